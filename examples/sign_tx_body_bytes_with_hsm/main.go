@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
 )
@@ -83,6 +85,11 @@ func main() {
 	if err := multiNodeFileTransactionExample(client, senderId, senderKey); err != nil {
 		panic(fmt.Sprintf("Multi-node file transaction example failed: %v", err))
 	}
+
+	// Signer interface example
+	if err := signerTransactionExample(client, senderId, receiverId, senderKey); err != nil {
+		panic(fmt.Sprintf("Signer transaction example failed: %v", err))
+	}
 }
 
 func singleNodeTransactionExample(client *hiero.Client, senderId, receiverId hiero.AccountID, senderKey hiero.PrivateKey) error {
@@ -220,6 +227,60 @@ func multiNodeFileTransactionExample(client *hiero.Client, senderId hiero.Accoun
 	return nil
 }
 
+func signerTransactionExample(client *hiero.Client, senderId, receiverId hiero.AccountID, senderKey hiero.PrivateKey) error {
+	// Step 1 - Create and freeze the transfer transaction for every node of the network
+	transferTx, err := hiero.NewTransferTransaction().
+		AddHbarTransfer(senderId, hiero.NewHbar(-1)).
+		AddHbarTransfer(receiverId, hiero.NewHbar(1)).
+		SetTransactionID(hiero.TransactionIDGenerate(senderId)).
+		FreezeWith(client)
+	if err != nil {
+		return fmt.Errorf("failed to freeze transfer transaction: %w", err)
+	}
+
+	// Step 2 - Add the HSM backed signer, the body bytes of every node are signed when the transaction is executed
+	transferTx = transferTx.SignWithSigner(&hsmSigner{key: senderKey})
+
+	// Step 3 - Execute transaction, HSM errors are returned here instead of panicking
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	transferResponse, err := transferTx.ExecuteWithContext(ctx, client)
+	if err != nil {
+		return fmt.Errorf("failed to execute transfer transaction: %w", err)
+	}
+
+	transferReceipt, err := transferResponse.GetReceiptWithContext(ctx, client)
+	if err != nil {
+		return fmt.Errorf("failed to get transfer receipt: %w", err)
+	}
+
+	fmt.Printf("Signer transaction status: %v\n", transferReceipt.Status)
+	return nil
+}
+
+// hsmSigner simulates a hiero.Signer backed by an HSM.
+// In a real implementation, Sign would call the HSM SDK and return its errors.
+type hsmSigner struct {
+	key hiero.PrivateKey
+}
+
+func (s *hsmSigner) PublicKey() hiero.PublicKey {
+	return s.key.PublicKey()
+}
+
+func (s *hsmSigner) KeyType() hiero.SignerKeyType {
+	return hiero.SignerKeyTypeEd25519
+}
+
+func (s *hsmSigner) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return hsmSign(s.key, message), nil
+}
+
 // hsmSign simulates signing with an HSM.
 // In a real implementation, this would use actual HSM SDK logic.
 func hsmSign(key hiero.PrivateKey, bodyBytes []byte) []byte {
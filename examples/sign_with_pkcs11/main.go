@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/pkcs11"
+)
+
+func main() {
+	var client *hiero.Client
+	var err error
+
+	// Retrieving network type from environment variable HEDERA_NETWORK
+	client, err = hiero.ClientForName(os.Getenv("HEDERA_NETWORK"))
+	if err != nil {
+		panic(fmt.Sprintf("%v : error creating client", err))
+	}
+
+	// Retrieving operator ID from environment variable OPERATOR_ID
+	operatorAccountID, err := hiero.AccountIDFromString(os.Getenv("OPERATOR_ID"))
+	if err != nil {
+		panic(fmt.Sprintf("%v : error converting string to AccountID", err))
+	}
+
+	// The operator's ED25519 key lives in a PKCS#11 token, e.g. SoftHSM2:
+	// HIERO_PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so HIERO_PKCS11_TOKEN_LABEL=hiero
+	// HIERO_PKCS11_KEY_LABEL=operator HIERO_PKCS11_PIN=1234
+	signer, err := pkcs11.NewEd25519Signer(pkcs11.Config{
+		ModulePath: os.Getenv("HIERO_PKCS11_MODULE"),
+		TokenLabel: os.Getenv("HIERO_PKCS11_TOKEN_LABEL"),
+		KeyLabel:   os.Getenv("HIERO_PKCS11_KEY_LABEL"),
+		PIN:        os.Getenv("HIERO_PKCS11_PIN"),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("%v : error opening PKCS#11 signer", err))
+	}
+	defer signer.Close()
+
+	// Transactions and query payments are signed by the token from now on
+	client.SetOperatorWithSigner(operatorAccountID, signer)
+
+	defer client.Close()
+
+	fmt.Printf("Operator public key from the token: %v\n", signer.PublicKey())
+
+	balance, err := hiero.NewAccountBalanceQuery().
+		SetAccountID(operatorAccountID).
+		Execute(client)
+	if err != nil {
+		panic(fmt.Sprintf("%v : error executing account balance query", err))
+	}
+
+	fmt.Printf("Operator balance: %v\n", balance.Hbars)
+
+	transactionResponse, err := hiero.NewTransferTransaction().
+		AddHbarTransfer(operatorAccountID, hiero.NewHbar(-1)).
+		AddHbarTransfer(hiero.AccountID{Account: 3}, hiero.NewHbar(1)).
+		Execute(client)
+	if err != nil {
+		panic(fmt.Sprintf("%v : error executing transfer transaction", err))
+	}
+
+	receipt, err := transactionResponse.GetReceipt(client)
+	if err != nil {
+		panic(fmt.Sprintf("%v : error retrieving transfer receipt", err))
+	}
+
+	fmt.Printf("Transfer signed by the token: %v\n", receipt.Status)
+}
@@ -1,10 +1,9 @@
 package hiero
 
 import (
+	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 
@@ -340,29 +339,20 @@ const (
 )
 
 func (id *AccountID) _MirrorNodeRequest(client *Client, populateType string) (map[string]any, error) {
-	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
-		return nil, errors.New("mirror node is not set")
-	}
-
-	mirrorUrl, err := client.GetMirrorRestApiBaseUrl()
+	restClient, err := client.GetMirrorRestClient()
 	if err != nil {
 		return nil, err
 	}
 
+	var path string
 	if populateType == "account" {
-		mirrorUrl = fmt.Sprintf("%s/accounts/%s", mirrorUrl, hex.EncodeToString(*id.AliasEvmAddress))
+		path = fmt.Sprintf("/accounts/%s", hex.EncodeToString(*id.AliasEvmAddress))
 	} else {
-		mirrorUrl = fmt.Sprintf("%s/accounts/%s", mirrorUrl, id.String())
-	}
-
-	resp, err := http.Get(mirrorUrl) // #nosec
-	if err != nil {
-		return nil, err
+		path = fmt.Sprintf("/accounts/%s", id.String())
 	}
-	defer resp.Body.Close()
 
 	var result map[string]any
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := restClient.get(context.Background(), path, nil, &result); err != nil {
 		return nil, err
 	}
 
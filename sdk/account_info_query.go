@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -32,7 +33,12 @@ func (q *AccountInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
-	resp, err := q.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, returning ctx.Err() if ctx is cancelled before it completes
+func (q *AccountInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (AccountInfo, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return AccountInfo{}, err
@@ -0,0 +1,203 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+const _TokenServiceGetAccountNftInfosFullMethodName = "/proto.TokenService/getAccountNftInfos"
+
+// AccountNftInfosQuery
+// Gets info on the NFTs owned by an AccountID in the range [start, end).
+// Networks which no longer serve this query are answered from the mirror node instead.
+type AccountNftInfosQuery struct {
+	Query
+	accountID *AccountID
+	start     int64
+	end       int64
+}
+
+// NewAccountNftInfosQuery creates AccountNftInfosQuery which
+// gets info on the NFTs owned by an AccountID in the range [start, end)
+func NewAccountNftInfosQuery() *AccountNftInfosQuery {
+	header := services.QueryHeader{}
+	return &AccountNftInfosQuery{
+		Query: _NewQuery(true, &header),
+	}
+}
+
+// SetGrpcDeadline When execution is attempted, a single attempt will timeout when this deadline is reached. (The SDK may subsequently retry the execution.)
+func (q *AccountNftInfosQuery) SetGrpcDeadline(deadline *time.Duration) *AccountNftInfosQuery {
+	q.Query.SetGrpcDeadline(deadline)
+	return q
+}
+
+// SetAccountID Sets the ID of the account whose NFTs are listed
+func (q *AccountNftInfosQuery) SetAccountID(accountID AccountID) *AccountNftInfosQuery {
+	q.accountID = &accountID
+	return q
+}
+
+// GetAccountID returns the ID of the account whose NFTs are listed
+func (q *AccountNftInfosQuery) GetAccountID() AccountID {
+	if q.accountID == nil {
+		return AccountID{}
+	}
+
+	return *q.accountID
+}
+
+// SetStart sets the index (inclusive) of the first NFT to list
+func (q *AccountNftInfosQuery) SetStart(start int64) *AccountNftInfosQuery {
+	q.start = start
+	return q
+}
+
+// GetStart returns the index (inclusive) of the first NFT to list
+func (q *AccountNftInfosQuery) GetStart() int64 {
+	return q.start
+}
+
+// SetEnd sets the index (exclusive) of the last NFT to list
+func (q *AccountNftInfosQuery) SetEnd(end int64) *AccountNftInfosQuery {
+	q.end = end
+	return q
+}
+
+// GetEnd returns the index (exclusive) of the last NFT to list
+func (q *AccountNftInfosQuery) GetEnd() int64 {
+	return q.end
+}
+
+func (q *AccountNftInfosQuery) GetCost(client *Client) (Hbar, error) {
+	return q.Query.getCost(client, q)
+}
+
+// Execute executes the Query with the provided client
+func (q *AccountNftInfosQuery) Execute(client *Client) ([]TokenNftInfo, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, returning ctx.Err() if ctx is cancelled before it completes
+func (q *AccountNftInfosQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TokenNftInfo, error) {
+	if q.start < 0 || q.end <= q.start {
+		return []TokenNftInfo{}, errInvalidNftInfosRange
+	}
+
+	resp, err := q.Query.executeWithContext(ctx, client, q)
+	if err != nil {
+		if !_NftInfosShouldFallback(err) {
+			return []TokenNftInfo{}, err
+		}
+
+		restClient, restErr := client.GetMirrorRestClient()
+		if restErr != nil {
+			return []TokenNftInfo{}, err
+		}
+
+		params := NewMirrorRestParams().SetOrder("asc").SetLimit(int(min(q.end, _NftInfosMirrorPageSize)))
+		return _NftInfosFromMirror(client, restClient.AccountNfts(ctx, q.GetAccountID(), params), q.start, q.end)
+	}
+
+	return _NftInfosFromProtobuf(resp.GetTokenGetAccountNftInfos().GetNfts()), nil
+}
+
+// SetMaxQueryPayment sets the maximum payment allowed for this Query.
+func (q *AccountNftInfosQuery) SetMaxQueryPayment(maxPayment Hbar) *AccountNftInfosQuery {
+	q.Query.SetMaxQueryPayment(maxPayment)
+	return q
+}
+
+// SetQueryPayment sets the payment amount for this Query.
+func (q *AccountNftInfosQuery) SetQueryPayment(paymentAmount Hbar) *AccountNftInfosQuery {
+	q.Query.SetQueryPayment(paymentAmount)
+	return q
+}
+
+// SetNodeAccountIDs sets the _Node AccountID for this AccountNftInfosQuery.
+func (q *AccountNftInfosQuery) SetNodeAccountIDs(accountID []AccountID) *AccountNftInfosQuery {
+	q.Query.SetNodeAccountIDs(accountID)
+	return q
+}
+
+// SetMaxRetry sets the max number of errors before execution will fail.
+func (q *AccountNftInfosQuery) SetMaxRetry(count int) *AccountNftInfosQuery {
+	q.Query.SetMaxRetry(count)
+	return q
+}
+
+// SetMaxBackoff The maximum amount of time to wait between retries.
+// Every retry attempt will increase the wait time exponentially until it reaches this time.
+func (q *AccountNftInfosQuery) SetMaxBackoff(max time.Duration) *AccountNftInfosQuery {
+	q.Query.SetMaxBackoff(max)
+	return q
+}
+
+// SetMinBackoff sets the minimum amount of time to wait between retries.
+func (q *AccountNftInfosQuery) SetMinBackoff(min time.Duration) *AccountNftInfosQuery {
+	q.Query.SetMinBackoff(min)
+	return q
+}
+
+// SetPaymentTransactionID assigns the payment transaction id.
+func (q *AccountNftInfosQuery) SetPaymentTransactionID(transactionID TransactionID) *AccountNftInfosQuery {
+	q.Query.SetPaymentTransactionID(transactionID)
+	return q
+}
+
+func (q *AccountNftInfosQuery) SetLogLevel(level LogLevel) *AccountNftInfosQuery {
+	q.Query.SetLogLevel(level)
+	return q
+}
+
+// ---------- Parent functions specific implementation ----------
+
+func (q *AccountNftInfosQuery) getMethod(channel *_Channel) _Method {
+	return _Method{
+		query: channel._LegacyQuery(_TokenServiceGetAccountNftInfosFullMethodName),
+	}
+}
+
+func (q *AccountNftInfosQuery) getName() string {
+	return "AccountNftInfosQuery"
+}
+
+func (q *AccountNftInfosQuery) buildQuery() *services.Query {
+	body := &services.TokenGetAccountNftInfosQuery{
+		Header: q.pbHeader,
+		Start:  q.start,
+		End:    q.end,
+	}
+
+	if q.accountID != nil {
+		body.AccountID = q.accountID._ToProtobuf()
+	}
+
+	return &services.Query{
+		Query: &services.Query_TokenGetAccountNftInfos{
+			TokenGetAccountNftInfos: body,
+		},
+	}
+}
+
+func (q *AccountNftInfosQuery) validateNetworkOnIDs(client *Client) error {
+	if client == nil || !client.autoValidateChecksums {
+		return nil
+	}
+
+	if q.accountID != nil {
+		if err := q.accountID.ValidateChecksum(client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (q *AccountNftInfosQuery) getQueryResponse(response *services.Response) queryResponse {
+	return response.GetTokenGetAccountNftInfos()
+}
@@ -0,0 +1,61 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitAccountNftInfosQueryGet(t *testing.T) {
+	t.Parallel()
+
+	query := NewAccountNftInfosQuery().
+		SetAccountID(AccountID{Account: 1800}).
+		SetStart(0).
+		SetEnd(25).
+		SetNodeAccountIDs([]AccountID{{Account: 10}}).
+		SetMaxQueryPayment(NewHbar(1))
+
+	require.Equal(t, AccountID{Account: 1800}, query.GetAccountID())
+	require.Equal(t, int64(0), query.GetStart())
+	require.Equal(t, int64(25), query.GetEnd())
+	require.Equal(t, []AccountID{{Account: 10}}, query.GetNodeAccountIDs())
+
+	body := query.buildQuery().GetTokenGetAccountNftInfos()
+	require.NotNil(t, body)
+	require.Equal(t, int64(1800), body.GetAccountID().GetAccountNum())
+	require.Equal(t, int64(0), body.GetStart())
+	require.Equal(t, int64(25), body.GetEnd())
+}
+
+func TestUnitAccountNftInfosQueryMirrorFallback(t *testing.T) {
+	t.Parallel()
+
+	mirror := _NftInfosMirror(t, "/api/v1/accounts/0.0.1800/nfts", "0.0.1800")
+	defer mirror.Close()
+
+	// the mock nodes do not serve getAccountNftInfos and answer UNIMPLEMENTED
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+	client.SetMirrorRestApiBaseUrl(mirror.URL + "/api/v1")
+
+	infos, err := NewAccountNftInfosQuery().
+		SetAccountID(AccountID{Account: 1800}).
+		SetStart(0).
+		SetEnd(10).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, infos, 4)
+
+	serials := make([]int64, 0, len(infos))
+	for _, info := range infos {
+		assert.Equal(t, AccountID{Account: 1800}, info.AccountID)
+		serials = append(serials, info.NftID.SerialNumber)
+	}
+	assert.Equal(t, []int64{1, 3, 4, 5}, serials)
+}
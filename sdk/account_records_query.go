@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -54,7 +55,12 @@ func (q *AccountRecordsQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, returning ctx.Err() if ctx is cancelled before it completes
+func (q *AccountRecordsQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TransactionRecord, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 	records := make([]TransactionRecord, 0)
 
 	if err != nil {
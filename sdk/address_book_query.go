@@ -3,7 +3,10 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
+
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
 )
 
 // AddressBookQuery query an address book for its list of nodes
@@ -84,6 +87,12 @@ func (q *AddressBookQuery) build() *mirror.AddressBookQuery {
 
 // Execute executes the Query with the provided client
 func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, returning ctx.Err() if ctx is cancelled before the
+// whole address book has been received. The stream is also cancelled when the client is closed.
+func (q *AddressBookQuery) ExecuteWithContext(ctx context.Context, client *Client) (NodeAddressBook, error) {
 	err := q.validateNetworkOnIDs(client)
 	if err != nil {
 		return NodeAddressBook{}, err
@@ -96,30 +105,66 @@ func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
 		return NodeAddressBook{}, err
 	}
 
-	channel, err := mirrorNode._GetNetworkServiceClient()
+	channel, err := mirrorNode._GetNetworkServiceClient(client.interceptors)
 	if err != nil {
 		return NodeAddressBook{}, err
 	}
 
-	ctx := client.networkUpdateContext
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if client.networkUpdateContext != nil {
+		stop := context.AfterFunc(client.networkUpdateContext, cancel)
+		defer stop()
+	}
 
-	stream, err := channel.GetNodes(ctx, pbBody)
+	stream, err := channel.GetNodes(streamCtx, pbBody)
 	if err != nil {
+		if ctx.Err() != nil {
+			return NodeAddressBook{}, ctx.Err()
+		}
 		return NodeAddressBook{}, err
 	}
 
-	resultStream := processProtoMessageStream(ctx, stream, q.attempt, q.maxAttempts, _DefaultRetryHandler)
+	// the mirror node cannot resume the node stream, so a retry receives the address book from the start again and
+	// skips the nodes which were already received
+	resubscribe := func(_ *services.NodeAddress, received uint64) (recvStream[*services.NodeAddress], error) {
+		stream, err := channel.GetNodes(streamCtx, pbBody)
+		if err != nil {
+			return nil, err
+		}
+
+		for range received {
+			if _, err := stream.Recv(); err != nil {
+				return nil, err
+			}
+		}
+
+		return stream, nil
+	}
+
+	resultStream := processProtoMessageStream(streamCtx, stream, resubscribe, q.attempt, q.maxAttempts, _DefaultRetryHandler)
 
 	results := make([]NodeAddress, 0)
 
 	for result := range resultStream {
 		if result.err != nil {
+			if ctx.Err() != nil {
+				return NodeAddressBook{}, ctx.Err()
+			}
 			return NodeAddressBook{}, result.err
 		}
 
 		results = append(results, _NodeAddressFromProtobuf(result.data))
 	}
 
+	// the stream is closed without an error once streamCtx is done
+	if err := streamCtx.Err(); err != nil {
+		if ctx.Err() != nil {
+			return NodeAddressBook{}, ctx.Err()
+		}
+		return NodeAddressBook{}, err
+	}
+
 	return NodeAddressBook{
 		NodeAddresses: results,
 	}, nil
@@ -0,0 +1,629 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// The network limits of a single TokenAirdropTransaction
+	airdropMaxTokenTransfers = 10
+	airdropMaxNftTransfers   = 10
+	transactionMaxSize       = 6144
+	// airdropTransactionOverhead is the size of the transaction ID, node account ID, fee, valid duration and
+	// envelope of a signed transaction, and airdropSignatureSize the size of a signature pair
+	airdropTransactionOverhead = 256
+	airdropSignatureSize       = 112
+	airdropDefaultConcurrency  = 4
+)
+
+// AirdropRecipient is an airdrop of an amount of a fungible token, or of an NFT, to an account.
+type AirdropRecipient struct {
+	AccountID AccountID
+	// TokenID is the fungible token, or the token of the NFT.
+	TokenID TokenID
+	// Amount is the amount of the fungible token in its smallest denomination.
+	Amount int64
+	// Decimals is the number of decimals of the fungible token, which the network checks.
+	Decimals uint32
+	// NftID is the NFT of an NFT airdrop, nil for a fungible token airdrop.
+	NftID *NftID
+}
+
+// IsNft returns true if the recipient receives an NFT.
+func (recipient AirdropRecipient) IsNft() bool {
+	return recipient.NftID != nil
+}
+
+// String returns a string representation of the AirdropRecipient
+func (recipient AirdropRecipient) String() string {
+	if recipient.NftID != nil {
+		return fmt.Sprintf("%s to %s", recipient.NftID.String(), recipient.AccountID.String())
+	}
+
+	return fmt.Sprintf("%d of %s to %s", recipient.Amount, recipient.TokenID.String(), recipient.AccountID.String())
+}
+
+// _Key identifies the transfer of the recipient, airdrops of the same fungible token to the same account are one
+// transfer
+func (recipient AirdropRecipient) _Key() string {
+	if recipient.NftID != nil {
+		return recipient.NftID.String()
+	}
+
+	return recipient.TokenID.String() + "/" + recipient.AccountID.String()
+}
+
+// AirdropBatch is a TokenAirdropTransaction planned by an AirdropPlanner, with the recipients it airdrops to.
+type AirdropBatch struct {
+	Transaction *TokenAirdropTransaction
+	Recipients  []AirdropRecipient
+	indices     []int
+}
+
+// AirdropOutcome is what happened to the airdrop of a recipient.
+type AirdropOutcome uint32
+
+const (
+	// AirdropOutcomeTransferred is an airdrop which was transferred to the recipient immediately.
+	AirdropOutcomeTransferred AirdropOutcome = iota
+	// AirdropOutcomePending is an airdrop which the recipient has to claim, because it is not associated with the
+	// token and has no free automatic association slots.
+	AirdropOutcomePending
+	// AirdropOutcomeFailed is an airdrop whose transaction failed or was not executed.
+	AirdropOutcomeFailed
+)
+
+// String returns a string representation of the AirdropOutcome
+func (outcome AirdropOutcome) String() string {
+	switch outcome {
+	case AirdropOutcomeTransferred:
+		return "TRANSFERRED"
+	case AirdropOutcomePending:
+		return "PENDING"
+	case AirdropOutcomeFailed:
+		return "FAILED"
+	}
+
+	return fmt.Sprintf("UNKNOWN(%d)", uint32(outcome))
+}
+
+// AirdropResult is the outcome of the airdrop of a recipient.
+type AirdropResult struct {
+	Recipient AirdropRecipient
+	Outcome   AirdropOutcome
+	// TransactionID is the transaction which contained the airdrop, if it was executed.
+	TransactionID *TransactionID
+	// PendingAirdrop is the pending airdrop the recipient can claim, if the outcome is pending.
+	PendingAirdrop *PendingAirdropRecord
+	// Err is the error of a failed airdrop.
+	Err error
+}
+
+// AirdropReport is the outcome of every airdrop of an AirdropPlanner, in the order the recipients were added.
+type AirdropReport struct {
+	Results []AirdropResult
+}
+
+// Transferred returns the results of the airdrops which were transferred immediately.
+func (report AirdropReport) Transferred() []AirdropResult {
+	return report._Filter(AirdropOutcomeTransferred)
+}
+
+// Pending returns the results of the airdrops which the recipients have to claim.
+func (report AirdropReport) Pending() []AirdropResult {
+	return report._Filter(AirdropOutcomePending)
+}
+
+// Failed returns the results of the airdrops which failed.
+func (report AirdropReport) Failed() []AirdropResult {
+	return report._Filter(AirdropOutcomeFailed)
+}
+
+func (report AirdropReport) _Filter(outcome AirdropOutcome) []AirdropResult {
+	results := make([]AirdropResult, 0)
+	for _, result := range report.Results {
+		if result.Outcome == outcome {
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
+// AirdropPlanner airdrops tokens and NFTs from a sender to any number of recipients. It packs the recipients into as
+// few TokenAirdropTransactions as the network limits on token transfers, NFT transfers and transaction size allow,
+// executes them concurrently and reports for every recipient whether the airdrop was transferred, is pending or
+// failed.
+//
+// Every transaction debits the sender once for every fungible token it airdrops, which counts as a token transfer.
+// Airdrops of the same fungible token to the same account are combined into one transfer.
+type AirdropPlanner struct {
+	sender             AccountID
+	recipients         []AirdropRecipient
+	maxTokenTransfers  int
+	maxNftTransfers    int
+	maxTransactionSize int
+	maxConcurrency     int
+	maxTransactionFee  *Hbar
+	signers            []Signer
+}
+
+// NewAirdropPlanner returns a planner which airdrops from the sender account.
+func NewAirdropPlanner(sender AccountID) *AirdropPlanner {
+	return &AirdropPlanner{
+		sender:             sender,
+		maxTokenTransfers:  airdropMaxTokenTransfers,
+		maxNftTransfers:    airdropMaxNftTransfers,
+		maxTransactionSize: transactionMaxSize,
+		maxConcurrency:     airdropDefaultConcurrency,
+	}
+}
+
+// GetSender returns the account the tokens and NFTs are airdropped from.
+func (planner *AirdropPlanner) GetSender() AccountID {
+	return planner.sender
+}
+
+// AddTokenAirdrop adds an airdrop of amount of a fungible token with the given number of decimals to an account.
+func (planner *AirdropPlanner) AddTokenAirdrop(tokenID TokenID, accountID AccountID, amount int64, decimals uint32) *AirdropPlanner {
+	planner.recipients = append(planner.recipients, AirdropRecipient{
+		AccountID: accountID,
+		TokenID:   tokenID,
+		Amount:    amount,
+		Decimals:  decimals,
+	})
+
+	return planner
+}
+
+// AddNftAirdrop adds an airdrop of an NFT owned by the sender to an account.
+func (planner *AirdropPlanner) AddNftAirdrop(nftID NftID, accountID AccountID) *AirdropPlanner {
+	planner.recipients = append(planner.recipients, AirdropRecipient{
+		AccountID: accountID,
+		TokenID:   nftID.TokenID,
+		NftID:     &nftID,
+	})
+
+	return planner
+}
+
+// AddRecipients adds airdrops to the planner.
+func (planner *AirdropPlanner) AddRecipients(recipients ...AirdropRecipient) *AirdropPlanner {
+	planner.recipients = append(planner.recipients, recipients...)
+	return planner
+}
+
+// GetRecipients returns the airdrops added to the planner.
+func (planner *AirdropPlanner) GetRecipients() []AirdropRecipient {
+	return planner.recipients
+}
+
+// SetMaxTokenTransfers sets the maximum number of fungible token transfers in a transaction, including the debits
+// of the sender. The default is the network limit of 10.
+func (planner *AirdropPlanner) SetMaxTokenTransfers(maxTokenTransfers int) *AirdropPlanner {
+	planner.maxTokenTransfers = maxTokenTransfers
+	return planner
+}
+
+// GetMaxTokenTransfers returns the maximum number of fungible token transfers in a transaction.
+func (planner *AirdropPlanner) GetMaxTokenTransfers() int {
+	return planner.maxTokenTransfers
+}
+
+// SetMaxNftTransfers sets the maximum number of NFT transfers in a transaction. The default is the network limit of 10.
+func (planner *AirdropPlanner) SetMaxNftTransfers(maxNftTransfers int) *AirdropPlanner {
+	planner.maxNftTransfers = maxNftTransfers
+	return planner
+}
+
+// GetMaxNftTransfers returns the maximum number of NFT transfers in a transaction.
+func (planner *AirdropPlanner) GetMaxNftTransfers() int {
+	return planner.maxNftTransfers
+}
+
+// SetMaxTransactionSize sets the maximum size in bytes of a signed transaction. The default is the network limit of
+// 6144 bytes.
+func (planner *AirdropPlanner) SetMaxTransactionSize(maxTransactionSize int) *AirdropPlanner {
+	planner.maxTransactionSize = maxTransactionSize
+	return planner
+}
+
+// GetMaxTransactionSize returns the maximum size in bytes of a signed transaction.
+func (planner *AirdropPlanner) GetMaxTransactionSize() int {
+	return planner.maxTransactionSize
+}
+
+// SetMaxConcurrency sets how many transactions are executed at the same time. The default is 4.
+func (planner *AirdropPlanner) SetMaxConcurrency(maxConcurrency int) *AirdropPlanner {
+	planner.maxConcurrency = maxConcurrency
+	return planner
+}
+
+// GetMaxConcurrency returns how many transactions are executed at the same time.
+func (planner *AirdropPlanner) GetMaxConcurrency() int {
+	return planner.maxConcurrency
+}
+
+// SetMaxTransactionFee sets the maximum transaction fee of every transaction.
+func (planner *AirdropPlanner) SetMaxTransactionFee(fee Hbar) *AirdropPlanner {
+	planner.maxTransactionFee = &fee
+	return planner
+}
+
+// Sign signs every transaction with the private key, for example the key of the sender if it is not the operator.
+func (planner *AirdropPlanner) Sign(privateKey PrivateKey) *AirdropPlanner {
+	return planner.SignWithSigner(NewPrivateKeySigner(privateKey))
+}
+
+// SignWithSigner signs every transaction with the Signer.
+func (planner *AirdropPlanner) SignWithSigner(signer Signer) *AirdropPlanner {
+	planner.signers = append(planner.signers, signer)
+	return planner
+}
+
+// _AirdropSlot is a transfer in a transaction, with the recipients it airdrops to
+type _AirdropSlot struct {
+	recipient AirdropRecipient
+	indices   []int
+}
+
+// Plan validates the recipients and packs them into transactions, without executing them. The transactions are not
+// frozen.
+func (planner *AirdropPlanner) Plan() ([]AirdropBatch, error) {
+	if planner.maxTokenTransfers < 2 {
+		return nil, fmt.Errorf("max token transfers must be at least 2 to debit the sender and credit a recipient, got %d", planner.maxTokenTransfers)
+	}
+	if planner.maxNftTransfers < 1 {
+		return nil, fmt.Errorf("max NFT transfers must be at least 1, got %d", planner.maxNftTransfers)
+	}
+
+	tokens, nfts, err := planner._Slots()
+	if err != nil {
+		return nil, err
+	}
+
+	builders := make([]*_AirdropBatchBuilder, 0)
+
+	// Fungible tokens fill the transactions one after another, a token which does not fit is split across them
+	var current *_AirdropBatchBuilder
+	for _, slots := range tokens {
+		for _, slot := range slots {
+			if current == nil || !current._AddToken(slot) {
+				current = planner._NewBatchBuilder()
+				builders = append(builders, current)
+				if !current._AddToken(slot) {
+					return nil, fmt.Errorf("airdrop of %s does not fit in a transaction of %d bytes", slot.recipient.String(), planner.maxTransactionSize)
+				}
+			}
+		}
+	}
+
+	// NFTs have their own limit, so they are added to the transactions of the fungible tokens first
+	index := 0
+	for _, slot := range nfts {
+		for {
+			if index == len(builders) {
+				builders = append(builders, planner._NewBatchBuilder())
+			}
+			if builders[index]._AddNft(slot) {
+				break
+			}
+			if builders[index]._IsEmpty() {
+				return nil, fmt.Errorf("airdrop of %s does not fit in a transaction of %d bytes", slot.recipient.String(), planner.maxTransactionSize)
+			}
+			index++
+		}
+	}
+
+	batches := make([]AirdropBatch, 0, len(builders))
+	for _, builder := range builders {
+		batches = append(batches, builder._Build(planner))
+	}
+
+	return batches, nil
+}
+
+// _Slots validates the recipients and combines them into transfers, grouped by fungible token in the order they were
+// added
+func (planner *AirdropPlanner) _Slots() ([][]*_AirdropSlot, []*_AirdropSlot, error) {
+	tokens := make([][]*_AirdropSlot, 0)
+	tokenIndex := make(map[TokenID]int)
+	decimals := make(map[TokenID]uint32)
+	slots := make(map[string]*_AirdropSlot)
+	nfts := make([]*_AirdropSlot, 0)
+
+	for i, recipient := range planner.recipients {
+		if recipient.AccountID.Compare(planner.sender) == 0 {
+			return nil, nil, fmt.Errorf("airdrop of %s is to the sender", recipient.String())
+		}
+
+		key := recipient._Key()
+		if recipient.NftID != nil {
+			if _, ok := slots[key]; ok {
+				return nil, nil, fmt.Errorf("NFT %s is airdropped more than once", recipient.NftID.String())
+			}
+			slot := &_AirdropSlot{recipient: recipient, indices: []int{i}}
+			slots[key] = slot
+			nfts = append(nfts, slot)
+			continue
+		}
+
+		if recipient.Amount <= 0 {
+			return nil, nil, fmt.Errorf("airdrop of %s must have a positive amount", recipient.String())
+		}
+		if known, ok := decimals[recipient.TokenID]; ok && known != recipient.Decimals {
+			return nil, nil, fmt.Errorf("token %s is airdropped with %d and %d decimals", recipient.TokenID.String(), known, recipient.Decimals)
+		}
+		decimals[recipient.TokenID] = recipient.Decimals
+
+		if slot, ok := slots[key]; ok {
+			slot.recipient.Amount += recipient.Amount
+			slot.indices = append(slot.indices, i)
+			continue
+		}
+
+		slot := &_AirdropSlot{recipient: recipient, indices: []int{i}}
+		slots[key] = slot
+
+		index, ok := tokenIndex[recipient.TokenID]
+		if !ok {
+			index = len(tokens)
+			tokenIndex[recipient.TokenID] = index
+			tokens = append(tokens, nil)
+		}
+		tokens[index] = append(tokens[index], slot)
+	}
+
+	return tokens, nfts, nil
+}
+
+// _AirdropBatchBuilder collects the transfers of a transaction and checks them against the limits
+type _AirdropBatchBuilder struct {
+	sender            AccountID
+	maxTokenTransfers int
+	maxNftTransfers   int
+	maxBodySize       int
+	tokenTransfers    int
+	nftTransfers      int
+	slots             []*_AirdropSlot
+	body              *services.TokenAirdropTransactionBody
+	lists             map[TokenID]*services.TokenTransferList
+}
+
+func (planner *AirdropPlanner) _NewBatchBuilder() *_AirdropBatchBuilder {
+	return &_AirdropBatchBuilder{
+		sender:            planner.sender,
+		maxTokenTransfers: planner.maxTokenTransfers,
+		maxNftTransfers:   planner.maxNftTransfers,
+		maxBodySize: planner.maxTransactionSize - airdropTransactionOverhead -
+			airdropSignatureSize*(len(planner.signers)+1),
+		body:  &services.TokenAirdropTransactionBody{},
+		lists: make(map[TokenID]*services.TokenTransferList),
+	}
+}
+
+func (builder *_AirdropBatchBuilder) _IsEmpty() bool {
+	return len(builder.slots) == 0
+}
+
+// _AddToken adds the transfer of a fungible token, and the debit of the sender if the token is new to the transaction
+func (builder *_AirdropBatchBuilder) _AddToken(slot *_AirdropSlot) bool {
+	recipient := slot.recipient
+	list, ok := builder.lists[recipient.TokenID]
+
+	transfers := 1
+	if !ok {
+		transfers++
+	}
+	if builder.tokenTransfers+transfers > builder.maxTokenTransfers {
+		return false
+	}
+
+	credit := &services.AccountAmount{AccountID: recipient.AccountID._ToProtobuf(), Amount: recipient.Amount}
+	if !ok {
+		list = &services.TokenTransferList{
+			Token: recipient.TokenID._ToProtobuf(),
+			Transfers: []*services.AccountAmount{
+				{AccountID: builder.sender._ToProtobuf(), Amount: 0},
+			},
+			ExpectedDecimals: &wrapperspb.UInt32Value{Value: recipient.Decimals},
+		}
+		builder.body.TokenTransfers = append(builder.body.TokenTransfers, list)
+	}
+
+	previousDebit := list.Transfers[0].Amount
+	list.Transfers[0].Amount -= recipient.Amount
+	list.Transfers = append(list.Transfers, credit)
+
+	if !builder._Fits() {
+		list.Transfers = list.Transfers[:len(list.Transfers)-1]
+		list.Transfers[0].Amount = previousDebit
+		if !ok {
+			builder.body.TokenTransfers = builder.body.TokenTransfers[:len(builder.body.TokenTransfers)-1]
+		}
+		return false
+	}
+
+	if !ok {
+		builder.lists[recipient.TokenID] = list
+	}
+	builder.tokenTransfers += transfers
+	builder.slots = append(builder.slots, slot)
+
+	return true
+}
+
+func (builder *_AirdropBatchBuilder) _AddNft(slot *_AirdropSlot) bool {
+	if builder.nftTransfers+1 > builder.maxNftTransfers {
+		return false
+	}
+
+	nftID := *slot.recipient.NftID
+	list := &services.TokenTransferList{
+		Token: nftID.TokenID._ToProtobuf(),
+		NftTransfers: []*services.NftTransfer{{
+			SenderAccountID:   builder.sender._ToProtobuf(),
+			ReceiverAccountID: slot.recipient.AccountID._ToProtobuf(),
+			SerialNumber:      nftID.SerialNumber,
+		}},
+	}
+
+	// Estimated as a list of its own, which is an upper bound of its size next to other NFTs of the token
+	builder.body.TokenTransfers = append(builder.body.TokenTransfers, list)
+	if !builder._Fits() {
+		builder.body.TokenTransfers = builder.body.TokenTransfers[:len(builder.body.TokenTransfers)-1]
+		return false
+	}
+
+	builder.nftTransfers++
+	builder.slots = append(builder.slots, slot)
+
+	return true
+}
+
+func (builder *_AirdropBatchBuilder) _Fits() bool {
+	return protobuf.Size(builder.body) <= builder.maxBodySize
+}
+
+func (builder *_AirdropBatchBuilder) _Build(planner *AirdropPlanner) AirdropBatch {
+	tx := NewTokenAirdropTransaction()
+	if planner.maxTransactionFee != nil {
+		tx.SetMaxTransactionFee(*planner.maxTransactionFee)
+	}
+
+	batch := AirdropBatch{Transaction: tx}
+	for _, slot := range builder.slots {
+		recipient := slot.recipient
+		if recipient.NftID != nil {
+			tx.AddNftTransfer(*recipient.NftID, builder.sender, recipient.AccountID)
+		} else {
+			tx.AddTokenTransferWithDecimals(recipient.TokenID, builder.sender, -recipient.Amount, recipient.Decimals)
+			tx.AddTokenTransferWithDecimals(recipient.TokenID, recipient.AccountID, recipient.Amount, recipient.Decimals)
+		}
+
+		for _, index := range slot.indices {
+			batch.Recipients = append(batch.Recipients, planner.recipients[index])
+			batch.indices = append(batch.indices, index)
+		}
+	}
+
+	return batch
+}
+
+// Execute plans and executes the airdrop transactions.
+func (planner *AirdropPlanner) Execute(client *Client) (AirdropReport, error) {
+	return planner.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext plans and executes the airdrop transactions, at most GetMaxConcurrency at the same time, and
+// waits for their records. An error is only returned if the airdrop cannot be planned; failed transactions are
+// reported per recipient. Transactions which did not start before ctx is done fail with ctx.Err().
+func (planner *AirdropPlanner) ExecuteWithContext(ctx context.Context, client *Client) (AirdropReport, error) {
+	if client == nil {
+		return AirdropReport{}, errNoClientProvided
+	}
+
+	batches, err := planner.Plan()
+	if err != nil {
+		return AirdropReport{}, err
+	}
+
+	report := AirdropReport{Results: make([]AirdropResult, len(planner.recipients))}
+	for i, recipient := range planner.recipients {
+		report.Results[i] = AirdropResult{Recipient: recipient, Outcome: AirdropOutcomeFailed}
+	}
+
+	concurrency := planner.maxConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	work := make(chan *AirdropBatch)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range work {
+				planner._ExecuteBatch(ctx, client, batch, report.Results)
+			}
+		}()
+	}
+
+	for i := range batches {
+		if ctx.Err() != nil {
+			for _, index := range batches[i].indices {
+				report.Results[index].Err = ctx.Err()
+			}
+			continue
+		}
+		work <- &batches[i]
+	}
+	close(work)
+	wg.Wait()
+
+	return report, nil
+}
+
+// _ExecuteBatch executes a transaction and sets the results of its recipients, which no other batch has
+func (planner *AirdropPlanner) _ExecuteBatch(ctx context.Context, client *Client, batch *AirdropBatch, results []AirdropResult) {
+	fail := func(transactionID *TransactionID, err error) {
+		for _, index := range batch.indices {
+			results[index].TransactionID = transactionID
+			results[index].Err = err
+		}
+	}
+
+	tx, err := batch.Transaction.FreezeWith(client)
+	if err != nil {
+		fail(nil, err)
+		return
+	}
+	for _, signer := range planner.signers {
+		tx.SignWithSigner(signer)
+	}
+
+	response, err := tx.ExecuteWithContext(ctx, client)
+	if err != nil {
+		transactionID := tx.GetTransactionID()
+		fail(&transactionID, err)
+		return
+	}
+
+	transactionID := response.TransactionID
+	record, err := response.GetRecordWithContext(ctx, client)
+	if err != nil {
+		fail(&transactionID, err)
+		return
+	}
+
+	pending := make(map[string]PendingAirdropRecord)
+	for _, pendingAirdrop := range record.PendingAirdropRecords {
+		id := pendingAirdrop.GetPendingAirdropId()
+		switch {
+		case id.GetNftID() != nil:
+			pending[id.GetNftID().String()] = pendingAirdrop
+		case id.GetTokenID() != nil && id.GetReceiver() != nil:
+			pending[id.GetTokenID().String()+"/"+id.GetReceiver().String()] = pendingAirdrop
+		}
+	}
+
+	for _, index := range batch.indices {
+		result := &results[index]
+		result.TransactionID = &transactionID
+		if pendingAirdrop, ok := pending[result.Recipient._Key()]; ok {
+			result.Outcome = AirdropOutcomePending
+			result.PendingAirdrop = &pendingAirdrop
+		} else {
+			result.Outcome = AirdropOutcomeTransferred
+		}
+	}
+}
@@ -0,0 +1,237 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// _AirdropPlanTransfers returns the number of token and NFT transfers of a planned transaction and the debit of the
+// sender of every fungible token
+func _AirdropPlanTransfers(t *testing.T, batch AirdropBatch, sender AccountID) (int, int, map[TokenID]int64) {
+	tokenTransfers, nftTransfers := 0, 0
+	debits := make(map[TokenID]int64)
+
+	for tokenID, transfers := range batch.Transaction.GetTokenTransfers() {
+		tokenTransfers += len(transfers)
+		for _, transfer := range transfers {
+			if transfer.AccountID.Compare(sender) == 0 {
+				debits[tokenID] += transfer.Amount
+			}
+		}
+	}
+	for _, transfers := range batch.Transaction.GetNftTransfers() {
+		nftTransfers += len(transfers)
+	}
+
+	require.LessOrEqual(t, tokenTransfers, airdropMaxTokenTransfers)
+	require.LessOrEqual(t, nftTransfers, airdropMaxNftTransfers)
+
+	return tokenTransfers, nftTransfers, debits
+}
+
+func TestUnitAirdropPlannerPlanSingleToken(t *testing.T) {
+	t.Parallel()
+
+	sender := AccountID{Account: 1000}
+	tokenID := TokenID{Token: 500}
+	planner := NewAirdropPlanner(sender)
+	for i := 0; i < 25; i++ {
+		planner.AddTokenAirdrop(tokenID, AccountID{Account: uint64(2000 + i)}, 10, 2)
+	}
+
+	batches, err := planner.Plan()
+	require.NoError(t, err)
+	// every transaction debits the sender once, so it has room for 9 recipients
+	require.Len(t, batches, 3)
+
+	recipients := 0
+	var debited int64
+	for _, batch := range batches {
+		transfers, nfts, debits := _AirdropPlanTransfers(t, batch, sender)
+		assert.Equal(t, len(batch.Recipients)+1, transfers)
+		assert.Zero(t, nfts)
+		assert.Equal(t, uint32(2), batch.Transaction.GetTokenIDDecimals()[tokenID])
+		recipients += len(batch.Recipients)
+		debited += debits[tokenID]
+	}
+	assert.Equal(t, 25, recipients)
+	assert.Equal(t, int64(-250), debited)
+	assert.Len(t, batches[0].Recipients, 9)
+	assert.Len(t, batches[2].Recipients, 7)
+}
+
+func TestUnitAirdropPlannerPlanTokensAndNfts(t *testing.T) {
+	t.Parallel()
+
+	sender := AccountID{Account: 1000}
+	planner := NewAirdropPlanner(sender)
+	for i := 0; i < 5; i++ {
+		planner.AddTokenAirdrop(TokenID{Token: 500}, AccountID{Account: uint64(2000 + i)}, 1, 0)
+		planner.AddTokenAirdrop(TokenID{Token: 600}, AccountID{Account: uint64(2000 + i)}, 1, 8)
+	}
+	for i := 0; i < 12; i++ {
+		planner.AddNftAirdrop(NftID{TokenID: TokenID{Token: 700}, SerialNumber: int64(i + 1)}, AccountID{Account: uint64(3000 + i)})
+	}
+	// combined with the first airdrop of the token to the account
+	planner.AddTokenAirdrop(TokenID{Token: 500}, AccountID{Account: 2000}, 4, 0)
+
+	batches, err := planner.Plan()
+	require.NoError(t, err)
+	// 12 token transfers and 12 NFT transfers fit in two transactions
+	require.Len(t, batches, 2)
+
+	total := 0
+	var debited int64
+	for _, batch := range batches {
+		_, nfts, debits := _AirdropPlanTransfers(t, batch, sender)
+		assert.Greater(t, nfts, 0)
+		total += len(batch.Recipients)
+		debited += debits[TokenID{Token: 500}]
+	}
+	assert.Equal(t, 23, total)
+	assert.Equal(t, int64(-9), debited)
+}
+
+func TestUnitAirdropPlannerPlanErrors(t *testing.T) {
+	t.Parallel()
+
+	sender := AccountID{Account: 1000}
+	tokenID := TokenID{Token: 500}
+
+	_, err := NewAirdropPlanner(sender).AddTokenAirdrop(tokenID, AccountID{Account: 2000}, 0, 0).Plan()
+	require.ErrorContains(t, err, "positive amount")
+
+	_, err = NewAirdropPlanner(sender).AddTokenAirdrop(tokenID, sender, 1, 0).Plan()
+	require.ErrorContains(t, err, "to the sender")
+
+	_, err = NewAirdropPlanner(sender).
+		AddTokenAirdrop(tokenID, AccountID{Account: 2000}, 1, 0).
+		AddTokenAirdrop(tokenID, AccountID{Account: 2001}, 1, 2).
+		Plan()
+	require.ErrorContains(t, err, "decimals")
+
+	_, err = NewAirdropPlanner(sender).
+		AddNftAirdrop(NftID{TokenID: tokenID, SerialNumber: 1}, AccountID{Account: 2000}).
+		AddNftAirdrop(NftID{TokenID: tokenID, SerialNumber: 1}, AccountID{Account: 2001}).
+		Plan()
+	require.ErrorContains(t, err, "more than once")
+
+	_, err = NewAirdropPlanner(sender).SetMaxTokenTransfers(1).Plan()
+	require.Error(t, err)
+
+	_, err = NewAirdropPlanner(sender).
+		AddTokenAirdrop(tokenID, AccountID{Account: 2000}, 1, 0).
+		SetMaxTransactionSize(300).
+		Plan()
+	require.ErrorContains(t, err, "does not fit")
+
+	batches, err := NewAirdropPlanner(sender).Plan()
+	require.NoError(t, err)
+	assert.Empty(t, batches)
+}
+
+func TestUnitAirdropPlannerPlanSize(t *testing.T) {
+	t.Parallel()
+
+	sender := AccountID{Account: 1000}
+	planner := NewAirdropPlanner(sender).SetMaxTokenTransfers(1000).SetMaxNftTransfers(1000)
+	for i := 0; i < 800; i++ {
+		planner.AddTokenAirdrop(TokenID{Token: 500}, AccountID{Account: uint64(100_000 + i)}, 1_000_000, 6)
+	}
+
+	batches, err := planner.Plan()
+	require.NoError(t, err)
+	require.Greater(t, len(batches), 1)
+
+	for _, batch := range batches {
+		body := batch.Transaction.buildProtoBody()
+		size := 0
+		for _, list := range body.TokenTransfers {
+			size += len(list.Transfers)
+		}
+		assert.Equal(t, len(batch.Recipients)+1, size)
+		assert.LessOrEqual(t, protobuf.Size(body), transactionMaxSize-airdropTransactionOverhead-airdropSignatureSize)
+	}
+}
+
+func TestUnitAirdropPlannerExecute(t *testing.T) {
+	t.Parallel()
+
+	tokenID := TokenID{Token: 500}
+	pendingRecipient := AccountID{Account: 2001}
+	record := func(request *services.Query) *services.Response {
+		return &services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{
+						NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+						ResponseType:                request.GetTransactionGetRecord().GetHeader().GetResponseType(),
+					},
+					TransactionRecord: &services.TransactionRecord{
+						Receipt: &services.TransactionReceipt{Status: services.ResponseCodeEnum_SUCCESS},
+						NewPendingAirdrops: []*services.PendingAirdropRecord{{
+							PendingAirdropId: &services.PendingAirdropId{
+								SenderId:   AccountID{Account: 1800}._ToProtobuf(),
+								ReceiverId: pendingRecipient._ToProtobuf(),
+								TokenReference: &services.PendingAirdropId_FungibleTokenType{
+									FungibleTokenType: tokenID._ToProtobuf(),
+								},
+							},
+							PendingAirdropValue: &services.PendingAirdropValue{Amount: 1},
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+		record,
+		record,
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE},
+	}})
+	defer server.Close()
+
+	planner := NewAirdropPlanner(AccountID{Account: 1800}).SetMaxConcurrency(1).SetMaxTokenTransfers(3)
+	planner.AddTokenAirdrop(tokenID, AccountID{Account: 2000}, 1, 0)
+	planner.AddTokenAirdrop(tokenID, pendingRecipient, 1, 0)
+	planner.AddTokenAirdrop(tokenID, AccountID{Account: 2002}, 1, 0)
+
+	report, err := planner.Execute(client)
+	require.NoError(t, err)
+	require.Len(t, report.Results, 3)
+
+	assert.Equal(t, AirdropOutcomeTransferred, report.Results[0].Outcome)
+	assert.Equal(t, AirdropOutcomePending, report.Results[1].Outcome)
+	require.NotNil(t, report.Results[1].PendingAirdrop)
+	assert.Equal(t, uint64(1), report.Results[1].PendingAirdrop.GetPendingAirdropAmount())
+	assert.Equal(t, report.Results[0].TransactionID.String(), report.Results[1].TransactionID.String())
+
+	failed := report.Failed()
+	require.Len(t, failed, 1)
+	assert.Equal(t, AccountID{Account: 2002}, failed[0].Recipient.AccountID)
+	assert.ErrorContains(t, failed[0].Err, StatusInsufficientTokenBalance.String())
+	assert.NotNil(t, failed[0].TransactionID)
+	assert.Len(t, report.Transferred(), 1)
+	assert.Len(t, report.Pending(), 1)
+	assert.Equal(t, "FAILED", failed[0].Outcome.String())
+
+	// nothing is executed once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report, err = planner.ExecuteWithContext(ctx, client)
+	require.NoError(t, err)
+	assert.Len(t, report.Failed(), 3)
+	assert.ErrorIs(t, report.Results[0].Err, context.Canceled)
+}
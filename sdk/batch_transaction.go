@@ -1,6 +1,7 @@
 package hiero
 
 import (
+	"context"
 	"reflect"
 	"slices"
 
@@ -46,6 +47,8 @@ import (
 type BatchTransaction struct {
 	*Transaction[*BatchTransaction]
 	innerTransactions []TransactionInterface
+	// the signed inner transactions, set when the batch is frozen
+	innerTransactionBytes [][]byte
 }
 
 // blacklistedTransactions is a list of transaction types that are not allowed in a batch transaction.
@@ -75,7 +78,8 @@ func _BatchTransactionFromProtobuf(tx Transaction[*BatchTransaction], pb *servic
 	}
 
 	batchTransaction := BatchTransaction{
-		innerTransactions: innerTransactions,
+		innerTransactions:     innerTransactions,
+		innerTransactionBytes: pb.GetAtomicBatch().GetTransactions(),
 	}
 	tx.childTransaction = &batchTransaction
 	batchTransaction.Transaction = &tx
@@ -180,6 +184,63 @@ func (tx *BatchTransaction) GetInnerTransactionIDs() []TransactionID {
 	return ids
 }
 
+// Freeze signs the inner transactions and freezes the BatchTransaction.
+func (tx *BatchTransaction) Freeze() (*BatchTransaction, error) {
+	return tx.FreezeWith(nil)
+}
+
+// FreezeWith signs the inner transactions and freezes the BatchTransaction with the provided client.
+// A failing Signer of an inner transaction is returned as ErrSigningFailed.
+func (tx *BatchTransaction) FreezeWith(client *Client) (*BatchTransaction, error) {
+	return tx._FreezeWithContext(context.Background(), client)
+}
+
+func (tx *BatchTransaction) _FreezeWithContext(ctx context.Context, client *Client) (*BatchTransaction, error) {
+	if tx.IsFrozen() {
+		return tx, nil
+	}
+
+	if err := tx._SignInnerTransactions(ctx); err != nil {
+		return tx, err
+	}
+
+	return tx.Transaction.FreezeWith(client)
+}
+
+// Execute executes the BatchTransaction with the provided client
+func (tx *BatchTransaction) Execute(client *Client) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the BatchTransaction with the provided client, returning ctx.Err() if ctx is cancelled
+// before the network accepts the transaction. The Signers of the inner transactions are called with ctx.
+func (tx *BatchTransaction) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
+	if client != nil && tx.keyError == nil && tx.freezeError == nil && !tx.IsFrozen() {
+		if _, err := tx._FreezeWithContext(ctx, client); err != nil {
+			return TransactionResponse{}, err
+		}
+	}
+
+	return tx.Transaction.ExecuteWithContext(ctx, client)
+}
+
+// _SignInnerTransactions signs the inner transactions with their Signers and keeps the signed transactions as the
+// body of the batch
+func (tx *BatchTransaction) _SignInnerTransactions(ctx context.Context) error {
+	signed := make([][]byte, 0, len(tx.innerTransactions))
+	for _, innerTransaction := range tx.innerTransactions {
+		request, err := innerTransaction.makeRequest(ctx)
+		if err != nil {
+			return err
+		}
+
+		signed = append(signed, request.(*services.Transaction).GetSignedTransactionBytes())
+	}
+
+	tx.innerTransactionBytes = signed
+	return nil
+}
+
 // ----------- Overridden functions ----------------
 
 func (tx BatchTransaction) getName() string {
@@ -217,14 +278,21 @@ func (tx BatchTransaction) buildScheduled() (*services.SchedulableTransactionBod
 }
 
 func (tx BatchTransaction) buildProtoBody() *services.AtomicBatchTransactionBody {
+	if tx.innerTransactionBytes != nil {
+		return &services.AtomicBatchTransactionBody{
+			Transactions: tx.innerTransactionBytes,
+		}
+	}
+
+	// the batch is not frozen yet, e.g. while its fee is estimated, so the inner transactions are taken with the
+	// signatures they already carry
 	body := &services.AtomicBatchTransactionBody{}
 	for _, innerTransaction := range tx.innerTransactions {
-		request := innerTransaction.makeRequest()
-		transaction, ok := request.(*services.Transaction)
-		if !ok {
-			return nil
+		signed, err := innerTransaction.getBaseTransaction()._MarshalSignedTransaction(0)
+		if err != nil {
+			continue
 		}
-		body.Transactions = append(body.Transactions, transaction.GetSignedTransactionBytes())
+		body.Transactions = append(body.Transactions, signed.GetSignedTransactionBytes())
 	}
 
 	return body
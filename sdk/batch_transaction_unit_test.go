@@ -5,6 +5,8 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -453,3 +455,62 @@ func TestUnitBatchTransactionAcceptValidTransaction(t *testing.T) {
 	assert.Len(t, tx2.GetInnerTransactions(), 1)
 	assert.Contains(t, tx2.GetInnerTransactions(), validTx2)
 }
+
+func TestUnitBatchTransactionInnerSignerErrorIsReturned(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	errHsm := errors.New("hsm unavailable")
+
+	innerTx := spawnTestTransactionAccountCreate()
+	innerTx.SignWithSigner(&_TestSigner{key: key, err: errHsm})
+
+	_, err = NewBatchTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5006})).
+		AddInnerTransaction(innerTx).
+		Freeze()
+	require.ErrorIs(t, err, errHsm)
+
+	var signingErr ErrSigningFailed
+	require.ErrorAs(t, err, &signingErr)
+	assert.Equal(t, key.PublicKey().String(), signingErr.PublicKey.String())
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+
+	innerTx = spawnTestTransactionAccountCreate()
+	innerTx.SignWithSigner(&_TestSigner{key: key, err: errHsm})
+
+	batch := NewBatchTransaction().AddInnerTransaction(innerTx)
+	_, err = batch.ExecuteWithContext(context.Background(), client)
+	require.ErrorAs(t, err, &signingErr)
+	assert.False(t, batch.IsFrozen())
+}
+
+func TestUnitBatchTransactionInnerSignerSignsOnFreeze(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	signer := &_TestSigner{key: key}
+
+	innerTx := spawnTestTransactionAccountCreate()
+	innerTx.SignWithSigner(signer)
+
+	batch, err := NewBatchTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5006})).
+		AddInnerTransaction(innerTx).
+		Freeze()
+	require.NoError(t, err)
+	require.Equal(t, 1, signer.signCalls)
+
+	body := batch.buildProtoBody()
+	require.Len(t, body.GetTransactions(), 1)
+
+	_, err = batch.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, 1, signer.signCalls)
+}
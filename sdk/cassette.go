@@ -0,0 +1,335 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CassetteMode is whether a Cassette records requests sent to the network or replays recorded responses.
+type CassetteMode int
+
+const (
+	// CassetteModeRecord sends every request to the network and records it with its response.
+	CassetteModeRecord CassetteMode = iota
+	// CassetteModeReplay answers every request with a recorded response without contacting the network.
+	CassetteModeReplay
+)
+
+// String returns a string representation of the CassetteMode
+func (mode CassetteMode) String() string {
+	switch mode {
+	case CassetteModeRecord:
+		return "RECORD"
+	case CassetteModeReplay:
+		return "REPLAY"
+	}
+
+	return fmt.Sprintf("UNKNOWN(%d)", int(mode))
+}
+
+// CassetteInteraction is a request sent to a node and the response or gRPC error it received.
+type CassetteInteraction struct {
+	// NodeAccountID is the account ID of the node the request was sent to.
+	NodeAccountID string `json:"nodeAccountId"`
+	// Operation is the HederaFunctionality of the request, e.g. CryptoTransfer or TransactionGetReceipt.
+	Operation string `json:"operation"`
+	// Request is the normalised, marshalled services.Transaction or services.Query used to match requests.
+	Request []byte `json:"request"`
+	// Response is the marshalled services.TransactionResponse or services.Response, unless the call failed.
+	Response []byte `json:"response,omitempty"`
+	// ErrorCode and ErrorMessage are the gRPC status of a failed call.
+	ErrorCode    codes.Code `json:"errorCode,omitempty"`
+	ErrorMessage string     `json:"errorMessage,omitempty"`
+}
+
+// Cassette records the gRPC requests a client sends to the consensus nodes with their responses, and replays them
+// offline for deterministic tests. Set it on a client with Client.SetCassette.
+//
+// Requests are matched by their normalised body: the valid start of every transaction ID, the node account ID of
+// transaction bodies, signatures and query payments are removed, so a replayed session matches the recorded one
+// although its transaction IDs and signatures differ. Identical normalised requests, such as the polling of a
+// receipt, are answered with their recorded responses in the order they were recorded. Responses are replayed as
+// recorded, including the transaction IDs and timestamps they contain.
+type Cassette struct {
+	mutex        sync.Mutex
+	mode         CassetteMode
+	interactions []CassetteInteraction
+	used         []bool
+}
+
+type _CassetteFile struct {
+	Interactions []CassetteInteraction `json:"interactions"`
+}
+
+// ErrCassetteMiss is returned in replay mode when a request has no recorded response left.
+type ErrCassetteMiss struct {
+	NodeAccountID AccountID
+	Operation     string
+}
+
+func (err ErrCassetteMiss) Error() string {
+	return fmt.Sprintf("no recorded response left for %s sent to node %s", err.Operation, err.NodeAccountID.String())
+}
+
+// NewCassette returns an empty cassette which records the requests of the clients it is set on.
+func NewCassette() *Cassette {
+	return &Cassette{
+		mode: CassetteModeRecord,
+	}
+}
+
+// CassetteFromBytes returns a cassette which replays the interactions of data, as returned by Cassette.ToBytes.
+func CassetteFromBytes(data []byte) (*Cassette, error) {
+	if data == nil {
+		return nil, errByteArrayNull
+	}
+
+	var file _CassetteFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	return &Cassette{
+		mode:         CassetteModeReplay,
+		interactions: file.Interactions,
+		used:         make([]bool, len(file.Interactions)),
+	}, nil
+}
+
+// LoadCassette returns a cassette which replays the interactions of a file written by Cassette.Save.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return CassetteFromBytes(data)
+}
+
+// GetMode returns whether the cassette records or replays requests.
+func (cassette *Cassette) GetMode() CassetteMode {
+	return cassette.mode
+}
+
+// GetInteractions returns the recorded interactions.
+func (cassette *Cassette) GetInteractions() []CassetteInteraction {
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	return append([]CassetteInteraction(nil), cassette.interactions...)
+}
+
+// GetRemaining returns the number of interactions which have not been replayed yet.
+func (cassette *Cassette) GetRemaining() int {
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	remaining := 0
+	for _, used := range cassette.used {
+		if !used {
+			remaining++
+		}
+	}
+
+	return remaining
+}
+
+// ToBytes returns the JSON encoding of the interactions.
+func (cassette *Cassette) ToBytes() ([]byte, error) {
+	return json.MarshalIndent(_CassetteFile{Interactions: cassette.GetInteractions()}, "", "  ")
+}
+
+// Save writes the interactions to a file which LoadCassette can replay.
+func (cassette *Cassette) Save(path string) error {
+	data, err := cassette.ToBytes()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
+
+// _Wrap returns a method which records the calls of method, or replays them without calling it.
+func (cassette *Cassette) _Wrap(nodeAccountID AccountID, method _Method) _Method {
+	wrapped := _Method{}
+
+	if method.query != nil {
+		wrapped.query = func(ctx context.Context, query *services.Query, opts ...grpc.CallOption) (*services.Response, error) {
+			if cassette.mode == CassetteModeReplay {
+				response := &services.Response{}
+				if err := cassette._Replay(nodeAccountID, query, response); err != nil {
+					return nil, err
+				}
+
+				return response, nil
+			}
+
+			response, err := method.query(ctx, query, opts...)
+			cassette._Record(nodeAccountID, query, response, err)
+
+			return response, err
+		}
+	}
+
+	if method.transaction != nil {
+		wrapped.transaction = func(ctx context.Context, transaction *services.Transaction, opts ...grpc.CallOption) (*services.TransactionResponse, error) {
+			if cassette.mode == CassetteModeReplay {
+				response := &services.TransactionResponse{}
+				if err := cassette._Replay(nodeAccountID, transaction, response); err != nil {
+					return nil, err
+				}
+
+				return response, nil
+			}
+
+			response, err := method.transaction(ctx, transaction, opts...)
+			cassette._Record(nodeAccountID, transaction, response, err)
+
+			return response, err
+		}
+	}
+
+	return wrapped
+}
+
+func (cassette *Cassette) _Record(nodeAccountID AccountID, request protobuf.Message, response protobuf.Message, err error) {
+	interaction := CassetteInteraction{
+		NodeAccountID: nodeAccountID.String(),
+		Operation:     services.HederaFunctionality(_RequestTypeFromRequest(request)).String(),
+		Request:       _CassetteNormalize(request),
+	}
+
+	if err != nil {
+		grpcStatus := status.Convert(err)
+		interaction.ErrorCode = grpcStatus.Code()
+		interaction.ErrorMessage = grpcStatus.Message()
+	} else {
+		data, marshalErr := protobuf.Marshal(response)
+		if marshalErr != nil {
+			return
+		}
+		interaction.Response = data
+	}
+
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	cassette.interactions = append(cassette.interactions, interaction)
+	cassette.used = append(cassette.used, false)
+}
+
+// _Replay unmarshals the first unused interaction matching request into response, or returns its gRPC error.
+func (cassette *Cassette) _Replay(nodeAccountID AccountID, request protobuf.Message, response protobuf.Message) error {
+	operation := services.HederaFunctionality(_RequestTypeFromRequest(request)).String()
+	normalized := _CassetteNormalize(request)
+
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	for i, interaction := range cassette.interactions {
+		if cassette.used[i] || interaction.Operation != operation || !bytes.Equal(interaction.Request, normalized) {
+			continue
+		}
+
+		cassette.used[i] = true
+		if interaction.ErrorCode != codes.OK {
+			return status.Error(interaction.ErrorCode, interaction.ErrorMessage)
+		}
+
+		return protobuf.Unmarshal(interaction.Response, response)
+	}
+
+	return ErrCassetteMiss{NodeAccountID: nodeAccountID, Operation: operation}
+}
+
+// _CassetteNormalize returns the deterministic encoding of a request without the parts which change between
+// sessions: transaction valid starts, node account IDs, signatures and query payments.
+func _CassetteNormalize(request protobuf.Message) []byte {
+	normalized := protobuf.Clone(request)
+	_CassetteNormalizeMessage(normalized.ProtoReflect())
+
+	data, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(normalized)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+func _CassetteNormalizeMessage(message protoreflect.Message) {
+	switch value := message.Interface().(type) {
+	case *services.Transaction:
+		_CassetteNormalizeTransaction(value)
+		return
+	case *services.TransactionBody:
+		value.NodeAccountID = nil
+	case *services.TransactionID:
+		value.TransactionValidStart = nil
+	case *services.QueryHeader:
+		value.Payment = nil
+	}
+
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.Kind() != protoreflect.MessageKind && field.Kind() != protoreflect.GroupKind {
+			return true
+		}
+
+		switch {
+		case field.IsList():
+			list := value.List()
+			for i := range list.Len() {
+				_CassetteNormalizeMessage(list.Get(i).Message())
+			}
+		case field.IsMap():
+			if field.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, entry protoreflect.Value) bool {
+					_CassetteNormalizeMessage(entry.Message())
+					return true
+				})
+			}
+		default:
+			_CassetteNormalizeMessage(value.Message())
+		}
+
+		return true
+	})
+}
+
+// _CassetteNormalizeTransaction replaces a transaction with its normalised body, dropping the signatures.
+func _CassetteNormalizeTransaction(transaction *services.Transaction) {
+	var signed services.SignedTransaction
+	if err := protobuf.Unmarshal(transaction.GetSignedTransactionBytes(), &signed); err != nil {
+		return
+	}
+
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(signed.GetBodyBytes(), &body); err != nil {
+		return
+	}
+	_CassetteNormalizeMessage(body.ProtoReflect())
+
+	bodyBytes, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(&body)
+	if err != nil {
+		return
+	}
+	signedBytes, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(&services.SignedTransaction{BodyBytes: bodyBytes})
+	if err != nil {
+		return
+	}
+
+	protobuf.Reset(transaction)
+	transaction.SignedTransactionBytes = signedBytes
+}
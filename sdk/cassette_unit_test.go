@@ -0,0 +1,125 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func _CassetteSession(t *testing.T, client *Client) TransactionReceipt {
+	response, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 1001}, NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+
+	return receipt
+}
+
+func TestUnitCassetteRecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	receipt := func(status services.ResponseCodeEnum) *services.Response {
+		return &services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+					Receipt: &services.TransactionReceipt{Status: status},
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{
+		status.New(codes.Unavailable, "node is UNAVAILABLE").Err(),
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+		receipt(services.ResponseCodeEnum_UNKNOWN),
+		receipt(services.ResponseCodeEnum_SUCCESS),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	recorder := NewCassette()
+	client.SetCassette(recorder)
+	assert.Same(t, recorder, client.GetCassette())
+	assert.Equal(t, StatusSuccess, _CassetteSession(t, client).Status)
+
+	interactions := recorder.GetInteractions()
+	require.Len(t, interactions, 4)
+	assert.Equal(t, codes.Unavailable, interactions[0].ErrorCode)
+	assert.Equal(t, "CryptoTransfer", interactions[1].Operation)
+	assert.Equal(t, "0.0.3", interactions[1].NodeAccountID)
+	assert.Equal(t, "TransactionGetReceipt", interactions[2].Operation)
+	assert.Equal(t, interactions[2].Request, interactions[3].Request)
+
+	path := filepath.Join(t.TempDir(), "session.json")
+	require.NoError(t, recorder.Save(path))
+
+	// the replaying client has no responses left on its server, so every answer comes from the cassette
+	replayClient, replayServer := NewMockClientAndServer([][]interface{}{{}})
+	defer replayServer.Close()
+
+	replayer, err := LoadCassette(path)
+	require.NoError(t, err)
+	assert.Equal(t, CassetteModeReplay, replayer.GetMode())
+	assert.Equal(t, 4, replayer.GetRemaining())
+
+	replayClient.SetCassette(replayer)
+	assert.Equal(t, StatusSuccess, _CassetteSession(t, replayClient).Status)
+	assert.Equal(t, 0, replayer.GetRemaining())
+
+	// a request which was not recorded fails without being retried
+	_, err = NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-2)).
+		AddHbarTransfer(AccountID{Account: 1001}, NewHbar(2)).
+		Execute(replayClient)
+	var miss ErrCassetteMiss
+	require.ErrorAs(t, err, &miss)
+	assert.Equal(t, "CryptoTransfer", miss.Operation)
+	assert.Equal(t, AccountID{Account: 3}, miss.NodeAccountID)
+}
+
+func TestUnitCassetteNormalize(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+
+	build := func() *services.Transaction {
+		transaction, err := NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			SetTransactionID(TransactionIDGenerate(AccountID{Account: 2})).
+			AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+			AddHbarTransfer(AccountID{Account: 1001}, NewHbar(1)).
+			FreezeWith(client)
+		require.NoError(t, err)
+
+		request, err := transaction.makeRequest(context.Background())
+		require.NoError(t, err)
+
+		return request.(*services.Transaction)
+	}
+
+	first, second := build(), build()
+	assert.NotEqual(t, first.GetSignedTransactionBytes(), second.GetSignedTransactionBytes())
+	assert.Equal(t, _CassetteNormalize(first), _CassetteNormalize(second))
+
+	_, err = CassetteFromBytes(nil)
+	assert.ErrorIs(t, err, errByteArrayNull)
+	assert.Equal(t, "RECORD", CassetteModeRecord.String())
+}
@@ -3,6 +3,8 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
+
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
 	"google.golang.org/grpc"
 )
@@ -18,10 +20,17 @@ type _Channel struct {
 	schedule    services.ScheduleServiceClient
 	util        services.UtilServiceClient
 	addressBook services.AddressBookServiceClient
-	client      *grpc.ClientConn
+	client      _ChannelConn
+}
+
+// _ChannelConn is the connection the service clients of a _Channel send their calls on, a *grpc.ClientConn or a
+// *_GrpcWebConn
+type _ChannelConn interface {
+	grpc.ClientConnInterface
+	Close() error
 }
 
-func _NewChannel(client *grpc.ClientConn) _Channel {
+func _NewChannel(client _ChannelConn) _Channel {
 	return _Channel{
 		client: client,
 	}
@@ -106,3 +115,16 @@ func (channel _Channel) _GetAddressBook() services.AddressBookServiceClient {
 
 	return channel.addressBook
 }
+
+// _LegacyQuery returns a call of a query method which is no longer part of the generated service clients, such as
+// the NFT list queries removed from the token service
+func (channel _Channel) _LegacyQuery(fullMethod string) func(context.Context, *services.Query, ...grpc.CallOption) (*services.Response, error) {
+	return func(ctx context.Context, in *services.Query, opts ...grpc.CallOption) (*services.Response, error) {
+		out := new(services.Response)
+		if err := channel.client.Invoke(ctx, fullMethod, in, out, opts...); err != nil {
+			return nil, err
+		}
+
+		return out, nil
+	}
+}
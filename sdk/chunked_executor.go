@@ -2,8 +2,11 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
+import "context"
+
 // ExecuteAll executes all chunks of a transaction with proper throttling handling
 func executeAll(
+	ctx context.Context,
 	tx TransactionInterface,
 	client *Client,
 ) ([]TransactionResponse, error) {
@@ -36,23 +39,20 @@ func executeAll(
 	}
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(accountID) {
-		baseTxn.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		baseTxn.SignWithSigner(client.operator.signer)
 	}
 
 	size := baseTxn.signedTransactions._Length() / baseTxn.nodeAccountIDs._Length()
 	list := make([]TransactionResponse, size)
 
 	for i := 0; i < size; i++ {
-		resp, err := _Execute(client, tx)
+		resp, err := _Execute(ctx, client, tx)
 		if err != nil {
 			return list, err
 		}
 
 		list[i] = resp.(TransactionResponse)
-		receipt, err := list[i].GetReceipt(client)
+		receipt, err := list[i].GetReceiptWithContext(ctx, client)
 		if err != nil {
 			return list, err
 		}
@@ -60,7 +60,7 @@ func executeAll(
 		// Retry in case of throttle error
 		for receipt.Status == StatusThrottledAtConsensus {
 			baseTxn.regenerateID(client)
-			resp, err := _Execute(client, tx)
+			resp, err := _Execute(ctx, client, tx)
 			if err != nil {
 				return list, err
 			}
@@ -69,7 +69,7 @@ func executeAll(
 				SetTransactionID(respTx.TransactionID).
 				SetNodeAccountIDs([]AccountID{respTx.NodeID}).
 				SetIncludeChildren(respTx.IncludeChildReceipts).
-				Execute(client)
+				ExecuteWithContext(ctx, client)
 
 			// If we get a non-throttled receipt, we can break out of the loop
 			if err == nil && receipt.Status != StatusThrottledAtConsensus {
@@ -10,7 +10,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strings"
+	"sync"
 	"time"
+
+	"google.golang.org/grpc"
 )
 
 //go:embed addressbook/mainnet.pb
@@ -50,6 +55,17 @@ type Client struct {
 	logger                     Logger
 	shard                      uint64
 	realm                      uint64
+
+	systemFiles *_SystemFileCache
+	rateLimiter *RateLimiter
+	cassette    *Cassette
+
+	interceptors *_Interceptors
+	telemetry    *_Telemetry
+
+	nodeAddresses *_NodeAddressState
+
+	mirrorRestApiBaseUrl string
 }
 
 // TransactionSigner is a closure or function that defines how transactions will be signed
@@ -59,7 +75,7 @@ type _Operator struct {
 	accountID  AccountID
 	privateKey *PrivateKey
 	publicKey  PublicKey
-	signer     TransactionSigner
+	signer     Signer
 }
 
 var mainnetMirror = []string{"mainnet-public.mirrornode.hedera.com:443"}
@@ -184,6 +200,9 @@ func _NewClient(network _Network, mirrorNetwork []string, ledgerId *LedgerID, sh
 		logger:                          defaultLogger,
 		shard:                           shard,
 		realm:                           realm,
+		systemFiles:                     &_SystemFileCache{},
+		interceptors:                    &_Interceptors{},
+		nodeAddresses:                   &_NodeAddressState{},
 	}
 
 	client.SetMirrorNetwork(mirrorNetwork)
@@ -202,6 +221,16 @@ func _NewClient(network _Network, mirrorNetwork []string, ledgerId *LedgerID, sh
 }
 
 func (client *Client) _UpdateAddressBook() {
+	// The address book of the mirror node REST API has the gRPC-web proxy endpoints, and unlike the address book
+	// query it does not need a native gRPC stream
+	if client.network.transport == TransportGrpcWeb {
+		addressbook, err := client._GetMirrorRestAddressBook(context.Background())
+		if err == nil && len(addressbook.NodeAddresses) > 0 {
+			client.SetNetworkFromAddressBook(addressbook)
+		}
+		return
+	}
+
 	addressbook, err := NewAddressBookQuery().
 		SetFileID(GetAddressBookFileIDFor(client.shard, client.realm)).
 		Execute(client)
@@ -210,6 +239,23 @@ func (client *Client) _UpdateAddressBook() {
 	}
 }
 
+func (client *Client) _GetMirrorRestAddressBook(ctx context.Context) (NodeAddressBook, error) {
+	restClient, err := client.GetMirrorRestClient()
+	if err != nil {
+		return NodeAddressBook{}, err
+	}
+
+	nodes := make([]MirrorNetworkNode, 0)
+	for node, err := range restClient.NetworkNodes(ctx, nil) {
+		if err != nil {
+			return NodeAddressBook{}, err
+		}
+		nodes = append(nodes, node)
+	}
+
+	return NodeAddressBookFromMirrorNetworkNodes(nodes), nil
+}
+
 func (client *Client) _ScheduleNetworkUpdate(ctx context.Context, duration time.Duration) {
 	for {
 		select {
@@ -386,7 +432,7 @@ func clientFromConfig(jsonBytes []byte, shouldScheduleNetworkUpdate bool) (*Clie
 		accountID:  operatorID,
 		privateKey: &operatorKey,
 		publicKey:  operatorKey.PublicKey(),
-		signer:     operatorKey.Sign,
+		signer:     NewPrivateKeySigner(operatorKey),
 	}
 
 	client.operator = &operator
@@ -689,7 +735,7 @@ func (client *Client) SetOperator(accountID AccountID, privateKey PrivateKey) *C
 		accountID:  accountID,
 		privateKey: &privateKey,
 		publicKey:  privateKey.PublicKey(),
-		signer:     privateKey.Sign,
+		signer:     NewPrivateKeySigner(privateKey),
 	}
 
 	return client
@@ -703,6 +749,20 @@ func (client *Client) SetOperatorWith(accountID AccountID, publicKey PublicKey,
 		accountID:  accountID,
 		privateKey: nil,
 		publicKey:  publicKey,
+		signer:     NewSignerFromTransactionSigner(publicKey, signer),
+	}
+
+	return client
+}
+
+// SetOperatorWithSigner sets that account that will, by default, be paying for
+// transactions and queries built with the client and the Signer used to sign them,
+// e.g. one backed by an HSM or a KMS. Signing errors are returned from Execute.
+func (client *Client) SetOperatorWithSigner(accountID AccountID, signer Signer) *Client {
+	client.operator = &_Operator{
+		accountID:  accountID,
+		privateKey: nil,
+		publicKey:  signer.PublicKey(),
 		signer:     signer,
 	}
 
@@ -755,9 +815,128 @@ func (client *Client) PingAll() {
 	}
 }
 
-// SetNetworkFromAddressBook replaces all nodes in this Client with the nodes in the Address Book.
+// PingAllWithResults sends an AccountBalanceQuery to every node concurrently, like PingAll, and returns the latency
+// and error of every node ordered by account ID.
+func (client *Client) PingAllWithResults() []PingResult {
+	accountIDs := make(map[AccountID]bool)
+	for _, accountID := range client.GetNetwork() {
+		accountIDs[accountID] = true
+	}
+
+	results := make([]PingResult, 0, len(accountIDs))
+	for accountID := range accountIDs {
+		results = append(results, PingResult{AccountID: accountID})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].AccountID.Compare(results[j].AccountID) < 0
+	})
+
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(result *PingResult) {
+			defer wg.Done()
+
+			start := time.Now()
+			result.Err = client.Ping(result.AccountID)
+			result.Latency = time.Since(start)
+		}(&results[i])
+	}
+	wg.Wait()
+
+	return results
+}
+
+// GetNetworkHealth returns the statistics the client keeps about every node address, such as whether it is
+// healthy, its backoff, average latency and last error, ordered by account ID and address.
+func (client *Client) GetNetworkHealth() []NodeHealth {
+	return client.network._GetHealth()
+}
+
+// SetNetworkFromAddressBook replaces all nodes in this Client with the nodes in the Address Book which pass the
+// node address filters. The address book is ignored if the filters leave no nodes.
 func (client *Client) SetNetworkFromAddressBook(addressBook NodeAddressBook) *Client {
-	client.network._SetNetworkFromAddressBook(addressBook)
+	book := NodeAddressBook{NodeAddresses: append([]NodeAddress(nil), addressBook.NodeAddresses...)}
+
+	client.nodeAddresses.mutex.Lock()
+	client.nodeAddresses.book = &book
+
+	filtered := _FilterNodeAddressBook(book, client.nodeAddresses.filters)
+	if len(filtered.NodeAddresses) == 0 && len(book.NodeAddresses) > 0 {
+		client.nodeAddresses.mutex.Unlock()
+		client.logger.Warn("node address filters left no nodes, keeping the current network")
+		return client
+	}
+
+	previous := client.network._GetAddressBook()._ToMap()
+	client.network._SetNetworkFromAddressBook(filtered)
+	handler := client.nodeAddresses.changeHandler
+	client.nodeAddresses.mutex.Unlock()
+
+	// The handler is called without holding the lock, so that it can use the client
+	change := _NetworkChangeFromAddressBooks(previous, filtered._ToMap())
+	if handler != nil && !change.IsEmpty() {
+		handler(change)
+	}
+
+	return client
+}
+
+// GetNodeAddressBook returns the address book the network was last set from, including the nodes left out by the
+// node address filters, ordered as received. Before the first network update it returns the address book the client
+// was created with, if any.
+func (client *Client) GetNodeAddressBook() NodeAddressBook {
+	client.nodeAddresses.mutex.RLock()
+	defer client.nodeAddresses.mutex.RUnlock()
+
+	if client.nodeAddresses.book == nil {
+		return client.network._GetAddressBook()
+	}
+
+	return NodeAddressBook{NodeAddresses: append([]NodeAddress(nil), client.nodeAddresses.book.NodeAddresses...)}
+}
+
+// SetNodeAddressFilters sets the filters applied, in order, to the address books the network is set from, such as
+// the ones fetched by the scheduled network update. If the network was already set from an address book, it is set
+// again with the new filters.
+func (client *Client) SetNodeAddressFilters(filters ...NodeAddressFilter) *Client {
+	client.nodeAddresses.mutex.Lock()
+	client.nodeAddresses.filters = append([]NodeAddressFilter(nil), filters...)
+	book := client.nodeAddresses.book
+	client.nodeAddresses.mutex.Unlock()
+
+	if book != nil {
+		client.SetNetworkFromAddressBook(*book)
+	}
+
+	return client
+}
+
+// SetTransport sets the protocol used to send requests to consensus nodes, TransportGrpc by default. With
+// TransportGrpcWeb the client uses the gRPC-web proxy endpoints of the address book the network was set from, and the
+// scheduled network updates fetch the address book from the mirror node REST API. If the address book has no such
+// endpoints, the node addresses set with SetNetwork are used as they are.
+func (client *Client) SetTransport(transport Transport) *Client {
+	if err := client.network._SetTransport(transport); err != nil {
+		client.logger.Warn("failed to close node connections", "error", err)
+	}
+
+	return client
+}
+
+// GetTransport returns the protocol used to send requests to consensus nodes.
+func (client *Client) GetTransport() Transport {
+	return client.network.transport
+}
+
+// SetNetworkChangeHandler sets a handler which is called when setting the network from an address book adds,
+// removes or updates nodes. The handler is called on the goroutine which updates the network.
+func (client *Client) SetNetworkChangeHandler(handler func(NetworkChange)) *Client {
+	client.nodeAddresses.mutex.Lock()
+	defer client.nodeAddresses.mutex.Unlock()
+
+	client.nodeAddresses.changeHandler = handler
 	return client
 }
 
@@ -791,6 +970,92 @@ func (client *Client) GetDefaultMaxTransactionFee() Hbar {
 	return client.defaultMaxTransactionFee
 }
 
+// SetRateLimiter sets the client-side limiter every transaction and query attempt waits on before it is sent.
+// A nil limiter, the default, sends requests without delay.
+func (client *Client) SetRateLimiter(limiter *RateLimiter) *Client {
+	client.rateLimiter = limiter
+	return client
+}
+
+// GetRateLimiter returns the client-side limiter, or nil when requests are not limited.
+func (client *Client) GetRateLimiter() *RateLimiter {
+	return client.rateLimiter
+}
+
+// SetNodeSelector sets the strategy which chooses the nodes transactions and queries are sent to when they have no
+// node account IDs set, such as NewRoundRobinNodeSelector, NewLeastLatencyNodeSelector, NewWeightedNodeSelector or a
+// PinningNodeSelector. A nil selector restores the default random selection.
+func (client *Client) SetNodeSelector(selector NodeSelector) *Client {
+	client.network.selector = selector
+	return client
+}
+
+// GetNodeSelector returns the node selector set on the client, or nil if nodes are selected randomly.
+func (client *Client) GetNodeSelector() NodeSelector {
+	return client.network.selector
+}
+
+// SetCassette sets the cassette which records the requests sent to the consensus nodes, or replays recorded
+// responses instead of contacting the nodes. A nil cassette, the default, disables recording and replaying.
+func (client *Client) SetCassette(cassette *Cassette) *Client {
+	client.cassette = cassette
+	return client
+}
+
+// GetCassette returns the cassette set on the client, or nil.
+func (client *Client) GetCassette() *Cassette {
+	return client.cassette
+}
+
+// AddUnaryInterceptor adds a gRPC interceptor which is called for every unary call to the consensus and mirror
+// nodes, after the interceptors added before it. Interceptors can add metadata such as proxy authentication headers,
+// log or time the calls, or fail them.
+func (client *Client) AddUnaryInterceptor(interceptor grpc.UnaryClientInterceptor) *Client {
+	client._GetInterceptors()._AddUnary(interceptor)
+	return client
+}
+
+// AddStreamInterceptor adds a gRPC interceptor which is called for every streaming call to the consensus and mirror
+// nodes, such as topic subscriptions and address book queries, after the interceptors added before it.
+func (client *Client) AddStreamInterceptor(interceptor grpc.StreamClientInterceptor) *Client {
+	client._GetInterceptors()._AddStream(interceptor)
+	return client
+}
+
+// AddMiddleware adds a middleware which wraps every attempt of a transaction or query execution, inside the
+// middleware added before it. Unlike gRPC interceptors, middleware sees the executable, the node, the attempt number
+// and the request and response protobufs.
+func (client *Client) AddMiddleware(middleware Middleware) *Client {
+	client._GetInterceptors()._AddMiddleware(middleware)
+	return client
+}
+
+// SetTelemetry sets the Telemetry which receives every Execute call with its attempts, the node health transitions
+// and the receipt polling of GetReceipt. The sdk/opentelemetry package provides one recording OpenTelemetry spans and
+// metrics. A nil telemetry, the default, records nothing.
+func (client *Client) SetTelemetry(telemetry Telemetry) *Client {
+	client.telemetry = _NewTelemetry(telemetry)
+	client.network._SetTelemetry(client.telemetry)
+	return client
+}
+
+// GetTelemetry returns the Telemetry set on the client, or nil.
+func (client *Client) GetTelemetry() Telemetry {
+	if client.telemetry == nil {
+		return nil
+	}
+
+	return client.telemetry.telemetry
+}
+
+func (client *Client) _GetInterceptors() *_Interceptors {
+	if client.interceptors == nil {
+		client.interceptors = &_Interceptors{}
+	}
+
+	return client.interceptors
+}
+
 func (client *Client) SetLogger(logger Logger) *Client {
 	client.logger = logger
 	return client
@@ -805,7 +1070,19 @@ func (client *Client) SetLogLevel(level LogLevel) *Client {
 	return client
 }
 
+// SetMirrorRestApiBaseUrl sets the base URL of the mirror node REST API, e.g. `http://127.0.0.1:5551/api/v1`,
+// instead of deriving it from the mirror network. An empty URL restores the derived one.
+func (client *Client) SetMirrorRestApiBaseUrl(baseURL string) *Client {
+	client.mirrorRestApiBaseUrl = strings.TrimSuffix(baseURL, "/")
+	return client
+}
+
+// GetMirrorRestApiBaseUrl returns the base URL of the mirror node REST API.
 func (client *Client) GetMirrorRestApiBaseUrl() (string, error) {
+	if client.mirrorRestApiBaseUrl != "" {
+		return client.mirrorRestApiBaseUrl, nil
+	}
+
 	mirrorNode, err := client.mirrorNetwork._GetNextMirrorNode()
 	if err != nil {
 		return "", err
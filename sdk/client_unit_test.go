@@ -461,3 +461,25 @@ func TestUnitClientGetMirrorRestApiBaseUrlLocalHost(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitClientSetMirrorRestApiBaseUrl(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorNetwork([]string{"127.0.0.1:5600"})
+
+	client.SetMirrorRestApiBaseUrl("http://127.0.0.1:8081/api/v1/")
+	baseURL, err := client.GetMirrorRestApiBaseUrl()
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:8081/api/v1", baseURL)
+
+	restClient, err := client.GetMirrorRestClient()
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:8081/api/v1", restClient.GetBaseURL())
+
+	client.SetMirrorRestApiBaseUrl("")
+	baseURL, err = client.GetMirrorRestApiBaseUrl()
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:5551/api/v1", baseURL)
+}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -119,7 +120,12 @@ func (q *ContractCallQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *ContractCallQuery) Execute(client *Client) (ContractFunctionResult, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, returning ctx.Err() if ctx is cancelled before it completes
+func (q *ContractCallQuery) ExecuteWithContext(ctx context.Context, client *Client) (ContractFunctionResult, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return ContractFunctionResult{}, err
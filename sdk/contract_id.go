@@ -3,10 +3,9 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 
@@ -169,23 +168,13 @@ func (id ContractID) ToEvmAddress() string {
 // Should be used after generating `ContractId.FromEvmAddress()` because it sets the `Contract` field to `0`
 // automatically since there is no connection between the `Contract` and the `evmAddress`
 func (id *ContractID) PopulateContract(client *Client) error {
-	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
-		return errors.New("mirror node is not set")
-	}
-	mirrorUrl, err := client.GetMirrorRestApiBaseUrl()
-	if err != nil {
-		return err
-	}
-	url := fmt.Sprintf("%s/contracts/%s", mirrorUrl, hex.EncodeToString(id.EvmAddress))
-
-	resp, err := http.Get(url) // #nosec
+	restClient, err := client.GetMirrorRestClient()
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 
 	var result map[string]any
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := restClient.get(context.Background(), fmt.Sprintf("/contracts/%s", hex.EncodeToString(id.EvmAddress)), nil, &result); err != nil {
 		return err
 	}
 
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -52,7 +53,12 @@ func (q *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, returning ctx.Err() if ctx is cancelled before it completes
+func (q *ContractInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (ContractInfo, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return ContractInfo{}, err
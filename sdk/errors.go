@@ -38,6 +38,7 @@ var errNodeIdIsRequired = errors.New("nodeID is required")
 var errEvmAddressIsNotALongZeroAddress = errors.New("EVM address is not a correct long zero address")
 var errEvmAddressIsNotCorrectSize = errors.New("EVM address is not the correct size")
 var errInvalidChunkSize = errors.New("chunk size must be greater than 0")
+var errMirrorNodeNotSet = errors.New("mirror node is not set")
 
 // Endpoint validation errors
 var errEndpointMustHaveAddressOrDomainName = errors.New("endpoint must have either address or domain name")
@@ -167,3 +168,20 @@ type ErrLocalValidation struct {
 func (e ErrLocalValidation) Error() string {
 	return e.message
 }
+
+// ErrSigningFailed is returned when a Signer fails to produce a signature while a transaction
+// or query payment is being built.
+type ErrSigningFailed struct {
+	PublicKey PublicKey
+	Err       error
+}
+
+// Error() implements the Error interface
+func (e ErrSigningFailed) Error() string {
+	return fmt.Sprintf("failed to sign with key %s: %s", e.PublicKey.String(), e.Err)
+}
+
+// Unwrap returns the error reported by the Signer
+func (e ErrSigningFailed) Unwrap() error {
+	return e.Err
+}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"encoding/hex"
 
 	"github.com/pkg/errors"
@@ -90,7 +91,7 @@ func (transaction *EthereumFlow) GetNodeAccountIDs() []AccountID {
 	return transaction.nodeAccountIDs
 }
 
-func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (FileID, error) {
+func (transaction *EthereumFlow) _CreateFile(ctx context.Context, callData []byte, client *Client) (FileID, error) {
 	// The calldata in the file needs to be hex encoded
 	callDataHex := []byte(hex.EncodeToString(callData))
 	fileCreate := NewFileCreateTransaction().SetKeys(client.GetOperatorPublicKey())
@@ -101,12 +102,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 	if len(callDataHex) < 4097 {
 		resp, err := fileCreate.
 			SetContents(callDataHex).
-			Execute(client)
+			ExecuteWithContext(ctx, client)
 		if err != nil {
 			return FileID{}, err
 		}
 
-		receipt, err := resp.GetReceipt(client)
+		receipt, err := resp.GetReceiptWithContext(ctx, client)
 		if err != nil {
 			return FileID{}, err
 		}
@@ -116,12 +117,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 
 	resp, err := fileCreate.
 		SetContents(callDataHex[:4097]).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
 
-	receipt, err := resp.GetReceipt(client)
+	receipt, err := resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
@@ -132,12 +133,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 		SetFileID(fileID).
 		SetContents(callDataHex[4097:]).
 		SetMaxChunks(1000).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
 
-	_, err = resp.GetReceipt(client)
+	_, err = resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
@@ -147,6 +148,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 
 // Execute executes the Transaction with the provided client
 func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, returning ctx.Err() if ctx is cancelled
+// before the ethereum transaction has reached consensus
+func (transaction *EthereumFlow) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	if transaction.ethereumData == nil {
 		return TransactionResponse{}, errors.New("cannot submit ethereum transaction with no ethereum data")
 	}
@@ -177,7 +184,7 @@ func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, e
 			SetEthereumData(dataBytes)
 	} else {
 		fileID, err := transaction.
-			_CreateFile(transaction.ethereumData.GetData(), client)
+			_CreateFile(ctx, transaction.ethereumData.GetData(), client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
@@ -194,12 +201,12 @@ func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, e
 	}
 
 	resp, err := ethereumTransaction.
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return resp, err
 	}
 
-	_, err = resp.GetReceipt(client)
+	_, err = resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return resp, err
 	}
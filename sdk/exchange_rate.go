@@ -4,6 +4,8 @@ package hiero
 
 import (
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
 	protobuf "google.golang.org/protobuf/proto"
@@ -16,6 +18,46 @@ type ExchangeRate struct {
 	expirationTime *services.TimestampSeconds
 }
 
+// NewExchangeRate returns an ExchangeRate of hbars to cents, e.g. to estimate fees offline
+func NewExchangeRate(hbars int32, cents int32) ExchangeRate {
+	return ExchangeRate{
+		Hbars: hbars,
+		cents: cents,
+	}
+}
+
+// GetCents returns the number of cents which are worth Hbars
+func (exchange *ExchangeRate) GetCents() int32 {
+	return exchange.cents
+}
+
+// GetExpirationTime returns the time the exchange rate expires, or the zero time when it has no expiration time
+func (exchange *ExchangeRate) GetExpirationTime() time.Time {
+	if exchange.expirationTime == nil {
+		return time.Time{}
+	}
+
+	return time.Unix(exchange.expirationTime.Seconds, 0)
+}
+
+// HbarToCents returns the value of hbar in US cents
+func (exchange *ExchangeRate) HbarToCents(hbar Hbar) float64 {
+	if exchange.Hbars == 0 {
+		return 0
+	}
+
+	return float64(hbar.AsTinybar()) * float64(exchange.cents) / float64(exchange.Hbars) / float64(HbarUnits.Hbar._NumberOfTinybar())
+}
+
+// CentsToHbar returns the hbar worth the given US cents, rounded to the nearest tinybar
+func (exchange *ExchangeRate) CentsToHbar(cents float64) Hbar {
+	if exchange.cents == 0 {
+		return Hbar{}
+	}
+
+	return HbarFromTinybar(int64(math.Round(cents * float64(exchange.Hbars) / float64(exchange.cents) * float64(HbarUnits.Hbar._NumberOfTinybar()))))
+}
+
 func _ExchangeRateFromProtobuf(protoExchange *services.ExchangeRate) ExchangeRate {
 	if protoExchange == nil {
 		return ExchangeRate{}
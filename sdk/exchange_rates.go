@@ -0,0 +1,87 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ExchangeRates are the current and the next exchange rate between HBAR and USD, as stored in the exchange rates
+// file. The next rate takes effect when the current rate expires.
+type ExchangeRates struct {
+	Current ExchangeRate
+	Next    ExchangeRate
+}
+
+func _ExchangeRatesFromProtobuf(pb *services.ExchangeRateSet) (ExchangeRates, error) {
+	if pb == nil {
+		return ExchangeRates{}, errParameterNull
+	}
+
+	return ExchangeRates{
+		Current: _ExchangeRateFromProtobuf(pb.GetCurrentRate()),
+		Next:    _ExchangeRateFromProtobuf(pb.GetNextRate()),
+	}, nil
+}
+
+func (rates ExchangeRates) _ToProtobuf() *services.ExchangeRateSet {
+	return &services.ExchangeRateSet{
+		CurrentRate: rates.Current._ToProtobuf(),
+		NextRate:    rates.Next._ToProtobuf(),
+	}
+}
+
+// ToBytes returns the byte representation of the ExchangeRates
+func (rates ExchangeRates) ToBytes() []byte {
+	data, err := protobuf.Marshal(rates._ToProtobuf())
+	if err != nil {
+		return make([]byte, 0)
+	}
+
+	return data
+}
+
+// ExchangeRatesFromBytes returns the ExchangeRates from the contents of the exchange rates file
+func ExchangeRatesFromBytes(data []byte) (ExchangeRates, error) {
+	if data == nil {
+		return ExchangeRates{}, errByteArrayNull
+	}
+	pb := services.ExchangeRateSet{}
+	err := protobuf.Unmarshal(data, &pb)
+	if err != nil {
+		return ExchangeRates{}, err
+	}
+
+	return _ExchangeRatesFromProtobuf(&pb)
+}
+
+// At returns the exchange rate in effect at the given time: the current rate until it expires and the next rate
+// afterwards.
+func (rates ExchangeRates) At(at time.Time) ExchangeRate {
+	if expiration := rates.Current.GetExpirationTime(); !expiration.IsZero() && !at.Before(expiration) {
+		return rates.Next
+	}
+
+	return rates.Current
+}
+
+// HbarToCents returns the value of hbar in US cents at the rate in effect at the given time.
+func (rates ExchangeRates) HbarToCents(hbar Hbar, at time.Time) float64 {
+	rate := rates.At(at)
+	return rate.HbarToCents(hbar)
+}
+
+// CentsToHbar returns the hbar worth the given US cents at the rate in effect at the given time.
+func (rates ExchangeRates) CentsToHbar(cents float64, at time.Time) Hbar {
+	rate := rates.At(at)
+	return rate.CentsToHbar(cents)
+}
+
+// String returns a string representation of the ExchangeRates
+func (rates ExchangeRates) String() string {
+	return fmt.Sprintf("Current: %s, Next: %s", rates.Current.String(), rates.Next.String())
+}
@@ -41,7 +41,8 @@ type Executable interface {
 	GetLogLevel() *LogLevel
 
 	shouldRetry(Executable, any) _ExecutionState
-	makeRequest() any
+	makeRequest(ctx context.Context) (any, error)
+	getRequestType() RequestType
 	advanceRequest()
 	getNodeAccountID() AccountID
 	getMethod(*_Channel) _Method
@@ -189,8 +190,23 @@ func (e *executable) getNodeAccountID() AccountID {
 	return e.nodeAccountIDs._GetCurrent().(AccountID)
 }
 
+func _Execute(ctx context.Context, client *Client, e Executable) (any, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, execution := client.telemetry._StartExecution(ctx, e)
+	resp, err := _ExecuteAttempts(ctx, client, e, execution)
+	execution._End(err)
+
+	return resp, err
+}
+
 // nolint
-func _Execute(client *Client, e Executable) (any, error) {
+func _ExecuteAttempts(ctx context.Context, client *Client, e Executable, execution *_ExecutionSpan) (any, error) {
+	var attemptSpan *_AttemptSpan
+	defer func() { attemptSpan._End() }()
+
 	var maxAttempts int
 
 	if client.maxAttempts != nil {
@@ -216,25 +232,61 @@ func _Execute(client *Client, e Executable) (any, error) {
 	}
 	startTime := time.Now()
 	for attempt = int64(0); attempt < int64(maxAttempts); attempt++ {
+		if err := ctx.Err(); err != nil {
+			if e.isTransaction() {
+				return TransactionResponse{}, err
+			}
+
+			return &services.Response{}, err
+		}
+
 		if time.Since(startTime) >= requestTimeout {
 			return TransactionResponse{}, fmt.Errorf("request timed out after %s", requestTimeout)
 		}
 
+		attemptSpan._End()
+		var attemptCtx context.Context
+		attemptCtx, attemptSpan = client.telemetry._StartAttempt(ctx, execution, e, attempt)
+
 		var protoRequest any
 		var node *_Node
 		var ok bool
+		var err error
 
 		// If this is not the first attempt, double the backoff time up to the max backoff time
 		if attempt > 0 && currentBackoff <= e.GetMaxBackoff() {
 			currentBackoff *= 2
 		}
 
-		protoRequest = e.makeRequest()
+		// Wait for the rate limiter before the request is made, so signatures and query payments are not created
+		// for a request which then waits or is cancelled
+		requestType := e.getRequestType()
+		if client.rateLimiter != nil {
+			if err = client.rateLimiter.Wait(ctx, requestType); err != nil {
+				if e.isTransaction() {
+					return TransactionResponse{}, err
+				}
+
+				return &services.Response{}, err
+			}
+		}
+
+		protoRequest, err = e.makeRequest(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+			if e.isTransaction() {
+				return TransactionResponse{}, err
+			}
+
+			return &services.Response{}, err
+		}
 		if e.isBatchedAndNotBatchTransaction() {
 			return TransactionResponse{}, errBatchedAndNotBatchTransaction
 		}
 		if len(e.GetNodeAccountIDs()) == 0 {
-			node = client.network._GetNode()
+			node = client.network._SelectNode(requestType)
 		} else {
 			nodeAccountID := e.getNodeAccountID()
 			if node, ok = client.network._GetNodeForAccountID(nodeAccountID); !ok {
@@ -249,18 +301,29 @@ func _Execute(client *Client, e Executable) (any, error) {
 		}
 
 		node._InUse()
+		attemptSpan._SetNode(node)
 
 		txLogger.Trace("executing", "requestId", e.getLogID(e), "nodeAccountID", node.accountID.String(), "nodeIPAddress", node.address._String(), "Request Proto", hex.EncodeToString(marshaledRequest))
 
 		if !node._IsHealthy() {
 			txLogger.Trace("node is unhealthy, waiting before continuing", "requestId", e.getLogID(e), "delay", node._Wait().String())
-			_DelayForAttempt(e.getLogID(e), currentBackoff, attempt, txLogger, errNodeIsUnhealthy)
+			attemptSpan._SetError(errNodeIsUnhealthy)
+			attemptSpan._SetBackoff(currentBackoff)
+			if err := _DelayForAttempt(ctx, e.getLogID(e), currentBackoff, attempt, txLogger, errNodeIsUnhealthy); err != nil {
+				if e.isTransaction() {
+					return TransactionResponse{}, err
+				}
+
+				return &services.Response{}, err
+			}
 			continue
 		}
 
 		txLogger.Trace("updating node account ID index", "requestId", e.getLogID(e))
-		channel, err := node._GetChannel(txLogger)
+		channel, err := node._GetChannel(txLogger, client.interceptors)
 		if err != nil {
+			attemptSpan._SetError(err)
+			node._RecordError(err)
 			client.network._IncreaseBackoff(node)
 			e.advanceRequest()
 			errPersistent = err
@@ -268,6 +331,10 @@ func _Execute(client *Client, e Executable) (any, error) {
 		}
 
 		method := e.getMethod(channel)
+		if client.cassette != nil {
+			method = client.cassette._Wrap(node.accountID, method)
+		}
+		method = client.interceptors._Wrap(e, node, attempt, method)
 
 		var resp any
 
@@ -277,25 +344,39 @@ func _Execute(client *Client, e Executable) (any, error) {
 		} else {
 			grpcDeadline = client.GetGrpcDeadline()
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), grpcDeadline)
-		defer cancel()
+		grpcCtx, cancel := context.WithTimeout(attemptCtx, grpcDeadline)
 
 		txLogger.Trace("executing gRPC call", "requestId", e.getLogID(e))
 
 		var marshaledResponse []byte
+		requestStart := time.Now()
 		if method.query != nil {
-			resp, err = method.query(ctx, protoRequest.(*services.Query))
+			resp, err = method.query(grpcCtx, protoRequest.(*services.Query))
 			if err == nil {
 				marshaledResponse, _ = protobuf.Marshal(resp.(*services.Response))
 			}
 		} else {
-			resp, err = method.transaction(ctx, protoRequest.(*services.Transaction))
+			resp, err = method.transaction(grpcCtx, protoRequest.(*services.Transaction))
 			if err == nil {
 				marshaledResponse, _ = protobuf.Marshal(resp.(*services.TransactionResponse))
 			}
 		}
+		cancel()
 
 		if err != nil {
+			attemptSpan._SetError(err)
+
+			// The caller gave up on the request, so the failure is not the node's fault
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				if e.isTransaction() {
+					return TransactionResponse{}, ctxErr
+				}
+
+				return &services.Response{}, ctxErr
+			}
+
+			node._RecordError(err)
+
 			e.advanceRequest()
 			errPersistent = err
 			if _ExecutableDefaultRetryHandler(e.getLogID(e), err, txLogger) {
@@ -310,9 +391,11 @@ func _Execute(client *Client, e Executable) (any, error) {
 			return &services.Response{}, errors.Wrapf(errPersistent, "retry %d/%d", attempt, maxAttempts)
 		}
 
+		node._RecordLatency(time.Since(requestStart))
 		node._DecreaseBackoff()
 
 		statusError := e.mapStatusError(e, resp)
+		attemptSpan._SetStatus(statusError)
 
 		var network = "unknown"
 		if client.GetLedgerID() != nil {
@@ -332,7 +415,17 @@ func _Execute(client *Client, e Executable) (any, error) {
 		switch e.shouldRetry(e, resp) {
 		case executionStateRetry:
 			errPersistent = statusError
-			_DelayForAttempt(e.getLogID(e), currentBackoff, attempt, txLogger, errPersistent)
+			if _IsNodeStatusError(statusError) {
+				node._RecordError(statusError)
+			}
+			attemptSpan._SetBackoff(currentBackoff)
+			if err := _DelayForAttempt(ctx, e.getLogID(e), currentBackoff, attempt, txLogger, errPersistent); err != nil {
+				if e.isTransaction() {
+					return TransactionResponse{}, err
+				}
+
+				return &services.Response{}, err
+			}
 			continue
 		case executionStateExpired:
 			if e.isTransaction() {
@@ -357,6 +450,7 @@ func _Execute(client *Client, e Executable) (any, error) {
 			return e.mapResponse(resp, node.accountID, protoRequest)
 		case executionStateRetryWithAnotherNode:
 			errPersistent = statusError
+			node._RecordError(statusError)
 			e.advanceRequest()
 			txLogger.Trace("received `INVALID_NODE_ACCOUNT`; updating addressbook and marking node as unhealthy", "requestId", e.getLogID(e), "nodeAccountId", node.accountID)
 			defer client._UpdateAddressBook()
@@ -380,10 +474,35 @@ func _Execute(client *Client, e Executable) (any, error) {
 	return &services.Response{}, errPersistent
 }
 
-func _DelayForAttempt(logID string, backoff time.Duration, attempt int64, logger Logger, err error) {
+// _DelayForAttempt waits for the backoff period before the next attempt, returning early with ctx.Err() if the context is done first
+func _DelayForAttempt(ctx context.Context, logID string, backoff time.Duration, attempt int64, logger Logger, err error) error {
 	logger.Trace("retrying request attempt", "requestId", logID, "delay", backoff, "attempt", attempt+1, "error", err)
 
-	time.Sleep(backoff)
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// _IsNodeStatusError reports whether a status returned by a node reflects the state of the node itself, rather than
+// the state of the request such as a receipt which is not available yet
+func _IsNodeStatusError(err error) bool {
+	var precheckErr ErrHederaPreCheckStatus
+	if !errors.As(err, &precheckErr) {
+		return false
+	}
+
+	switch precheckErr.Status {
+	case StatusBusy, StatusPlatformNotActive, StatusPlatformTransactionNotCreated, StatusInvalidNodeAccount, StatusInvalidNodeAccountId:
+		return true
+	default:
+		return false
+	}
 }
 
 func _ExecutableDefaultRetryHandler(logID string, err error, logger Logger) bool {
@@ -13,6 +13,7 @@ type FeeData struct {
 	NodeData    *FeeComponents
 	NetworkData *FeeComponents
 	ServiceData *FeeComponents
+	SubType     FeeDataType
 }
 
 func _FeeDataFromProtobuf(feeData *services.FeeData) (FeeData, error) {
@@ -39,6 +40,7 @@ func _FeeDataFromProtobuf(feeData *services.FeeData) (FeeData, error) {
 		NodeData:    &nodeData,
 		NetworkData: &networkData,
 		ServiceData: &serviceData,
+		SubType:     FeeDataType(feeData.GetSubType()),
 	}, nil
 }
 
@@ -62,6 +64,7 @@ func (feeData FeeData) _ToProtobuf() *services.FeeData {
 		Nodedata:    nodeData,
 		Networkdata: networkData,
 		Servicedata: serviceData,
+		SubType:     services.SubType(feeData.SubType),
 	}
 }
 
@@ -96,5 +99,5 @@ func FeeDataFromBytes(data []byte) (FeeData, error) {
 
 // String returns a string representation of the FeeData
 func (feeData FeeData) String() string {
-	return fmt.Sprintf("\nNodedata: %s\nNetworkdata: %s\nServicedata: %s\n", feeData.NodeData.String(), feeData.NetworkData.String(), feeData.ServiceData.String())
+	return fmt.Sprintf("\nSubType: %s\nNodedata: %s\nNetworkdata: %s\nServicedata: %s\n", feeData.SubType.String(), feeData.NodeData.String(), feeData.NetworkData.String(), feeData.ServiceData.String())
 }
@@ -0,0 +1,49 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+)
+
+// FeeDataType is the sub-type of a transaction or query which a FeeData of a TransactionFeeSchedule prices, e.g.
+// fungible and non-fungible token mints are priced differently.
+type FeeDataType int32
+
+const (
+	FeeDataTypeDefault                              FeeDataType = 0
+	FeeDataTypeTokenFungibleCommon                  FeeDataType = 1
+	FeeDataTypeTokenNonFungibleUnique               FeeDataType = 2
+	FeeDataTypeTokenFungibleCommonWithCustomFees    FeeDataType = 3
+	FeeDataTypeTokenNonFungibleUniqueWithCustomFees FeeDataType = 4
+	FeeDataTypeScheduleCreateContractCall           FeeDataType = 5
+	FeeDataTypeTopicCreateWithCustomFees            FeeDataType = 6
+	FeeDataTypeSubmitMessageWithCustomFees          FeeDataType = 7
+	FeeDataTypeCryptoTransferWithHooks              FeeDataType = 8
+)
+
+// String returns a string representation of the FeeDataType
+func (feeDataType FeeDataType) String() string {
+	switch feeDataType {
+	case FeeDataTypeDefault:
+		return "DEFAULT"
+	case FeeDataTypeTokenFungibleCommon:
+		return "TOKEN_FUNGIBLE_COMMON"
+	case FeeDataTypeTokenNonFungibleUnique:
+		return "TOKEN_NON_FUNGIBLE_UNIQUE"
+	case FeeDataTypeTokenFungibleCommonWithCustomFees:
+		return "TOKEN_FUNGIBLE_COMMON_WITH_CUSTOM_FEES"
+	case FeeDataTypeTokenNonFungibleUniqueWithCustomFees:
+		return "TOKEN_NON_FUNGIBLE_UNIQUE_WITH_CUSTOM_FEES"
+	case FeeDataTypeScheduleCreateContractCall:
+		return "SCHEDULE_CREATE_CONTRACT_CALL"
+	case FeeDataTypeTopicCreateWithCustomFees:
+		return "TOPIC_CREATE_WITH_CUSTOM_FEES"
+	case FeeDataTypeSubmitMessageWithCustomFees:
+		return "SUBMIT_MESSAGE_WITH_CUSTOM_FEES"
+	case FeeDataTypeCryptoTransferWithHooks:
+		return "CRYPTO_TRANSFER_WITH_HOOKS"
+	}
+
+	return fmt.Sprintf("UNKNOWN(%d)", int32(feeDataType))
+}
@@ -0,0 +1,477 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+//go:embed fee_schedule/fee_schedule.pb
+var bundledFeeSchedules []byte
+
+// The sizes mirror the ones used by the network's usage estimators.
+const (
+	feeEstimateDivisor                = 1000
+	feeEstimateHourSeconds            = 3600
+	feeEstimateReceiptStorageSeconds  = 180
+	feeEstimateDefaultLifetimeSeconds = 7890000
+	feeEstimateIntSize                = 4
+	feeEstimateBoolSize               = 4
+	feeEstimateLongSize               = 8
+	feeEstimateTransactionHashSize    = 48
+	feeEstimateEntityIDSize           = 3 * feeEstimateLongSize
+	feeEstimateAccountAmountSize      = feeEstimateEntityIDSize + feeEstimateLongSize
+	feeEstimateExchangeRateSize       = 2*feeEstimateIntSize + feeEstimateLongSize
+	feeEstimateReceiptSize            = feeEstimateIntSize + 2*feeEstimateExchangeRateSize
+	feeEstimateRecordSize             = feeEstimateReceiptSize + feeEstimateTransactionHashSize + 2*feeEstimateLongSize + feeEstimateEntityIDSize + feeEstimateLongSize
+	feeEstimateAccountSize            = 4*feeEstimateLongSize + 3*feeEstimateBoolSize
+	feeEstimateTopicSize              = 2*feeEstimateEntityIDSize + 3*feeEstimateLongSize + feeEstimateTransactionHashSize
+	feeEstimateFileSize               = feeEstimateEntityIDSize + feeEstimateLongSize + feeEstimateBoolSize
+	feeEstimateSignatureSize          = 64
+)
+
+var errFeeEstimateExchangeRate = errors.New("exchange rate must have a positive number of hbars and cents")
+
+// ErrFeeScheduleNotFound is returned by EstimateFee when the fee schedule does not price the transaction.
+type ErrFeeScheduleNotFound struct {
+	RequestType RequestType
+}
+
+func (err ErrFeeScheduleNotFound) Error() string {
+	return fmt.Sprintf("fee schedule has no fees for %s", err.RequestType.String())
+}
+
+// FeeEstimate is the fee the network is expected to charge for a transaction, split into its components.
+type FeeEstimate struct {
+	RequestType RequestType
+	SubType     FeeDataType
+	// NodeFee is paid to the node which submits the transaction.
+	NodeFee Hbar
+	// NetworkFee pays for consensus and for storing the receipt.
+	NetworkFee Hbar
+	// ServiceFee pays for the work of the transaction and for the state it stores.
+	ServiceFee Hbar
+	// Chunks is the number of transactions a chunked transaction is submitted as. The fees are the ones of the
+	// most expensive chunk.
+	Chunks int
+}
+
+// Total returns the sum of the node, network and service fees of one transaction.
+func (estimate FeeEstimate) Total() Hbar {
+	return HbarFromTinybar(estimate.NodeFee.AsTinybar() + estimate.NetworkFee.AsTinybar() + estimate.ServiceFee.AsTinybar())
+}
+
+// String returns a string representation of the FeeEstimate
+func (estimate FeeEstimate) String() string {
+	return fmt.Sprintf("RequestType: %s, SubType: %s, Node: %s, Network: %s, Service: %s, Chunks: %d",
+		estimate.RequestType.String(), estimate.SubType.String(), estimate.NodeFee.String(), estimate.NetworkFee.String(), estimate.ServiceFee.String(), estimate.Chunks)
+}
+
+// BundledFeeSchedules returns the fee schedules shipped with the SDK. They are a snapshot and may differ from the
+// fees the network currently charges.
+func BundledFeeSchedules() (FeeSchedules, error) {
+	return FeeSchedulesFromBytes(bundledFeeSchedules)
+}
+
+// EstimateFee estimates the fee the network charges for tx without contacting it, so it can be used to set
+// SetMaxTransactionFee or to warn before submitting. For chunked transactions the fee of the most expensive chunk is
+// returned, since the maximum transaction fee applies to every chunk. See EstimateFeeDetails.
+func EstimateFee(tx TransactionInterface, schedule FeeSchedules, rate ExchangeRate) (Hbar, error) {
+	estimate, err := EstimateFeeDetails(tx, schedule, rate)
+	if err != nil {
+		return Hbar{}, err
+	}
+
+	return estimate.Total(), nil
+}
+
+// EstimateFeeDetails estimates the node, network and service fees the network charges for tx from the fee schedule
+// in effect at the transaction's valid start and the exchange rate. The usage is derived from the size of the
+// transaction, its signatures, the bytes-hours of the accounts, files and topics it creates or extends, its gas
+// and the RequestType and FeeDataType of its body.
+//
+// Signatures which are not attached yet are counted for every key the transaction is to be signed with, and at least
+// one signature is counted for the payer. Transactions which are not frozen are estimated as a single transaction.
+// Appended file contents are charged for the default file lifetime since the expiry of the file is not known offline.
+func EstimateFeeDetails(tx TransactionInterface, schedule FeeSchedules, rate ExchangeRate) (FeeEstimate, error) {
+	if rate.Hbars <= 0 || rate.cents <= 0 {
+		return FeeEstimate{}, errFeeEstimateExchangeRate
+	}
+
+	baseTx := tx.getBaseTransaction()
+	bodies, err := _FeeEstimateBodies(tx)
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	estimate := FeeEstimate{Chunks: len(bodies)}
+	for _, body := range bodies {
+		chunkEstimate, err := _EstimateBodyFee(baseTx, body, schedule, rate)
+		if err != nil {
+			return FeeEstimate{}, err
+		}
+
+		if chunkEstimate.Total().AsTinybar() >= estimate.Total().AsTinybar() {
+			chunkEstimate.Chunks = estimate.Chunks
+			estimate = chunkEstimate
+		}
+	}
+
+	return estimate, nil
+}
+
+type _FeeEstimateBody struct {
+	bodyBytes []byte
+	sigPairs  []*services.SignaturePair
+}
+
+// _FeeEstimateBodies returns the body of every chunk of a frozen transaction, or the body the transaction would be
+// frozen with otherwise.
+func _FeeEstimateBodies(tx TransactionInterface) ([]_FeeEstimateBody, error) {
+	baseTx := tx.getBaseTransaction()
+
+	if !baseTx.IsFrozen() {
+		body := tx.build()
+		if body.NodeAccountID == nil {
+			// a placeholder, so the size matches the one of a frozen body
+			body.NodeAccountID = AccountID{Account: 3}._ToProtobuf()
+		}
+
+		bodyBytes, err := protobuf.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		return []_FeeEstimateBody{{bodyBytes: bodyBytes}}, nil
+	}
+
+	bodies := make([]_FeeEstimateBody, 0)
+	seen := make(map[string]bool)
+	for _, signedTransaction := range baseTx.signedTransactions.slice {
+		signed := signedTransaction.(*services.SignedTransaction)
+		var body services.TransactionBody
+		if err := protobuf.Unmarshal(signed.GetBodyBytes(), &body); err != nil {
+			return nil, err
+		}
+
+		transactionID := _TransactionIDFromProtobuf(body.TransactionID).String()
+		if seen[transactionID] {
+			continue
+		}
+		seen[transactionID] = true
+
+		bodies = append(bodies, _FeeEstimateBody{
+			bodyBytes: signed.GetBodyBytes(),
+			sigPairs:  signed.GetSigMap().GetSigPair(),
+		})
+	}
+
+	return bodies, nil
+}
+
+func _EstimateBodyFee(baseTx *Transaction[TransactionInterface], estimateBody _FeeEstimateBody, schedule FeeSchedules, rate ExchangeRate) (FeeEstimate, error) {
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(estimateBody.bodyBytes, &body); err != nil {
+		return FeeEstimate{}, err
+	}
+
+	requestType := _RequestTypeFromTransactionBody(&body)
+	if requestType == RequestTypeNone {
+		return FeeEstimate{}, ErrFeeScheduleNotFound{RequestType: requestType}
+	}
+
+	validStart := time.Now()
+	if body.TransactionID != nil && body.TransactionID.TransactionValidStart != nil {
+		validStart = _TimeFromProtobuf(body.TransactionID.TransactionValidStart)
+	}
+
+	subType := _FeeDataTypeFromTransactionBody(&body)
+	feeData, err := _FeeDataFor(schedule, validStart, requestType, subType)
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	usage := _FeeEstimateUsage(baseTx, &body, estimateBody)
+
+	return FeeEstimate{
+		RequestType: requestType,
+		SubType:     feeData.SubType,
+		NodeFee:     _FeeComponentHbar(feeData.NodeData, usage.NodeData, rate),
+		NetworkFee:  _FeeComponentHbar(feeData.NetworkData, usage.NetworkData, rate),
+		ServiceFee:  _FeeComponentHbar(feeData.ServiceData, usage.ServiceData, rate),
+		Chunks:      1,
+	}, nil
+}
+
+// _FeeDataFor returns the fee data of the schedule in effect at validStart for the request type and sub-type,
+// falling back to the default sub-type.
+func _FeeDataFor(schedules FeeSchedules, validStart time.Time, requestType RequestType, subType FeeDataType) (FeeData, error) {
+	schedule := schedules.current
+	if schedule != nil && schedule.ExpirationTime != nil && !schedule.ExpirationTime.IsZero() &&
+		validStart.After(*schedule.ExpirationTime) && schedules.next != nil && len(schedules.next.TransactionFeeSchedules) > 0 {
+		schedule = schedules.next
+	}
+
+	if schedule == nil {
+		return FeeData{}, ErrFeeScheduleNotFound{RequestType: requestType}
+	}
+
+	for _, transactionSchedule := range schedule.TransactionFeeSchedules {
+		if transactionSchedule.RequestType != requestType {
+			continue
+		}
+
+		var fallback *FeeData
+		for _, feeData := range transactionSchedule.Fees {
+			if feeData.SubType == subType {
+				return *feeData, nil
+			}
+			if feeData.SubType == FeeDataTypeDefault || fallback == nil {
+				fallback = feeData
+			}
+		}
+
+		if fallback == nil {
+			fallback = transactionSchedule.FeeData
+		}
+
+		if fallback != nil {
+			return *fallback, nil
+		}
+	}
+
+	return FeeData{}, ErrFeeScheduleNotFound{RequestType: requestType}
+}
+
+// _FeeEstimateUsage returns the resources used by the transaction, in the units the fee components price.
+func _FeeEstimateUsage(baseTx *Transaction[TransactionInterface], body *services.TransactionBody, estimateBody _FeeEstimateBody) FeeData {
+	signatures := 0
+	signatureBytes := 0
+	signed := make(map[string]bool)
+	for _, sigPair := range estimateBody.sigPairs {
+		signed[string(sigPair.GetPubKeyPrefix())] = true
+		signatures++
+		signatureBytes += protobuf.Size(sigPair)
+	}
+
+	for i, publicKey := range baseTx.publicKeys {
+		if baseTx.transactionSigners[i] == nil || signed[string(publicKey.BytesRaw())] {
+			continue
+		}
+		signed[string(publicKey.BytesRaw())] = true
+		signatures++
+		signatureBytes += protobuf.Size(publicKey._ToSignaturePairProtobuf(make([]byte, feeEstimateSignatureSize)))
+	}
+
+	if signatures == 0 {
+		signatures = 1
+		signatureBytes = protobuf.Size(&services.SignaturePair{
+			PubKeyPrefix: make([]byte, 32),
+			Signature:    &services.SignaturePair_Ed25519{Ed25519: make([]byte, feeEstimateSignatureSize)},
+		})
+	}
+
+	bpt := int64(len(estimateBody.bodyBytes) + signatureBytes)
+	transfers := 0
+	if transfer := body.GetCryptoTransfer(); transfer != nil {
+		transfers = len(transfer.GetTransfers().GetAccountAmounts())
+		for _, tokenTransfers := range transfer.GetTokenTransfers() {
+			transfers += len(tokenTransfers.GetTransfers()) + len(tokenTransfers.GetNftTransfers())
+		}
+	}
+
+	service := FeeComponents{
+		Constant:               1,
+		TransactionRamByteHour: _FeeEstimateByteHours(int64(feeEstimateRecordSize+len(body.GetMemo())+transfers*feeEstimateAccountAmountSize), feeEstimateReceiptStorageSeconds),
+	}
+	_AddEntityStorageUsage(&service, body)
+
+	return FeeData{
+		NodeData: &FeeComponents{
+			Constant:                 1,
+			TransactionBandwidthByte: bpt,
+			TransactionVerification:  1,
+			ResponseMemoryByte:       feeEstimateIntSize,
+		},
+		NetworkData: &FeeComponents{
+			Constant:                 1,
+			TransactionBandwidthByte: bpt,
+			TransactionVerification:  int64(signatures),
+			TransactionRamByteHour:   _FeeEstimateByteHours(feeEstimateReceiptSize, feeEstimateReceiptStorageSeconds),
+		},
+		ServiceData: &service,
+	}
+}
+
+// _AddEntityStorageUsage adds the state stored by accounts, files and topics, and the gas of contract transactions.
+func _AddEntityStorageUsage(service *FeeComponents, body *services.TransactionBody) {
+	validStart := time.Now()
+	if body.GetTransactionID().GetTransactionValidStart() != nil {
+		validStart = _TimeFromProtobuf(body.TransactionID.TransactionValidStart)
+	}
+
+	switch data := body.Data.(type) {
+	case *services.TransactionBody_CryptoCreateAccount:
+		bytes := feeEstimateAccountSize + protobuf.Size(data.CryptoCreateAccount.GetKey()) + len(data.CryptoCreateAccount.GetMemo()) + len(data.CryptoCreateAccount.GetAlias())
+		service.TransactionRamByteHour += _FeeEstimateByteHours(int64(bytes), _FeeEstimateLifetime(data.CryptoCreateAccount.GetAutoRenewPeriod()))
+	case *services.TransactionBody_ConsensusCreateTopic:
+		topic := data.ConsensusCreateTopic
+		bytes := feeEstimateTopicSize + len(topic.GetMemo()) + protobuf.Size(topic.GetAdminKey()) + protobuf.Size(topic.GetSubmitKey())
+		if topic.GetAutoRenewAccount() != nil {
+			bytes += feeEstimateEntityIDSize
+		}
+		service.TransactionRamByteHour += _FeeEstimateByteHours(int64(bytes), _FeeEstimateLifetime(topic.GetAutoRenewPeriod()))
+	case *services.TransactionBody_FileCreate:
+		file := data.FileCreate
+		bytes := feeEstimateFileSize + len(file.GetContents()) + protobuf.Size(file.GetKeys()) + len(file.GetMemo())
+		service.TransactionStorageByteHour += _FeeEstimateByteHours(int64(bytes), _FeeEstimateExpiry(file.GetExpirationTime(), validStart))
+	case *services.TransactionBody_FileUpdate:
+		file := data.FileUpdate
+		bytes := len(file.GetContents()) + protobuf.Size(file.GetKeys()) + len(file.GetMemo().GetValue())
+		service.TransactionStorageByteHour += _FeeEstimateByteHours(int64(bytes), _FeeEstimateExpiry(file.GetExpirationTime(), validStart))
+	case *services.TransactionBody_FileAppend:
+		service.TransactionStorageByteHour += _FeeEstimateByteHours(int64(len(data.FileAppend.GetContents())), feeEstimateDefaultLifetimeSeconds)
+	case *services.TransactionBody_ContractCall:
+		service.ContractTransactionGas += data.ContractCall.GetGas()
+	case *services.TransactionBody_ContractCreateInstance:
+		service.ContractTransactionGas += data.ContractCreateInstance.GetGas()
+	}
+}
+
+func _FeeEstimateLifetime(period *services.Duration) int64 {
+	if period == nil || period.GetSeconds() <= 0 {
+		return feeEstimateDefaultLifetimeSeconds
+	}
+
+	return period.GetSeconds()
+}
+
+func _FeeEstimateExpiry(expiry *services.Timestamp, validStart time.Time) int64 {
+	if expiry == nil {
+		return feeEstimateDefaultLifetimeSeconds
+	}
+
+	lifetime := int64(_TimeFromProtobuf(expiry).Sub(validStart) / time.Second)
+	if lifetime < 0 {
+		return 0
+	}
+
+	return lifetime
+}
+
+// _FeeEstimateByteHours converts bytes stored for seconds to byte-hours, charging at least one byte-hour for any
+// storage.
+func _FeeEstimateByteHours(bytes int64, seconds int64) int64 {
+	byteSeconds := bytes * seconds
+	if byteSeconds <= 0 {
+		return 0
+	}
+
+	return max(1, byteSeconds/feeEstimateHourSeconds)
+}
+
+// _FeeComponentHbar prices the usage with the fee components, which are in thousandths of tinycents, and converts the
+// result from tinycents to hbar with the exchange rate.
+func _FeeComponentHbar(price *FeeComponents, usage *FeeComponents, rate ExchangeRate) Hbar {
+	if price == nil || usage == nil {
+		return Hbar{}
+	}
+
+	total := price.Constant*usage.Constant +
+		price.TransactionBandwidthByte*usage.TransactionBandwidthByte +
+		price.TransactionVerification*usage.TransactionVerification +
+		price.TransactionRamByteHour*usage.TransactionRamByteHour +
+		price.TransactionStorageByteHour*usage.TransactionStorageByteHour +
+		price.ContractTransactionGas*usage.ContractTransactionGas +
+		price.TransferVolumeHbar*usage.TransferVolumeHbar +
+		price.ResponseMemoryByte*usage.ResponseMemoryByte +
+		price.ResponseDiscByte*usage.ResponseDiscByte
+
+	if total < price.Min {
+		total = price.Min
+	} else if price.Max > 0 && total > price.Max {
+		total = price.Max
+	}
+
+	tinycents := total / feeEstimateDivisor
+	if tinycents == 0 && total > 0 {
+		tinycents = 1
+	}
+
+	return HbarFromTinybar(tinycents * int64(rate.Hbars) / int64(rate.cents))
+}
+
+// _FeeDataTypeFromTransactionBody returns the sub-type the network prices the transaction with. Custom fees of the
+// tokens being transferred are not known offline, so transfers are priced without them.
+func _FeeDataTypeFromTransactionBody(body *services.TransactionBody) FeeDataType {
+	switch data := body.Data.(type) {
+	case *services.TransactionBody_CryptoTransfer:
+		subType := FeeDataTypeDefault
+		for _, accountAmount := range data.CryptoTransfer.GetTransfers().GetAccountAmounts() {
+			if accountAmount.HookCall != nil {
+				return FeeDataTypeCryptoTransferWithHooks
+			}
+		}
+		for _, tokenTransfers := range data.CryptoTransfer.GetTokenTransfers() {
+			for _, accountAmount := range tokenTransfers.GetTransfers() {
+				if accountAmount.HookCall != nil {
+					return FeeDataTypeCryptoTransferWithHooks
+				}
+			}
+			for _, nftTransfer := range tokenTransfers.GetNftTransfers() {
+				if nftTransfer.SenderAllowanceHookCall != nil || nftTransfer.ReceiverAllowanceHookCall != nil {
+					return FeeDataTypeCryptoTransferWithHooks
+				}
+			}
+			if len(tokenTransfers.GetNftTransfers()) > 0 {
+				subType = FeeDataTypeTokenNonFungibleUnique
+			} else if subType == FeeDataTypeDefault && len(tokenTransfers.GetTransfers()) > 0 {
+				subType = FeeDataTypeTokenFungibleCommon
+			}
+		}
+		return subType
+	case *services.TransactionBody_TokenCreation:
+		nonFungible := data.TokenCreation.GetTokenType() == services.TokenType_NON_FUNGIBLE_UNIQUE
+		customFees := len(data.TokenCreation.GetCustomFees()) > 0
+		switch {
+		case nonFungible && customFees:
+			return FeeDataTypeTokenNonFungibleUniqueWithCustomFees
+		case nonFungible:
+			return FeeDataTypeTokenNonFungibleUnique
+		case customFees:
+			return FeeDataTypeTokenFungibleCommonWithCustomFees
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_TokenMint:
+		if len(data.TokenMint.GetMetadata()) > 0 {
+			return FeeDataTypeTokenNonFungibleUnique
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_TokenBurn:
+		if len(data.TokenBurn.GetSerialNumbers()) > 0 {
+			return FeeDataTypeTokenNonFungibleUnique
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_TokenWipe:
+		if len(data.TokenWipe.GetSerialNumbers()) > 0 {
+			return FeeDataTypeTokenNonFungibleUnique
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_ConsensusCreateTopic:
+		if len(data.ConsensusCreateTopic.GetCustomFees()) > 0 {
+			return FeeDataTypeTopicCreateWithCustomFees
+		}
+	case *services.TransactionBody_ScheduleCreate:
+		if data.ScheduleCreate.GetScheduledTransactionBody().GetContractCall() != nil {
+			return FeeDataTypeScheduleCreateContractCall
+		}
+	}
+
+	return FeeDataTypeDefault
+}
@@ -0,0 +1,137 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitEstimateFeeFromBundledSchedule(t *testing.T) {
+	t.Parallel()
+
+	schedules, err := BundledFeeSchedules()
+	require.NoError(t, err)
+	// 1 hbar is worth 12 cents
+	rate := NewExchangeRate(1, 12)
+
+	transfer := NewTransferTransaction().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 6}, NewHbar(1))
+
+	estimate, err := EstimateFeeDetails(transfer, schedules, rate)
+	require.NoError(t, err)
+	assert.Equal(t, RequestTypeCryptoTransfer, estimate.RequestType)
+	assert.Equal(t, FeeDataTypeDefault, estimate.SubType)
+	assert.Equal(t, 1, estimate.Chunks)
+	assert.Positive(t, estimate.NodeFee.AsTinybar())
+	assert.Positive(t, estimate.NetworkFee.AsTinybar())
+	assert.Positive(t, estimate.ServiceFee.AsTinybar())
+
+	// a transfer costs about $0.0001
+	fee, err := EstimateFee(transfer, schedules, rate)
+	require.NoError(t, err)
+	assert.Equal(t, estimate.Total(), fee)
+	assert.InDelta(t, 0.0001*100/12, fee.As(HbarUnits.Hbar), 0.0002)
+
+	// an account costs about $0.05
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	accountCreate := NewAccountCreateTransaction().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		SetKeyWithoutAlias(key.PublicKey())
+
+	fee, err = EstimateFee(accountCreate, schedules, rate)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.05*100/12, fee.As(HbarUnits.Hbar), 0.1)
+
+	// the storage of a file grows with its contents and lifetime
+	small, err := EstimateFee(NewFileCreateTransaction().SetContents(make([]byte, 10)), schedules, rate)
+	require.NoError(t, err)
+	large, err := EstimateFee(NewFileCreateTransaction().SetContents(make([]byte, 4000)), schedules, rate)
+	require.NoError(t, err)
+	assert.Greater(t, large.AsTinybar(), small.AsTinybar())
+}
+
+func TestUnitEstimateFeeSubTypes(t *testing.T) {
+	t.Parallel()
+
+	schedules, err := BundledFeeSchedules()
+	require.NoError(t, err)
+	rate := NewExchangeRate(1, 12)
+
+	fungible, err := EstimateFeeDetails(NewTokenMintTransaction().SetTokenID(TokenID{Token: 7}).SetAmount(10), schedules, rate)
+	require.NoError(t, err)
+	assert.Equal(t, FeeDataTypeTokenFungibleCommon, fungible.SubType)
+
+	nonFungible, err := EstimateFeeDetails(NewTokenMintTransaction().SetTokenID(TokenID{Token: 7}).SetMetadata([]byte{1}), schedules, rate)
+	require.NoError(t, err)
+	assert.Equal(t, FeeDataTypeTokenNonFungibleUnique, nonFungible.SubType)
+	assert.Greater(t, nonFungible.Total().AsTinybar(), fungible.Total().AsTinybar())
+
+	nftTransfer, err := EstimateFeeDetails(NewTransferTransaction().
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 7}, SerialNumber: 1}, AccountID{Account: 5}, AccountID{Account: 6}), schedules, rate)
+	require.NoError(t, err)
+	assert.Equal(t, FeeDataTypeTokenNonFungibleUnique, nftTransfer.SubType)
+
+	// the bundled schedule has no sub-type for topics with custom fees, so the default is used
+	topicCreate, err := EstimateFeeDetails(NewTopicCreateTransaction().
+		SetCustomFees([]*CustomFixedFee{NewCustomFixedFee().SetAmount(1).SetFeeCollectorAccountID(AccountID{Account: 5})}), schedules, rate)
+	require.NoError(t, err)
+	assert.Equal(t, FeeDataTypeDefault, topicCreate.SubType)
+}
+
+func TestUnitEstimateFeeSignaturesAndChunks(t *testing.T) {
+	t.Parallel()
+
+	schedules, err := BundledFeeSchedules()
+	require.NoError(t, err)
+	rate := NewExchangeRate(1, 12)
+
+	tx, err := NewFileAppendTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		SetFileID(FileID{File: 7}).
+		SetContents(make([]byte, 2500)).
+		SetMaxChunkSize(1024).
+		FreezeWith(nil)
+	require.NoError(t, err)
+
+	unsigned, err := EstimateFeeDetails(tx, schedules, rate)
+	require.NoError(t, err)
+	assert.Equal(t, RequestTypeFileAppend, unsigned.RequestType)
+	assert.Equal(t, 3, unsigned.Chunks)
+
+	// every signature is verified by the network
+	for range 3 {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		tx.Sign(key)
+	}
+
+	signed, err := EstimateFeeDetails(tx, schedules, rate)
+	require.NoError(t, err)
+	assert.Greater(t, signed.NetworkFee.AsTinybar(), unsigned.NetworkFee.AsTinybar())
+}
+
+func TestUnitEstimateFeeErrors(t *testing.T) {
+	t.Parallel()
+
+	schedules, err := BundledFeeSchedules()
+	require.NoError(t, err)
+	transfer := NewTransferTransaction().AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1))
+
+	_, err = EstimateFee(transfer, schedules, ExchangeRate{})
+	require.ErrorIs(t, err, errFeeEstimateExchangeRate)
+
+	// the bundled schedule predates node transactions
+	_, err = EstimateFee(NewNodeDeleteTransaction().SetNodeID(1), schedules, NewExchangeRate(1, 12))
+	var notFound ErrFeeScheduleNotFound
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, RequestTypeNodeDelete, notFound.RequestType)
+}
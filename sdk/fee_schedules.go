@@ -14,6 +14,16 @@ type FeeSchedules struct {
 	next    *FeeSchedule
 }
 
+// GetCurrent returns the fee schedule in effect until its expiration time
+func (feeSchedules FeeSchedules) GetCurrent() *FeeSchedule {
+	return feeSchedules.current
+}
+
+// GetNext returns the fee schedule which takes effect when the current one expires
+func (feeSchedules FeeSchedules) GetNext() *FeeSchedule {
+	return feeSchedules.next
+}
+
 func _FeeSchedulesFromProtobuf(feeSchedules *services.CurrentAndNextFeeSchedule) (FeeSchedules, error) {
 	if feeSchedules == nil {
 		return FeeSchedules{}, errParameterNull
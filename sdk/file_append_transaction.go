@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -114,7 +115,7 @@ func (tx *FileAppendTransaction) FreezeWith(client *Client) (*FileAppendTransact
 			return tx, errNoClientOrTransactionIDOrNodeId
 		}
 
-		tx.SetNodeAccountIDs(client.network._GetNodeAccountIDsForExecute())
+		tx.SetNodeAccountIDs(client.network._GetNodeAccountIDsForRequest(RequestTypeFileAppend))
 	}
 
 	tx._InitFee(client)
@@ -185,6 +186,15 @@ func (tx *FileAppendTransaction) FreezeWith(client *Client) (*FileAppendTransact
 // Execute executes the Transaction with the provided client
 func (tx *FileAppendTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, returning ctx.Err() if ctx is cancelled
+// before every chunk has been accepted
+func (tx *FileAppendTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -194,7 +204,7 @@ func (tx *FileAppendTransaction) Execute(
 		return TransactionResponse{}, tx.freezeError
 	}
 
-	list, err := tx.ExecuteAll(client)
+	list, err := tx.ExecuteAllWithContext(ctx, client)
 
 	if err != nil {
 		if len(list) > 0 {
@@ -217,7 +227,16 @@ func (tx *FileAppendTransaction) Execute(
 func (tx *FileAppendTransaction) ExecuteAll(
 	client *Client,
 ) ([]TransactionResponse, error) {
-	return executeAll(tx, client)
+	return tx.ExecuteAllWithContext(context.Background(), client)
+}
+
+// ExecuteAllWithContext executes the all the Transactions with the provided client, stopping at the first chunk
+// interrupted by ctx
+func (tx *FileAppendTransaction) ExecuteAllWithContext(
+	ctx context.Context,
+	client *Client,
+) ([]TransactionResponse, error) {
+	return executeAll(ctx, tx, client)
 }
 
 func (tx *FileAppendTransaction) Schedule() (*ScheduleCreateTransaction, error) {
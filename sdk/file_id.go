@@ -33,6 +33,11 @@ func FileIDForExchangeRate() FileID {
 	return FileID{File: 112}
 }
 
+// FileIDForThrottleDefinitions returns the network throttle definitions.
+func FileIDForThrottleDefinitions() FileID {
+	return FileID{File: 123}
+}
+
 // GetAddressBookFileIDFor returns the public node address book FileID for the given realm and shard.
 func GetAddressBookFileIDFor(shard uint64, realm uint64) FileID {
 	return FileID{
@@ -60,6 +65,15 @@ func GetExchangeRatesFileIDFor(shard uint64, realm uint64) FileID {
 	}
 }
 
+// GetThrottleDefinitionsFileIDFor returns the throttle definitions FileID for the given realm and shard.
+func GetThrottleDefinitionsFileIDFor(shard uint64, realm uint64) FileID {
+	return FileID{
+		Shard: shard,
+		Realm: realm,
+		File:  123,
+	}
+}
+
 // FileIDFromString returns a FileID parsed from the given string.
 // A malformatted string will cause this to return an error instead.
 func FileIDFromString(data string) (FileID, error) {
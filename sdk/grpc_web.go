@@ -0,0 +1,297 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Transport is the protocol the client uses to send requests to consensus nodes.
+type Transport uint32
+
+const (
+	// TransportGrpc sends requests over native gRPC. This is the default.
+	TransportGrpc Transport = iota
+	// TransportGrpcWeb sends requests over gRPC-web, which is HTTP/1.1 with base64 framing, to the gRPC-web proxy
+	// endpoints of the nodes. Unlike native gRPC it works from GOOS=js GOARCH=wasm builds. Only unary calls are
+	// supported, so it cannot be used for mirror node subscriptions.
+	TransportGrpcWeb
+)
+
+// String returns a string representation of the Transport
+func (transport Transport) String() string {
+	switch transport {
+	case TransportGrpc:
+		return "gRPC"
+	case TransportGrpcWeb:
+		return "gRPC-web"
+	}
+
+	return fmt.Sprintf("UNKNOWN(%d)", uint32(transport))
+}
+
+const (
+	grpcWebContentType     = "application/grpc-web-text"
+	grpcWebFrameHeaderSize = 5
+	grpcWebFrameTrailer    = 0x80
+	grpcWebFrameCompressed = 0x01
+	grpcWebMaxTimeout      = 99_999_999
+)
+
+// _GrpcWebConn sends unary gRPC calls as gRPC-web requests to a single gRPC-web proxy. It is used in place of a
+// *grpc.ClientConn by _Channel, so the service clients of every transaction and query work unchanged.
+type _GrpcWebConn struct {
+	baseURL      string
+	httpClient   *http.Client
+	interceptors []grpc.UnaryClientInterceptor
+}
+
+func _NewGrpcWebConn(baseURL string, httpClient *http.Client, interceptors ...grpc.UnaryClientInterceptor) *_GrpcWebConn {
+	return &_GrpcWebConn{
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+		httpClient:   httpClient,
+		interceptors: interceptors,
+	}
+}
+
+// Invoke calls the unary interceptors in order and then sends the request. The interceptors are passed a nil
+// *grpc.ClientConn.
+func (conn *_GrpcWebConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	invoker := grpc.UnaryInvoker(conn._Invoke)
+	for i := len(conn.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := conn.interceptors[i], invoker
+		invoker = func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return interceptor(ctx, method, req, reply, cc, next, opts...)
+		}
+	}
+
+	return invoker(ctx, method, args, reply, nil, opts...)
+}
+
+// NewStream fails, gRPC-web proxies only support the unary calls the consensus nodes serve.
+func (conn *_GrpcWebConn) NewStream(_ context.Context, _ *grpc.StreamDesc, method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "the gRPC-web transport does not support the streaming method %s", method)
+}
+
+// Close closes the idle connections to the proxy.
+func (conn *_GrpcWebConn) Close() error {
+	conn.httpClient.CloseIdleConnections()
+	return nil
+}
+
+func (conn *_GrpcWebConn) _Invoke(ctx context.Context, method string, args, reply any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+	request, ok := args.(protobuf.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "gRPC-web request is not a protobuf message: %T", args)
+	}
+	response, ok := reply.(protobuf.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "gRPC-web response is not a protobuf message: %T", reply)
+	}
+
+	data, err := protobuf.Marshal(request)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, conn.baseURL+method, bytes.NewReader(_GrpcWebEncodeText(data)))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	httpRequest.Header.Set("Content-Type", grpcWebContentType)
+	httpRequest.Header.Set("Accept", grpcWebContentType)
+	httpRequest.Header.Set("X-Grpc-Web", "1")
+	if deadline, ok := ctx.Deadline(); ok {
+		httpRequest.Header.Set("Grpc-Timeout", _GrpcWebTimeout(time.Until(deadline)))
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		for key, values := range md {
+			for _, value := range values {
+				httpRequest.Header.Add(key, value)
+			}
+		}
+	}
+
+	httpResponse, err := conn.httpClient.Do(httpRequest)
+	if err != nil {
+		return _GrpcWebTransportError(ctx, err)
+	}
+	defer httpResponse.Body.Close()
+
+	body, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return _GrpcWebTransportError(ctx, err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return status.Errorf(_GrpcWebCodeFromHTTPStatus(httpResponse.StatusCode),
+			"gRPC-web proxy responded with HTTP status %d", httpResponse.StatusCode)
+	}
+
+	if strings.HasPrefix(httpResponse.Header.Get("Content-Type"), grpcWebContentType) {
+		if body, err = _GrpcWebDecodeText(body); err != nil {
+			return status.Errorf(codes.Internal, "failed to decode gRPC-web response: %s", err)
+		}
+	}
+
+	message, trailer, err := _GrpcWebParseFrames(body)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to parse gRPC-web response: %s", err)
+	}
+
+	// A response without messages may carry its status in the headers instead of a trailer frame
+	grpcStatus, grpcMessage := trailer.Get("Grpc-Status"), trailer.Get("Grpc-Message")
+	if grpcStatus == "" {
+		grpcStatus, grpcMessage = httpResponse.Header.Get("Grpc-Status"), httpResponse.Header.Get("Grpc-Message")
+	}
+	if grpcStatus == "" {
+		return status.Error(codes.Internal, "gRPC-web response has no grpc-status")
+	}
+
+	code, err := strconv.ParseUint(grpcStatus, 10, 32)
+	if err != nil {
+		return status.Errorf(codes.Internal, "gRPC-web response has an invalid grpc-status %q", grpcStatus)
+	}
+	if codes.Code(code) != codes.OK {
+		if unescaped, err := url.PathUnescape(grpcMessage); err == nil {
+			grpcMessage = unescaped
+		}
+		return status.Error(codes.Code(code), grpcMessage)
+	}
+
+	if message == nil {
+		return status.Error(codes.Internal, "gRPC-web response has no message")
+	}
+
+	if err = protobuf.Unmarshal(message, response); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// _GrpcWebTransportError maps a failed HTTP request to a gRPC status, so that it is retried like a failed gRPC call
+func _GrpcWebTransportError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+// _GrpcWebCodeFromHTTPStatus maps the HTTP status of a response without a gRPC status as gRPC clients do
+func _GrpcWebCodeFromHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.Internal
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.Unimplemented
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	}
+
+	return codes.Unknown
+}
+
+// _GrpcWebTimeout formats a timeout as the value of the grpc-timeout header, which allows at most 8 digits
+func _GrpcWebTimeout(timeout time.Duration) string {
+	milliseconds := timeout.Milliseconds()
+	if milliseconds < 1 {
+		milliseconds = 1
+	}
+	if milliseconds > grpcWebMaxTimeout {
+		return strconv.FormatInt(min(milliseconds/1000, grpcWebMaxTimeout), 10) + "S"
+	}
+
+	return strconv.FormatInt(milliseconds, 10) + "m"
+}
+
+// _GrpcWebEncodeText frames a message and encodes it as base64
+func _GrpcWebEncodeText(message []byte) []byte {
+	frame := make([]byte, grpcWebFrameHeaderSize+len(message))
+	binary.BigEndian.PutUint32(frame[1:grpcWebFrameHeaderSize], uint32(len(message))) // #nosec
+	copy(frame[grpcWebFrameHeaderSize:], message)
+
+	encoded := make([]byte, base64.StdEncoding.EncodedLen(len(frame)))
+	base64.StdEncoding.Encode(encoded, frame)
+
+	return encoded
+}
+
+// _GrpcWebDecodeText decodes a base64 response body. Proxies encode every frame separately, so the body may be
+// several padded base64 strings one after another.
+func _GrpcWebDecodeText(body []byte) ([]byte, error) {
+	body = bytes.Join(bytes.Fields(body), nil)
+	if len(body)%4 != 0 {
+		return nil, fmt.Errorf("base64 body length %d is not a multiple of 4", len(body))
+	}
+
+	decoded := make([]byte, 0, len(body)/4*3)
+	quantum := make([]byte, 3)
+	for i := 0; i < len(body); i += 4 {
+		n, err := base64.StdEncoding.Decode(quantum, body[i:i+4])
+		if err != nil {
+			return nil, err
+		}
+		decoded = append(decoded, quantum[:n]...)
+	}
+
+	return decoded, nil
+}
+
+// _GrpcWebParseFrames returns the first message and the trailers of a decoded response
+func _GrpcWebParseFrames(data []byte) (message []byte, trailer http.Header, err error) {
+	trailer = http.Header{}
+
+	for len(data) > 0 {
+		if len(data) < grpcWebFrameHeaderSize {
+			return nil, nil, fmt.Errorf("truncated frame header of %d bytes", len(data))
+		}
+
+		flags := data[0]
+		length := binary.BigEndian.Uint32(data[1:grpcWebFrameHeaderSize])
+		data = data[grpcWebFrameHeaderSize:]
+		if uint64(len(data)) < uint64(length) {
+			return nil, nil, fmt.Errorf("truncated frame of %d bytes, expected %d", len(data), length)
+		}
+
+		payload := data[:length]
+		data = data[length:]
+
+		switch {
+		case flags&grpcWebFrameTrailer != 0:
+			for _, line := range strings.Split(string(payload), "\r\n") {
+				if key, value, ok := strings.Cut(line, ":"); ok {
+					trailer.Add(strings.TrimSpace(key), strings.TrimSpace(value))
+				}
+			}
+		case flags&grpcWebFrameCompressed != 0:
+			return nil, nil, fmt.Errorf("compressed frames are not supported")
+		case message == nil:
+			message = payload
+		}
+	}
+
+	return message, trailer, nil
+}
@@ -0,0 +1,191 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func _GrpcWebTestFrame(flags byte, payload []byte) []byte {
+	frame := []byte{flags, byte(len(payload) >> 24), byte(len(payload) >> 16), byte(len(payload) >> 8), byte(len(payload))}
+	return append(frame, payload...)
+}
+
+func TestUnitGrpcWebFrames(t *testing.T) {
+	t.Parallel()
+
+	message := []byte("a message")
+	encoded := _GrpcWebEncodeText(message)
+	decoded, err := _GrpcWebDecodeText(encoded)
+	require.NoError(t, err)
+	require.Equal(t, _GrpcWebTestFrame(0, message), decoded)
+
+	// Proxies encode the message and the trailer frame separately
+	trailer := _GrpcWebTestFrame(grpcWebFrameTrailer, []byte("grpc-status: 14\r\ngrpc-message: node%20busy\r\n"))
+	first := base64.StdEncoding.EncodeToString(_GrpcWebTestFrame(0, message))
+	require.True(t, strings.HasSuffix(first, "="))
+	body := first + base64.StdEncoding.EncodeToString(trailer)
+
+	decoded, err = _GrpcWebDecodeText([]byte(body))
+	require.NoError(t, err)
+
+	parsed, trailers, err := _GrpcWebParseFrames(decoded)
+	require.NoError(t, err)
+	assert.Equal(t, message, parsed)
+	assert.Equal(t, "14", trailers.Get("grpc-status"))
+	assert.Equal(t, "node%20busy", trailers.Get("grpc-message"))
+
+	_, _, err = _GrpcWebParseFrames(decoded[:len(decoded)-1])
+	require.ErrorContains(t, err, "truncated frame")
+	_, err = _GrpcWebDecodeText([]byte("abc"))
+	require.Error(t, err)
+}
+
+func TestUnitGrpcWebTimeoutAndStatus(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "1500m", _GrpcWebTimeout(1500*time.Millisecond))
+	assert.Equal(t, "1m", _GrpcWebTimeout(-time.Second))
+	assert.Equal(t, "360000S", _GrpcWebTimeout(100*time.Hour))
+
+	assert.Equal(t, codes.Unavailable, _GrpcWebCodeFromHTTPStatus(http.StatusServiceUnavailable))
+	assert.Equal(t, codes.Unimplemented, _GrpcWebCodeFromHTTPStatus(http.StatusNotFound))
+	assert.Equal(t, codes.Unknown, _GrpcWebCodeFromHTTPStatus(http.StatusTeapot))
+
+	assert.Equal(t, "gRPC-web", TransportGrpcWeb.String())
+}
+
+func TestUnitGrpcWebConnErrors(t *testing.T) {
+	t.Parallel()
+
+	responses := []func(http.ResponseWriter){
+		func(writer http.ResponseWriter) {
+			writer.Header().Set("Content-Type", grpcWebContentType)
+			writer.Header().Set("Grpc-Status", "14")
+			writer.Header().Set("Grpc-Message", "node%20unavailable")
+		},
+		func(writer http.ResponseWriter) {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		},
+		func(writer http.ResponseWriter) {
+			writer.Header().Set("Content-Type", "application/grpc-web+proto")
+			_, _ = writer.Write(_GrpcWebTestFrame(grpcWebFrameTrailer, []byte("grpc-status: 0\r\n")))
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		responses[0](writer)
+		responses = responses[1:]
+	}))
+	defer server.Close()
+
+	conn := _NewGrpcWebConn(server.URL, server.Client())
+	defer conn.Close()
+
+	err := conn.Invoke(context.Background(), "/proto.CryptoService/cryptoGetBalance", &services.Query{}, &services.Response{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, "node unavailable", status.Convert(err).Message())
+
+	err = conn.Invoke(context.Background(), "/proto.CryptoService/cryptoGetBalance", &services.Query{}, &services.Response{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	err = conn.Invoke(context.Background(), "/proto.CryptoService/cryptoGetBalance", &services.Query{}, &services.Response{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	_, err = conn.NewStream(context.Background(), &grpc.StreamDesc{}, "/proto.MirrorService/subscribe")
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestUnitClientGrpcWebTransport(t *testing.T) {
+	t.Parallel()
+
+	balance, err := protobuf.Marshal(_NetworkHealthBalance(services.ResponseCodeEnum_OK))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, "/proto.CryptoService/cryptoGetBalance", request.URL.Path)
+		assert.Equal(t, grpcWebContentType, request.Header.Get("Content-Type"))
+		assert.Equal(t, "1", request.Header.Get("X-Grpc-Web"))
+		assert.NotEmpty(t, request.Header.Get("X-User-Agent"))
+		assert.NotEmpty(t, request.Header.Get("Grpc-Timeout"))
+
+		body, err := io.ReadAll(request.Body)
+		assert.NoError(t, err)
+		body, err = base64.StdEncoding.DecodeString(string(body))
+		assert.NoError(t, err)
+		query := &services.Query{}
+		assert.NoError(t, protobuf.Unmarshal(body[grpcWebFrameHeaderSize:], query))
+		assert.Equal(t, int64(1800), query.GetCryptogetAccountBalance().GetAccountID().GetAccountNum())
+
+		writer.Header().Set("Content-Type", grpcWebContentType+"+proto")
+		_, _ = writer.Write([]byte(base64.StdEncoding.EncodeToString(_GrpcWebTestFrame(0, balance))))
+		_, _ = writer.Write([]byte(base64.StdEncoding.EncodeToString(
+			_GrpcWebTestFrame(grpcWebFrameTrailer, []byte("grpc-status: 0\r\n")))))
+	}))
+	defer server.Close()
+
+	client := ClientForNetwork(map[string]AccountID{strings.TrimPrefix(server.URL, "http://"): {Account: 3}})
+	defer client.Close()
+	require.Equal(t, TransportGrpc, client.GetTransport())
+	client.SetTransport(TransportGrpcWeb)
+	require.Equal(t, TransportGrpcWeb, client.GetTransport())
+
+	var methods []string
+	client.AddUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		methods = append(methods, method)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	})
+
+	result, err := NewAccountBalanceQuery().SetAccountID(AccountID{Account: 1800}).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(1), result.Hbars)
+	assert.Equal(t, []string{"/proto.CryptoService/cryptoGetBalance"}, methods)
+}
+
+func TestUnitNodeAddressBookFromMirrorNetworkNodes(t *testing.T) {
+	t.Parallel()
+
+	book := NodeAddressBookFromMirrorNetworkNodes([]MirrorNetworkNode{{
+		NodeID:            1,
+		AccountID:         AccountID{Account: 4},
+		PublicKey:         "0x3082",
+		NodeCertHash:      "0x6162",
+		ServiceEndpoints:  []MirrorServiceEndpoint{{IPAddressV4: "10.0.0.1", Port: 50211}},
+		GrpcProxyEndpoint: &MirrorServiceEndpoint{DomainName: "node1.example.com", Port: 443},
+		Stake:             HbarFromTinybar(1_000),
+	}})
+
+	require.Len(t, book.NodeAddresses, 1)
+	address := book.NodeAddresses[0]
+	assert.Equal(t, AccountID{Account: 4}, *address.AccountID)
+	assert.Equal(t, "3082", address.PublicKey)
+	assert.Equal(t, []byte("ab"), address.CertHash)
+	require.Len(t, address.Addresses, 1)
+	assert.Equal(t, "10.0.0.1:50211", address.Addresses[0].String())
+	require.NotNil(t, address.GrpcWebProxyEndpoint)
+	assert.Equal(t, "node1.example.com:443", address.GrpcWebProxyEndpoint.String())
+	assert.Equal(t, int64(1_000), address.Stake)
+
+	network := _NewNetwork()
+	network._SetNetworkFromAddressBook(book)
+	require.Equal(t, map[string]AccountID{"10.0.0.1:50211": {Account: 4}}, network._GetNetwork())
+	require.NoError(t, network._SetTransport(TransportGrpcWeb))
+	require.Equal(t, map[string]AccountID{"node1.example.com:443": {Account: 4}}, network._GetNetwork())
+}
@@ -0,0 +1,184 @@
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func _Marshal(message protobuf.Message) []byte {
+	data, err := protobuf.Marshal(message)
+	if err != nil {
+		return make([]byte, 0)
+	}
+
+	return data
+}
+
+func _Clone[T protobuf.Message](message T) T {
+	return protobuf.Clone(message).(T)
+}
+
+func _KeyToProtobuf(key hiero.Key) (*services.Key, error) {
+	data, err := hiero.KeyToBytes(key)
+	if err != nil {
+		return nil, err
+	}
+
+	protoKey := &services.Key{}
+	if err := protobuf.Unmarshal(data, protoKey); err != nil {
+		return nil, err
+	}
+
+	return protoKey, nil
+}
+
+// _IsSigned reports whether the keys which signed a transaction satisfy key. A missing key is never satisfied.
+func _IsSigned(key *services.Key, signed []hiero.PublicKey) bool {
+	if key == nil {
+		return false
+	}
+
+	converted, err := hiero.KeyFromBytes(_Marshal(key))
+	if err != nil {
+		return false
+	}
+
+	return hiero.EvaluateKey(converted, signed).Satisfied
+}
+
+func _AccountIDFromProtobuf(accountID *services.AccountID) hiero.AccountID {
+	return hiero.AccountID{
+		Shard:   uint64(accountID.GetShardNum()),
+		Realm:   uint64(accountID.GetRealmNum()),
+		Account: uint64(accountID.GetAccountNum()),
+	}
+}
+
+func _AccountIDToProtobuf(num int64) *services.AccountID {
+	return &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: num}}
+}
+
+func _TokenIDToProtobuf(num int64) *services.TokenID {
+	return &services.TokenID{TokenNum: num}
+}
+
+func _TopicIDToProtobuf(num int64) *services.TopicID {
+	return &services.TopicID{TopicNum: num}
+}
+
+func _FileIDToProtobuf(num int64) *services.FileID {
+	return &services.FileID{FileNum: num}
+}
+
+func _ContractIDToProtobuf(num int64) *services.ContractID {
+	return &services.ContractID{Contract: &services.ContractID_ContractNum{ContractNum: num}}
+}
+
+func _ScheduleIDToProtobuf(num int64) *services.ScheduleID {
+	return &services.ScheduleID{ScheduleNum: num}
+}
+
+func _TimestampToProtobuf(t time.Time) *services.Timestamp {
+	return &services.Timestamp{Seconds: t.Unix(), Nanos: int32(t.Nanosecond())}
+}
+
+func _TimestampFromProtobuf(timestamp *services.Timestamp) time.Time {
+	return time.Unix(timestamp.GetSeconds(), int64(timestamp.GetNanos()))
+}
+
+func _DurationToProtobuf(duration time.Duration) *services.Duration {
+	return &services.Duration{Seconds: int64(duration / time.Second)}
+}
+
+// _TransactionIDKey identifies a transaction ID in the receipts and records of the ledger.
+func _TransactionIDKey(transactionID *services.TransactionID) string {
+	validStart := transactionID.GetTransactionValidStart()
+	accountID := transactionID.GetAccountID()
+
+	return fmt.Sprintf("%d.%d.%d@%d.%09d/%t/%d",
+		accountID.GetShardNum(), accountID.GetRealmNum(), accountID.GetAccountNum(),
+		validStart.GetSeconds(), validStart.GetNanos(), transactionID.GetScheduled(), transactionID.GetNonce())
+}
+
+func _TransactionIDKeyFromBytes(data []byte) string {
+	transactionID := &services.TransactionID{}
+	if err := protobuf.Unmarshal(data, transactionID); err != nil {
+		return ""
+	}
+
+	return _TransactionIDKey(transactionID)
+}
+
+// _PrecheckResponse returns the response to a query which only holds a response header with the precheck status,
+// as a node answers a query it rejects before looking at the ledger. Every response of the Response oneof has the
+// field number of its query in the Query oneof.
+func _PrecheckResponse(query *services.Query, precheck services.ResponseCodeEnum) *services.Response {
+	response := &services.Response{}
+
+	queryMessage := query.ProtoReflect()
+	queryField := queryMessage.WhichOneof(queryMessage.Descriptor().Oneofs().ByName("query"))
+	if queryField == nil {
+		return response
+	}
+	inner, ok := queryMessage.Get(queryField).Message().Interface().(interface {
+		GetHeader() *services.QueryHeader
+	})
+	if !ok {
+		return response
+	}
+
+	message := response.ProtoReflect()
+	field := message.Descriptor().Fields().ByNumber(queryField.Number())
+	if field == nil || field.ContainingOneof() == nil {
+		return response
+	}
+	answer := message.NewField(field).Message()
+	headerField := answer.Descriptor().Fields().ByName("header")
+	if headerField == nil {
+		return response
+	}
+	answer.Set(headerField, protoreflect.ValueOfMessage(_ResponseHeader(inner.GetHeader(), precheck).ProtoReflect()))
+	message.Set(field, protoreflect.ValueOfMessage(answer))
+
+	return response
+}
+
+// _VerifySignatures returns the keys of a signature map whose signatures of bodyBytes are valid. It reports false
+// when any signature is invalid. Signatures with a key prefix shorter than the full key cannot be verified and are
+// skipped.
+func _VerifySignatures(sigMap *services.SignatureMap, bodyBytes []byte) ([]hiero.PublicKey, bool) {
+	signed := make([]hiero.PublicKey, 0, len(sigMap.GetSigPair()))
+	for _, sigPair := range sigMap.GetSigPair() {
+		var key hiero.PublicKey
+		var signature []byte
+		var err error
+
+		switch sigPair.GetSignature().(type) {
+		case *services.SignaturePair_Ed25519:
+			key, err = hiero.PublicKeyFromBytesEd25519(sigPair.GetPubKeyPrefix())
+			signature = sigPair.GetEd25519()
+		case *services.SignaturePair_ECDSASecp256K1:
+			key, err = hiero.PublicKeyFromBytesECDSA(sigPair.GetPubKeyPrefix())
+			signature = sigPair.GetECDSASecp256K1()
+		default:
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		if !key.VerifySignedMessage(bodyBytes, signature) {
+			return nil, false
+		}
+		signed = append(signed, key)
+	}
+
+	return signed, true
+}
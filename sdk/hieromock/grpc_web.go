@@ -0,0 +1,128 @@
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+	grpcWebFrameHeaderSize = 5
+	grpcWebFrameTrailer    = 0x80
+)
+
+// _GrpcWebServer serves the unary methods of the services registered on it as gRPC-web, in the binary and the base64
+// text format, without a proxy in front of a gRPC server.
+type _GrpcWebServer struct {
+	methods map[string]_GrpcWebMethod
+}
+
+type _GrpcWebMethod struct {
+	service any
+	handler grpc.MethodHandler
+}
+
+func _NewGrpcWebServer() *_GrpcWebServer {
+	return &_GrpcWebServer{methods: map[string]_GrpcWebMethod{}}
+}
+
+// RegisterService registers the unary methods of a service, as grpc.Server does.
+func (server *_GrpcWebServer) RegisterService(desc *grpc.ServiceDesc, service any) {
+	for _, method := range desc.Methods {
+		server.methods["/"+desc.ServiceName+"/"+method.MethodName] = _GrpcWebMethod{service: service, handler: method.Handler}
+	}
+}
+
+func (server *_GrpcWebServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "gRPC-web requests must be POST requests", http.StatusMethodNotAllowed)
+		return
+	}
+
+	contentType := request.Header.Get("Content-Type")
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+	if !text && !strings.HasPrefix(contentType, grpcWebContentType) {
+		http.Error(writer, "unsupported content type "+contentType, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err == nil && text {
+		body, err = base64.StdEncoding.DecodeString(string(body))
+	}
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := server._Handle(request, body)
+
+	var out bytes.Buffer
+	if err == nil {
+		var data []byte
+		if data, err = protobuf.Marshal(response.(protobuf.Message)); err == nil {
+			out.Write(_GrpcWebFrame(0, data))
+		}
+	}
+
+	grpcStatus := status.Convert(err)
+	trailer := fmt.Sprintf("grpc-status: %d\r\ngrpc-message: %s\r\n", grpcStatus.Code(), url.PathEscape(grpcStatus.Message()))
+	out.Write(_GrpcWebFrame(grpcWebFrameTrailer, []byte(trailer)))
+
+	if text {
+		writer.Header().Set("Content-Type", grpcWebTextContentType+"+proto")
+		_, _ = writer.Write([]byte(base64.StdEncoding.EncodeToString(out.Bytes())))
+		return
+	}
+
+	writer.Header().Set("Content-Type", grpcWebContentType+"+proto")
+	_, _ = writer.Write(out.Bytes())
+}
+
+func (server *_GrpcWebServer) _Handle(request *http.Request, body []byte) (any, error) {
+	method, ok := server.methods[request.URL.Path]
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "unknown method %s", request.URL.Path)
+	}
+
+	if len(body) < grpcWebFrameHeaderSize || body[0] != 0 ||
+		uint64(binary.BigEndian.Uint32(body[1:grpcWebFrameHeaderSize])) != uint64(len(body)-grpcWebFrameHeaderSize) {
+		return nil, status.Error(codes.Internal, "the request is not a single uncompressed message")
+	}
+
+	md := metadata.MD{}
+	for key, values := range request.Header {
+		md.Append(key, values...)
+	}
+	ctx := metadata.NewIncomingContext(request.Context(), md)
+
+	decode := func(message any) error {
+		return protobuf.Unmarshal(body[grpcWebFrameHeaderSize:], message.(protobuf.Message))
+	}
+
+	return method.handler(method.service, ctx, decode, nil)
+}
+
+func _GrpcWebFrame(flags byte, payload []byte) []byte {
+	frame := make([]byte, grpcWebFrameHeaderSize+len(payload))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:grpcWebFrameHeaderSize], uint32(len(payload))) // #nosec
+	copy(frame[grpcWebFrameHeaderSize:], payload)
+
+	return frame
+}
@@ -0,0 +1,448 @@
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"crypto/sha512"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	_GenesisAccount          = 2
+	_FirstNodeAccount        = 3
+	_FirstEntity             = 1001
+	_FeeScheduleFile         = 111
+	_ExchangeRateFile        = 112
+	_DefaultAutoRenewPeriod  = 90 * 24 * time.Hour
+	_DefaultScheduleLifetime = 30 * time.Minute
+	_DefaultValidDuration    = 120 * time.Second
+	_RunningHashVersion      = 3
+)
+
+// _Ledger is the state shared by the nodes of a mock network. Every entity number is unique across entity types,
+// as on a real network, and contracts have an account with the same number which holds their balance.
+type _Ledger struct {
+	mutex         sync.Mutex
+	nextEntity    int64
+	lastConsensus time.Time
+	accounts      map[int64]*_Account
+	tokens        map[int64]*_Token
+	topics        map[int64]*_Topic
+	files         map[int64]*_File
+	contracts     map[int64]*_Contract
+	schedules     map[int64]*_Schedule
+	records       map[string]*services.TransactionRecord
+	airdrops      map[_PendingAirdrop]uint64
+}
+
+type _Account struct {
+	info   *services.CryptoGetInfoResponse_AccountInfo
+	tokens map[int64]*_TokenRelationship
+}
+
+// _TokenRelationship is the association of an account with a token.
+type _TokenRelationship struct {
+	// balance is the number of NFTs owned for non-fungible tokens
+	balance    uint64
+	frozen     bool
+	kycGranted bool
+}
+
+type _Token struct {
+	info       *services.TokenInfo
+	nfts       map[int64]*services.TokenNftInfo
+	lastSerial int64
+}
+
+type _Topic struct {
+	info     *services.ConsensusTopicInfo
+	messages [][]byte
+	deleted  bool
+}
+
+type _File struct {
+	info     *services.FileGetInfoResponse_FileInfo
+	contents []byte
+}
+
+type _Contract struct {
+	info     *services.ContractGetInfoResponse_ContractInfo
+	bytecode []byte
+}
+
+type _Schedule struct {
+	info *services.ScheduleInfo
+}
+
+// _PendingAirdrop identifies an airdrop waiting to be claimed by its receiver. The serial number is 0 for fungible
+// tokens, whose pending amounts are kept in _Ledger.airdrops.
+type _PendingAirdrop struct {
+	sender   int64
+	receiver int64
+	token    int64
+	serial   int64
+}
+
+// _Transaction is a transaction being applied to the ledger.
+type _Transaction struct {
+	body          *services.TransactionBody
+	payer         *_Account
+	signed        []hiero.PublicKey
+	consensusTime time.Time
+	receipt       *services.TransactionReceipt
+	record        *services.TransactionRecord
+	transfers     map[int64]int64
+}
+
+func _NewLedger(genesisKey hiero.PublicKey, nodeCount int) *_Ledger {
+	ledger := &_Ledger{
+		nextEntity: _FirstEntity,
+		accounts:   make(map[int64]*_Account),
+		tokens:     make(map[int64]*_Token),
+		topics:     make(map[int64]*_Topic),
+		files:      make(map[int64]*_File),
+		contracts:  make(map[int64]*_Contract),
+		schedules:  make(map[int64]*_Schedule),
+		records:    make(map[string]*services.TransactionRecord),
+		airdrops:   make(map[_PendingAirdrop]uint64),
+	}
+
+	protoKey, _ := _KeyToProtobuf(genesisKey)
+	ledger._PutAccount(_GenesisAccount, protoKey, GenesisBalance.AsTinybar())
+	for i := range nodeCount {
+		ledger._PutAccount(int64(_FirstNodeAccount+i), protoKey, 0)
+	}
+
+	// the fee schedule and exchange rate files let clients use Client.GetFeeSchedules and Client.GetExchangeRates
+	systemKeys := &services.KeyList{Keys: []*services.Key{protoKey}}
+	if feeSchedules, err := hiero.BundledFeeSchedules(); err == nil {
+		ledger._PutFile(_FeeScheduleFile, systemKeys, feeSchedules.ToBytes())
+	}
+	ledger._PutFile(_ExchangeRateFile, systemKeys, _Marshal(&services.ExchangeRateSet{
+		CurrentRate: &services.ExchangeRate{
+			HbarEquiv:      1,
+			CentEquiv:      12,
+			ExpirationTime: &services.TimestampSeconds{Seconds: time.Now().Add(100 * 365 * 24 * time.Hour).Unix()},
+		},
+		NextRate: &services.ExchangeRate{
+			HbarEquiv:      1,
+			CentEquiv:      12,
+			ExpirationTime: &services.TimestampSeconds{Seconds: time.Now().Add(100 * 365 * 24 * time.Hour).Unix()},
+		},
+	}))
+
+	return ledger
+}
+
+func (ledger *_Ledger) _NextEntity() int64 {
+	num := ledger.nextEntity
+	ledger.nextEntity++
+	return num
+}
+
+// _ConsensusTime returns a consensus timestamp after every previous one.
+func (ledger *_Ledger) _ConsensusTime() time.Time {
+	now := time.Now()
+	if !now.After(ledger.lastConsensus) {
+		now = ledger.lastConsensus.Add(time.Nanosecond)
+	}
+	ledger.lastConsensus = now
+
+	return now
+}
+
+func (airdrop _PendingAirdrop) _ToProtobuf() *services.PendingAirdropId {
+	id := &services.PendingAirdropId{
+		SenderId:   _AccountIDToProtobuf(airdrop.sender),
+		ReceiverId: _AccountIDToProtobuf(airdrop.receiver),
+	}
+	if airdrop.serial == 0 {
+		id.TokenReference = &services.PendingAirdropId_FungibleTokenType{FungibleTokenType: _TokenIDToProtobuf(airdrop.token)}
+	} else {
+		id.TokenReference = &services.PendingAirdropId_NonFungibleToken{
+			NonFungibleToken: &services.NftID{Token_ID: _TokenIDToProtobuf(airdrop.token), SerialNumber: airdrop.serial},
+		}
+	}
+
+	return id
+}
+
+func (ledger *_Ledger) _CreateAccount(key *services.Key, balance int64) *_Account {
+	return ledger._PutAccount(ledger._NextEntity(), key, balance)
+}
+
+func (ledger *_Ledger) _PutAccount(num int64, key *services.Key, balance int64) *_Account {
+	account := &_Account{
+		info: &services.CryptoGetInfoResponse_AccountInfo{
+			AccountID:       _AccountIDToProtobuf(num),
+			Key:             key,
+			Balance:         uint64(balance),
+			ExpirationTime:  _TimestampToProtobuf(time.Now().Add(_DefaultAutoRenewPeriod)),
+			AutoRenewPeriod: _DurationToProtobuf(_DefaultAutoRenewPeriod),
+		},
+		tokens: make(map[int64]*_TokenRelationship),
+	}
+	ledger.accounts[num] = account
+
+	return account
+}
+
+func (ledger *_Ledger) _PutFile(num int64, keys *services.KeyList, contents []byte) *_File {
+	file := &_File{
+		info: &services.FileGetInfoResponse_FileInfo{
+			FileID:         _FileIDToProtobuf(num),
+			Size:           int64(len(contents)),
+			ExpirationTime: _TimestampToProtobuf(time.Now().Add(_DefaultAutoRenewPeriod)),
+			Keys:           keys,
+		},
+		contents: contents,
+	}
+	ledger.files[num] = file
+
+	return file
+}
+
+func (ledger *_Ledger) _GetAccount(accountID *services.AccountID) (*_Account, services.ResponseCodeEnum) {
+	account, ok := ledger.accounts[accountID.GetAccountNum()]
+	if accountID == nil || !ok {
+		return nil, services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+	}
+	if account.info.Deleted {
+		return nil, services.ResponseCodeEnum_ACCOUNT_DELETED
+	}
+
+	return account, services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _GetToken(tokenID *services.TokenID) (*_Token, services.ResponseCodeEnum) {
+	token, ok := ledger.tokens[tokenID.GetTokenNum()]
+	if tokenID == nil || !ok {
+		return nil, services.ResponseCodeEnum_INVALID_TOKEN_ID
+	}
+	if token.info.Deleted {
+		return nil, services.ResponseCodeEnum_TOKEN_WAS_DELETED
+	}
+
+	return token, services.ResponseCodeEnum_SUCCESS
+}
+
+// _GetUnpausedToken is _GetToken for the transactions a paused token rejects.
+func (ledger *_Ledger) _GetUnpausedToken(tokenID *services.TokenID) (*_Token, services.ResponseCodeEnum) {
+	token, status := ledger._GetToken(tokenID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return nil, status
+	}
+	if token.info.PauseStatus == services.TokenPauseStatus_Paused {
+		return nil, services.ResponseCodeEnum_TOKEN_IS_PAUSED
+	}
+
+	return token, services.ResponseCodeEnum_SUCCESS
+}
+
+// _GetTokenRelationship returns the association of an account with a token, which must allow the account to send
+// and receive the token.
+func (ledger *_Ledger) _GetTokenRelationship(account *_Account, token *_Token) (*_TokenRelationship, services.ResponseCodeEnum) {
+	relationship, ok := account.tokens[token.info.TokenId.GetTokenNum()]
+	if !ok {
+		return nil, services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+	}
+	if relationship.frozen {
+		return nil, services.ResponseCodeEnum_ACCOUNT_FROZEN_FOR_TOKEN
+	}
+	if token.info.KycKey != nil && !relationship.kycGranted {
+		return nil, services.ResponseCodeEnum_ACCOUNT_KYC_NOT_GRANTED_FOR_TOKEN
+	}
+
+	return relationship, services.ResponseCodeEnum_SUCCESS
+}
+
+// _Associate associates an account with a token, frozen if the token freezes new associations by default.
+func (account *_Account) _Associate(token *_Token) *_TokenRelationship {
+	relationship := &_TokenRelationship{
+		frozen: token.info.DefaultFreezeStatus == services.TokenFreezeStatus_Frozen,
+	}
+	account.tokens[token.info.TokenId.GetTokenNum()] = relationship
+
+	return relationship
+}
+
+func (ledger *_Ledger) _GetTopic(topicID *services.TopicID) (*_Topic, services.ResponseCodeEnum) {
+	topic, ok := ledger.topics[topicID.GetTopicNum()]
+	if topicID == nil || !ok || topic.deleted {
+		return nil, services.ResponseCodeEnum_INVALID_TOPIC_ID
+	}
+
+	return topic, services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _GetFile(fileID *services.FileID) (*_File, services.ResponseCodeEnum) {
+	file, ok := ledger.files[fileID.GetFileNum()]
+	if fileID == nil || !ok {
+		return nil, services.ResponseCodeEnum_INVALID_FILE_ID
+	}
+	if file.info.Deleted {
+		return nil, services.ResponseCodeEnum_FILE_DELETED
+	}
+
+	return file, services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _GetContract(contractID *services.ContractID) (*_Contract, services.ResponseCodeEnum) {
+	contract, ok := ledger.contracts[contractID.GetContractNum()]
+	if contractID == nil || !ok {
+		return nil, services.ResponseCodeEnum_INVALID_CONTRACT_ID
+	}
+	if contract.info.Deleted {
+		return nil, services.ResponseCodeEnum_CONTRACT_DELETED
+	}
+
+	return contract, services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _GetSchedule(scheduleID *services.ScheduleID) (*_Schedule, services.ResponseCodeEnum) {
+	schedule, ok := ledger.schedules[scheduleID.GetScheduleNum()]
+	if scheduleID == nil || !ok {
+		return nil, services.ResponseCodeEnum_INVALID_SCHEDULE_ID
+	}
+	if schedule.info.GetDeletionTime() != nil {
+		return nil, services.ResponseCodeEnum_SCHEDULE_ALREADY_DELETED
+	}
+
+	return schedule, services.ResponseCodeEnum_SUCCESS
+}
+
+// _Submit runs the precheck of a transaction sent to the node with the account number nodeAccount and, when it
+// passes, applies the transaction and stores its record. It returns the precheck status.
+func (ledger *_Ledger) _Submit(nodeAccount int64, transaction *services.Transaction) services.ResponseCodeEnum {
+	signedTransactionBytes := transaction.GetSignedTransactionBytes()
+	signedTransaction := &services.SignedTransaction{}
+	if len(signedTransactionBytes) == 0 || protobuf.Unmarshal(signedTransactionBytes, signedTransaction) != nil {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION
+	}
+
+	body := &services.TransactionBody{}
+	if err := protobuf.Unmarshal(signedTransaction.GetBodyBytes(), body); err != nil {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+	}
+
+	if body.GetNodeAccountID().GetAccountNum() != nodeAccount {
+		return services.ResponseCodeEnum_INVALID_NODE_ACCOUNT
+	}
+
+	transactionID := body.GetTransactionID()
+	if transactionID.GetAccountID() == nil || transactionID.GetTransactionValidStart() == nil {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_ID
+	}
+
+	now := time.Now()
+	validStart := _TimestampFromProtobuf(transactionID.GetTransactionValidStart())
+	validDuration := _DefaultValidDuration
+	if body.GetTransactionValidDuration() != nil {
+		validDuration = time.Duration(body.GetTransactionValidDuration().GetSeconds()) * time.Second
+	}
+	if validStart.After(now) {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_START
+	}
+	if validStart.Add(validDuration).Before(now) {
+		return services.ResponseCodeEnum_TRANSACTION_EXPIRED
+	}
+
+	signed, ok := _VerifySignatures(signedTransaction.GetSigMap(), signedTransaction.GetBodyBytes())
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	ledger.mutex.Lock()
+	defer ledger.mutex.Unlock()
+
+	key := _TransactionIDKey(transactionID)
+	if _, ok := ledger.records[key]; ok {
+		return services.ResponseCodeEnum_DUPLICATE_TRANSACTION
+	}
+
+	payer, ok := ledger.accounts[transactionID.GetAccountID().GetAccountNum()]
+	if !ok {
+		return services.ResponseCodeEnum_PAYER_ACCOUNT_NOT_FOUND
+	}
+	if payer.info.Deleted {
+		return services.ResponseCodeEnum_ACCOUNT_DELETED
+	}
+	if !_IsSigned(payer.info.Key, signed) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	hash := sha512.Sum384(signedTransactionBytes)
+	tx := &_Transaction{
+		body:          body,
+		payer:         payer,
+		signed:        signed,
+		consensusTime: ledger._ConsensusTime(),
+		receipt:       &services.TransactionReceipt{},
+		transfers:     make(map[int64]int64),
+	}
+	tx.record = &services.TransactionRecord{
+		TransactionHash:    hash[:],
+		ConsensusTimestamp: _TimestampToProtobuf(tx.consensusTime),
+		TransactionID:      transactionID,
+		Memo:               body.GetMemo(),
+	}
+
+	status := ledger._Apply(tx)
+	if status == services.ResponseCodeEnum_NOT_SUPPORTED {
+		return status
+	}
+
+	tx.receipt.Status = status
+	tx.record.Receipt = tx.receipt
+	tx.record.TransferList = tx._TransferList()
+	ledger.records[key] = tx.record
+
+	return services.ResponseCodeEnum_OK
+}
+
+// _Transfer moves amount tinybars from one account to another and adds them to the transfer list of the record.
+func (tx *_Transaction) _Transfer(from *_Account, to *_Account, amount int64) {
+	if amount == 0 {
+		return
+	}
+
+	from.info.Balance -= uint64(amount)
+	to.info.Balance += uint64(amount)
+	tx.transfers[from.info.AccountID.GetAccountNum()] -= amount
+	tx.transfers[to.info.AccountID.GetAccountNum()] += amount
+}
+
+func (tx *_Transaction) _TransferList() *services.TransferList {
+	nums := make([]int64, 0, len(tx.transfers))
+	for num, amount := range tx.transfers {
+		if amount != 0 {
+			nums = append(nums, num)
+		}
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+
+	transferList := &services.TransferList{}
+	for _, num := range nums {
+		transferList.AccountAmounts = append(transferList.AccountAmounts, &services.AccountAmount{
+			AccountID: _AccountIDToProtobuf(num),
+			Amount:    tx.transfers[num],
+		})
+	}
+
+	return transferList
+}
+
+func (tx *_Transaction) _IsSigned(key *services.Key) bool {
+	return _IsSigned(key, tx.signed)
+}
+
+// _IsSignedIfSet is _IsSigned for optional keys, which are satisfied when they are not set.
+func (tx *_Transaction) _IsSignedIfSet(key *services.Key) bool {
+	return key == nil || _IsSigned(key, tx.signed)
+}
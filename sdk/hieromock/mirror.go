@@ -0,0 +1,427 @@
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+var errInvalidChunkSize = errors.New("hieromock: chunk size must be positive")
+
+// MirrorNode is an in-process mock mirror node. It serves the ConsensusService topic subscriptions and the
+// NetworkService address book stream over gRPC, and the mirror node REST endpoints the SDK calls over HTTP.
+//
+// Topic messages are scripted with PublishMessage and PublishChunkedMessage. Subscriptions receive the published
+// messages matching their start time, end time and limit, and stay open for messages published later until their end
+// time passes. Subscribing to a topic which was neither created nor published to fails with NOT_FOUND, as it does
+// on a real mirror node.
+type MirrorNode struct {
+	mutex              sync.Mutex
+	listener           net.Listener
+	server             *grpc.Server
+	restListener       net.Listener
+	restServer         *http.Server
+	topics             map[string]*_MirrorTopic
+	addressBook        []*services.NodeAddress
+	accounts           map[string]*_MirrorAccount
+	contracts          map[string]*_MirrorContract
+	contractCallResult []byte
+	gasEstimate        uint64
+	streamFailures     []*_StreamFailure
+	restFailures       []*_RestFailure
+	published          chan struct{}
+	consensusTime      time.Time
+	streams            int
+	restRequests       int
+}
+
+type _MirrorTopic struct {
+	messages    []*mirror.ConsensusTopicResponse
+	runningHash []byte
+}
+
+// _StreamFailure fails a stream with code once it has sent after messages.
+type _StreamFailure struct {
+	code  codes.Code
+	after uint64
+}
+
+type _RestFailure struct {
+	statusCode int
+	remaining  int
+}
+
+// NewMirrorNode starts a mock mirror node with its gRPC and REST servers on local ports.
+func NewMirrorNode() (*MirrorNode, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	restListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	node := &MirrorNode{
+		listener:      listener,
+		server:        grpc.NewServer(),
+		restListener:  restListener,
+		topics:        make(map[string]*_MirrorTopic),
+		accounts:      make(map[string]*_MirrorAccount),
+		contracts:     make(map[string]*_MirrorContract),
+		published:     make(chan struct{}),
+		consensusTime: time.Now(),
+	}
+	node.restServer = &http.Server{Handler: node._RestHandler(), ReadHeaderTimeout: 10 * time.Second}
+
+	mirror.RegisterConsensusServiceServer(node.server, &_MirrorConsensusService{node: node})
+	mirror.RegisterNetworkServiceServer(node.server, &_MirrorNetworkService{node: node})
+
+	go func() {
+		_ = node.server.Serve(listener)
+	}()
+	go func() {
+		_ = node.restServer.Serve(restListener)
+	}()
+
+	return node, nil
+}
+
+// Close stops the gRPC and REST servers, ending every open subscription.
+func (node *MirrorNode) Close() {
+	node.server.Stop()
+	_ = node.restServer.Close()
+}
+
+// GetAddress returns the host:port address of the gRPC server, for Client.SetMirrorNetwork.
+func (node *MirrorNode) GetAddress() string {
+	return node.listener.Addr().String()
+}
+
+// GetRestApiBaseUrl returns the base URL of the REST API, for Client.SetMirrorRestApiBaseUrl.
+func (node *MirrorNode) GetRestApiBaseUrl() string {
+	return fmt.Sprintf("http://%s/api/v1", node.restListener.Addr().String())
+}
+
+// Configure points the mirror network and the mirror node REST API of client at the mock mirror node, and removes
+// the backoff between retried REST requests.
+func (node *MirrorNode) Configure(client *hiero.Client) *hiero.Client {
+	client.SetMirrorNetwork([]string{node.GetAddress()})
+	client.SetMirrorRestApiBaseUrl(node.GetRestApiBaseUrl())
+	client.SetMinBackoff(0)
+	client.SetMaxBackoff(0)
+
+	return client
+}
+
+// NewClient returns a client without consensus nodes whose mirror network is the mock mirror node. Use Configure
+// to connect the client of a Network to the mirror node instead.
+func (node *MirrorNode) NewClient() *hiero.Client {
+	return node.Configure(hiero.ClientForNetwork(map[string]hiero.AccountID{}))
+}
+
+// CreateTopic registers a topic without messages, so that subscriptions to it wait for messages instead of failing
+// with NOT_FOUND.
+func (node *MirrorNode) CreateTopic(topicID hiero.TopicID) *MirrorNode {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node._Topic(topicID)
+
+	return node
+}
+
+// PublishMessage publishes a single chunk message to a topic, creating the topic if needed, and returns its
+// consensus timestamp. The message is delivered to the open subscriptions of the topic.
+func (node *MirrorNode) PublishMessage(topicID hiero.TopicID, message []byte) time.Time {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	response := node._Append(topicID, message, nil)
+	node._Notify()
+
+	return _TimestampFromProtobuf(response.ConsensusTimestamp)
+}
+
+// PublishChunkedMessage splits message into chunks of at most chunkSize bytes and publishes them to a topic as the
+// chunks of a single message, the way TopicMessageSubmitTransaction submits large messages. It returns the consensus
+// timestamp of the last chunk.
+func (node *MirrorNode) PublishChunkedMessage(topicID hiero.TopicID, message []byte, chunkSize int) (time.Time, error) {
+	if chunkSize <= 0 {
+		return time.Time{}, errInvalidChunkSize
+	}
+
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	total := max((len(message)+chunkSize-1)/chunkSize, 1)
+	initialTransactionID := &services.TransactionID{
+		AccountID:             _AccountIDToProtobuf(_GenesisAccount),
+		TransactionValidStart: _TimestampToProtobuf(node.consensusTime),
+	}
+
+	var response *mirror.ConsensusTopicResponse
+	for number := range total {
+		chunk := message[number*chunkSize : min((number+1)*chunkSize, len(message))]
+		response = node._Append(topicID, chunk, &services.ConsensusMessageChunkInfo{
+			InitialTransactionID: initialTransactionID,
+			Total:                int32(total),
+			Number:               int32(number + 1),
+		})
+	}
+	node._Notify()
+
+	return _TimestampFromProtobuf(response.ConsensusTimestamp), nil
+}
+
+// GetTopicMessageCount returns the number of messages, counting every chunk, published to a topic.
+func (node *MirrorNode) GetTopicMessageCount(topicID hiero.TopicID) int {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	if topic, ok := node.topics[topicID.String()]; ok {
+		return len(topic.messages)
+	}
+
+	return 0
+}
+
+// SetAddressBook sets the nodes streamed by the NetworkService GetNodes method, for every address book file ID.
+func (node *MirrorNode) SetAddressBook(addressBook hiero.NodeAddressBook) error {
+	book := &services.NodeAddressBook{}
+	if err := protobuf.Unmarshal(addressBook.ToBytes(), book); err != nil {
+		return err
+	}
+
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.addressBook = book.GetNodeAddress()
+
+	return nil
+}
+
+// FailNextStreams makes the next count topic or address book streams fail with code before sending any message,
+// e.g. codes.NotFound or codes.ResourceExhausted. The SDK retries NOT_FOUND, RESOURCE_EXHAUSTED and UNAVAILABLE.
+func (node *MirrorNode) FailNextStreams(code codes.Code, count int) *MirrorNode {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	for range count {
+		node.streamFailures = append(node.streamFailures, &_StreamFailure{code: code})
+	}
+
+	return node
+}
+
+// DropNextStream makes the next topic or address book stream fail with code once it has sent after messages, as if
+// the connection dropped in the middle of the subscription.
+func (node *MirrorNode) DropNextStream(after int, code codes.Code) *MirrorNode {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.streamFailures = append(node.streamFailures, &_StreamFailure{code: code, after: uint64(max(after, 0))})
+
+	return node
+}
+
+// GetStreamCount returns the number of topic and address book streams opened so far, including the failed ones.
+func (node *MirrorNode) GetStreamCount() int {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	return node.streams
+}
+
+// _Topic returns a topic, creating it if needed. The mutex must be held.
+func (node *MirrorNode) _Topic(topicID hiero.TopicID) *_MirrorTopic {
+	topic, ok := node.topics[topicID.String()]
+	if !ok {
+		topic = &_MirrorTopic{runningHash: make([]byte, sha512.Size384)}
+		node.topics[topicID.String()] = topic
+	}
+
+	return topic
+}
+
+// _Append adds a message to a topic with the next consensus timestamp. The mutex must be held.
+func (node *MirrorNode) _Append(topicID hiero.TopicID, message []byte, chunkInfo *services.ConsensusMessageChunkInfo) *mirror.ConsensusTopicResponse {
+	topic := node._Topic(topicID)
+
+	node.consensusTime = node.consensusTime.Add(time.Nanosecond)
+	if now := time.Now(); now.After(node.consensusTime) {
+		node.consensusTime = now
+	}
+
+	sequenceNumber := uint64(len(topic.messages) + 1)
+	consensusTimestamp := _TimestampToProtobuf(node.consensusTime)
+
+	// the running hash only chains the messages, it is not computed as the network does
+	hash := sha512.New384()
+	hash.Write(topic.runningHash)
+	hash.Write(message)
+	topic.runningHash = hash.Sum(nil)
+
+	response := &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: consensusTimestamp,
+		Message:            message,
+		RunningHash:        topic.runningHash,
+		SequenceNumber:     sequenceNumber,
+		RunningHashVersion: _RunningHashVersion,
+		ChunkInfo:          chunkInfo,
+	}
+	topic.messages = append(topic.messages, response)
+
+	return response
+}
+
+// _Notify wakes up the subscriptions waiting for new messages. The mutex must be held.
+func (node *MirrorNode) _Notify() {
+	close(node.published)
+	node.published = make(chan struct{})
+}
+
+// _OpenStream counts a new stream and returns the failure it should have, if any.
+func (node *MirrorNode) _OpenStream() *_StreamFailure {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.streams++
+	if len(node.streamFailures) == 0 {
+		return nil
+	}
+
+	failure := node.streamFailures[0]
+	node.streamFailures = node.streamFailures[1:]
+
+	return failure
+}
+
+// _Failed reports whether a stream with failure has to fail after sending sent messages.
+func (failure *_StreamFailure) _Failed(sent uint64) error {
+	if failure == nil || sent != failure.after {
+		return nil
+	}
+
+	return status.Error(failure.code, "hieromock: injected stream failure")
+}
+
+type _MirrorConsensusService struct {
+	mirror.UnimplementedConsensusServiceServer
+	node *MirrorNode
+}
+
+func (service *_MirrorConsensusService) SubscribeTopic(query *mirror.ConsensusTopicQuery, stream mirror.ConsensusService_SubscribeTopicServer) error {
+	node := service.node
+	failure := node._OpenStream()
+
+	topicID := hiero.TopicID{
+		Shard: uint64(query.GetTopicID().GetShardNum()),
+		Realm: uint64(query.GetTopicID().GetRealmNum()),
+		Topic: uint64(query.GetTopicID().GetTopicNum()),
+	}
+	startTime := _TimestampFromProtobuf(query.GetConsensusStartTime())
+	var endTime <-chan time.Time
+	if query.GetConsensusEndTime() != nil {
+		timer := time.NewTimer(time.Until(_TimestampFromProtobuf(query.GetConsensusEndTime())))
+		defer timer.Stop()
+		endTime = timer.C
+	}
+
+	sent := uint64(0)
+	next := 0
+	for {
+		if err := failure._Failed(sent); err != nil {
+			return err
+		}
+
+		node.mutex.Lock()
+		topic, ok := node.topics[topicID.String()]
+		if !ok {
+			node.mutex.Unlock()
+			return status.Errorf(codes.NotFound, "topic %s does not exist", topicID.String())
+		}
+		pending := topic.messages[next:]
+		published := node.published
+		node.mutex.Unlock()
+
+		for _, message := range pending {
+			next++
+
+			consensusTime := _TimestampFromProtobuf(message.ConsensusTimestamp)
+			if consensusTime.Before(startTime) {
+				continue
+			}
+			if query.GetConsensusEndTime() != nil && !consensusTime.Before(_TimestampFromProtobuf(query.GetConsensusEndTime())) {
+				return nil
+			}
+
+			if err := failure._Failed(sent); err != nil {
+				return err
+			}
+			if err := stream.Send(message); err != nil {
+				return err
+			}
+
+			sent++
+			if query.GetLimit() > 0 && sent >= query.GetLimit() {
+				return failure._Failed(sent)
+			}
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-endTime:
+			return nil
+		case <-published:
+		}
+	}
+}
+
+type _MirrorNetworkService struct {
+	mirror.UnimplementedNetworkServiceServer
+	node *MirrorNode
+}
+
+func (service *_MirrorNetworkService) GetNodes(query *mirror.AddressBookQuery, stream mirror.NetworkService_GetNodesServer) error {
+	node := service.node
+	failure := node._OpenStream()
+
+	node.mutex.Lock()
+	addressBook := node.addressBook
+	node.mutex.Unlock()
+
+	sent := uint64(0)
+	for _, address := range addressBook {
+		if err := failure._Failed(sent); err != nil {
+			return err
+		}
+		if err := stream.Send(address); err != nil {
+			return err
+		}
+
+		sent++
+		if query.GetLimit() > 0 && sent >= uint64(query.GetLimit()) {
+			return nil
+		}
+	}
+
+	return failure._Failed(sent)
+}
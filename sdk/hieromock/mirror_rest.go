@@ -0,0 +1,239 @@
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// _MirrorAccount is an account served by the `/accounts/{id}` endpoint.
+type _MirrorAccount struct {
+	accountID  hiero.AccountID
+	evmAddress string
+	balance    hiero.Hbar
+	created    time.Time
+}
+
+// _MirrorContract is a contract served by the `/contracts/{id}` endpoint.
+type _MirrorContract struct {
+	contractID hiero.ContractID
+	evmAddress string
+	created    time.Time
+}
+
+// AddAccount adds an account to the `/accounts/{id}` endpoint, which is looked up by its ID or by evmAddress. A nil
+// evmAddress makes the account use the long zero address of its ID, as `AccountID.PopulateEvmAddress` expects.
+func (node *MirrorNode) AddAccount(accountID hiero.AccountID, evmAddress []byte, balance hiero.Hbar) *MirrorNode {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	account := &_MirrorAccount{
+		accountID:  hiero.AccountID{Shard: accountID.Shard, Realm: accountID.Realm, Account: accountID.Account},
+		evmAddress: hex.EncodeToString(evmAddress),
+		balance:    balance,
+		created:    time.Now(),
+	}
+	if evmAddress == nil {
+		account.evmAddress = account.accountID.ToEvmAddress()
+	}
+
+	node.accounts[account.accountID.String()] = account
+	node.accounts[account.evmAddress] = account
+
+	return node
+}
+
+// AddContract adds a contract to the `/contracts/{id}` endpoint, which is looked up by its ID or by evmAddress. A
+// nil evmAddress makes the contract use the long zero address of its ID.
+func (node *MirrorNode) AddContract(contractID hiero.ContractID, evmAddress []byte) *MirrorNode {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	contract := &_MirrorContract{
+		contractID: hiero.ContractID{Shard: contractID.Shard, Realm: contractID.Realm, Contract: contractID.Contract},
+		evmAddress: hex.EncodeToString(evmAddress),
+		created:    time.Now(),
+	}
+	if evmAddress == nil {
+		contract.evmAddress = contract.contractID.ToEvmAddress()
+	}
+
+	node.contracts[contract.contractID.String()] = contract
+	node.contracts[contract.evmAddress] = contract
+
+	return node
+}
+
+// SetContractCallResult sets the result returned by `/contracts/call` for calls which are not gas estimates, i.e.
+// the result of MirrorNodeContractCallQuery.
+func (node *MirrorNode) SetContractCallResult(result []byte) *MirrorNode {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.contractCallResult = result
+
+	return node
+}
+
+// SetGasEstimate sets the gas returned by `/contracts/call` for gas estimates, i.e. the result of
+// MirrorNodeContractEstimateGasQuery.
+func (node *MirrorNode) SetGasEstimate(gas uint64) *MirrorNode {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.gasEstimate = gas
+
+	return node
+}
+
+// FailNextRestRequests makes the next count REST requests fail with the HTTP status statusCode. The SDK retries
+// 429 and 5xx responses.
+func (node *MirrorNode) FailNextRestRequests(statusCode int, count int) *MirrorNode {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	if count > 0 {
+		node.restFailures = append(node.restFailures, &_RestFailure{statusCode: statusCode, remaining: count})
+	}
+
+	return node
+}
+
+// GetRestRequestCount returns the number of REST requests received so far, including the failed ones.
+func (node *MirrorNode) GetRestRequestCount() int {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	return node.restRequests
+}
+
+func (node *MirrorNode) _RestHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/accounts/{id}", node._GetAccount)
+	mux.HandleFunc("GET /api/v1/contracts/{id}", node._GetContract)
+	mux.HandleFunc("POST /api/v1/contracts/call", node._ContractCall)
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if statusCode := node._NextRestFailure(); statusCode != 0 {
+			_WriteRestError(writer, statusCode, http.StatusText(statusCode))
+			return
+		}
+
+		mux.ServeHTTP(writer, request)
+	})
+}
+
+// _NextRestFailure counts a REST request and returns the status code it should fail with, or 0.
+func (node *MirrorNode) _NextRestFailure() int {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.restRequests++
+	if len(node.restFailures) == 0 {
+		return 0
+	}
+
+	failure := node.restFailures[0]
+	failure.remaining--
+	if failure.remaining <= 0 {
+		node.restFailures = node.restFailures[1:]
+	}
+
+	return failure.statusCode
+}
+
+func (node *MirrorNode) _GetAccount(writer http.ResponseWriter, request *http.Request) {
+	node.mutex.Lock()
+	account, ok := node.accounts[_RestEntityKey(request.PathValue("id"))]
+	node.mutex.Unlock()
+
+	if !ok {
+		_WriteRestError(writer, http.StatusNotFound, "Not found")
+		return
+	}
+
+	_WriteRestJSON(writer, map[string]any{
+		"account":     account.accountID.String(),
+		"alias":       nil,
+		"evm_address": "0x" + account.evmAddress,
+		"balance": map[string]any{
+			"balance":   account.balance.AsTinybar(),
+			"timestamp": _RestTimestamp(time.Now()),
+			"tokens":    []any{},
+		},
+		"key":               nil,
+		"memo":              "",
+		"deleted":           false,
+		"created_timestamp": _RestTimestamp(account.created),
+	})
+}
+
+func (node *MirrorNode) _GetContract(writer http.ResponseWriter, request *http.Request) {
+	node.mutex.Lock()
+	contract, ok := node.contracts[_RestEntityKey(request.PathValue("id"))]
+	node.mutex.Unlock()
+
+	if !ok {
+		_WriteRestError(writer, http.StatusNotFound, "Not found")
+		return
+	}
+
+	_WriteRestJSON(writer, map[string]any{
+		"contract_id":       contract.contractID.String(),
+		"evm_address":       "0x" + contract.evmAddress,
+		"admin_key":         nil,
+		"memo":              "",
+		"deleted":           false,
+		"created_timestamp": _RestTimestamp(contract.created),
+	})
+}
+
+func (node *MirrorNode) _ContractCall(writer http.ResponseWriter, request *http.Request) {
+	var call struct {
+		Estimate bool `json:"estimate"`
+	}
+	if err := json.NewDecoder(request.Body).Decode(&call); err != nil {
+		_WriteRestError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	node.mutex.Lock()
+	result := "0x" + hex.EncodeToString(node.contractCallResult)
+	if call.Estimate {
+		result = fmt.Sprintf("0x%x", node.gasEstimate)
+	}
+	node.mutex.Unlock()
+
+	_WriteRestJSON(writer, map[string]any{"result": result})
+}
+
+// _RestEntityKey normalises an entity ID or EVM address path parameter to the key of the accounts and contracts.
+func _RestEntityKey(id string) string {
+	return strings.ToLower(strings.TrimPrefix(id, "0x"))
+}
+
+func _RestTimestamp(t time.Time) string {
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
+
+func _WriteRestJSON(writer http.ResponseWriter, body any) {
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode(body)
+}
+
+func _WriteRestError(writer http.ResponseWriter, statusCode int, message string) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	_ = json.NewEncoder(writer).Encode(map[string]any{
+		"_status": map[string]any{
+			"messages": []map[string]string{{"message": message}},
+		},
+	})
+}
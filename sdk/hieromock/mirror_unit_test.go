@@ -0,0 +1,218 @@
+//go:build all || unit
+
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func _NewTestMirrorNode(t *testing.T) (*MirrorNode, *hiero.Client) {
+	mirrorNode, err := NewMirrorNode()
+	require.NoError(t, err)
+	client := mirrorNode.NewClient()
+
+	t.Cleanup(func() {
+		_ = client.Close()
+		mirrorNode.Close()
+	})
+
+	return mirrorNode, client
+}
+
+// _Subscribe subscribes to a topic and returns the received messages and the final error status, which is nil when
+// the subscription completed.
+func _Subscribe(t *testing.T, client *hiero.Client, query *hiero.TopicMessageQuery) (<-chan hiero.TopicMessage, <-chan *status.Status) {
+	messages := make(chan hiero.TopicMessage, 16)
+	done := make(chan *status.Status, 1)
+
+	handle, err := query.
+		SetErrorHandler(func(stat status.Status) { done <- &stat }).
+		SetCompletionHandler(func() { done <- nil }).
+		Subscribe(client, func(message hiero.TopicMessage) { messages <- message })
+	require.NoError(t, err)
+	t.Cleanup(handle.Unsubscribe)
+
+	return messages, done
+}
+
+func _Receive(t *testing.T, messages <-chan hiero.TopicMessage, count int) []string {
+	contents := make([]string, 0, count)
+	for range count {
+		select {
+		case message := <-messages:
+			contents = append(contents, string(message.Contents))
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "timed out waiting for topic messages", "received %v", contents)
+		}
+	}
+
+	return contents
+}
+
+func TestUnitMirrorNodeTopicMessages(t *testing.T) {
+	t.Parallel()
+
+	mirrorNode, client := _NewTestMirrorNode(t)
+	topicID := hiero.TopicID{Topic: 1001}
+
+	mirrorNode.PublishMessage(topicID, []byte("first"))
+	_, err := mirrorNode.PublishChunkedMessage(topicID, []byte("a chunked message"), 5)
+	require.NoError(t, err)
+	assert.Equal(t, 5, mirrorNode.GetTopicMessageCount(topicID))
+
+	_, err = mirrorNode.PublishChunkedMessage(topicID, []byte("chunk"), 0)
+	assert.ErrorIs(t, err, errInvalidChunkSize)
+
+	messages, done := _Subscribe(t, client, hiero.NewTopicMessageQuery().SetTopicID(topicID).SetLimit(6))
+	assert.Equal(t, []string{"first", "a chunked message"}, _Receive(t, messages, 2))
+
+	// the subscription stays open for messages published later
+	mirrorNode.PublishMessage(topicID, []byte("last"))
+	assert.Equal(t, []string{"last"}, _Receive(t, messages, 1))
+	assert.Nil(t, <-done)
+
+	// the start time skips earlier messages
+	startTime := mirrorNode.PublishMessage(topicID, []byte("later"))
+	messages, done = _Subscribe(t, client, hiero.NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetStartTime(startTime).
+		SetEndTime(time.Now().Add(time.Second)))
+	assert.Equal(t, []string{"later"}, _Receive(t, messages, 1))
+	assert.Nil(t, <-done)
+}
+
+func TestUnitMirrorNodeTopicStreamFailures(t *testing.T) {
+	t.Parallel()
+
+	mirrorNode, client := _NewTestMirrorNode(t)
+	topicID := hiero.TopicID{Topic: 1001}
+	for _, message := range []string{"one", "two", "three", "four"} {
+		mirrorNode.PublishMessage(topicID, []byte(message))
+	}
+
+	// a dropped stream resumes after the last received message
+	mirrorNode.DropNextStream(2, codes.Unavailable)
+	messages, done := _Subscribe(t, client, hiero.NewTopicMessageQuery().SetTopicID(topicID).SetLimit(4))
+	assert.Equal(t, []string{"one", "two", "three", "four"}, _Receive(t, messages, 4))
+	assert.Nil(t, <-done)
+	assert.Equal(t, 2, mirrorNode.GetStreamCount())
+
+	// a stream which fails after the limit was received finishes instead of resubscribing
+	mirrorNode.DropNextStream(2, codes.Unavailable)
+	messages, done = _Subscribe(t, client, hiero.NewTopicMessageQuery().SetTopicID(topicID).SetLimit(2))
+	assert.Equal(t, []string{"one", "two"}, _Receive(t, messages, 2))
+	assert.Nil(t, <-done)
+	assert.Empty(t, messages)
+	assert.Equal(t, 3, mirrorNode.GetStreamCount())
+
+	mirrorNode.FailNextStreams(codes.ResourceExhausted, 1).FailNextStreams(codes.NotFound, 1)
+	messages, done = _Subscribe(t, client, hiero.NewTopicMessageQuery().SetTopicID(topicID).SetLimit(1))
+	assert.Equal(t, []string{"one"}, _Receive(t, messages, 1))
+	assert.Nil(t, <-done)
+	assert.Equal(t, 6, mirrorNode.GetStreamCount())
+
+	mirrorNode.FailNextStreams(codes.InvalidArgument, 1)
+	_, done = _Subscribe(t, client, hiero.NewTopicMessageQuery().SetTopicID(topicID))
+	stat := <-done
+	require.NotNil(t, stat)
+	assert.Equal(t, codes.InvalidArgument, stat.Code())
+
+	_, done = _Subscribe(t, client, hiero.NewTopicMessageQuery().SetTopicID(hiero.TopicID{Topic: 5}).SetMaxAttempts(0))
+	stat = <-done
+	require.NotNil(t, stat)
+	assert.Equal(t, codes.NotFound, stat.Code())
+}
+
+func TestUnitMirrorNodeAddressBook(t *testing.T) {
+	t.Parallel()
+
+	mirrorNode, client := _NewTestMirrorNode(t)
+	require.NoError(t, mirrorNode.SetAddressBook(hiero.NodeAddressBook{
+		NodeAddresses: []hiero.NodeAddress{
+			{NodeID: 0, AccountID: &hiero.AccountID{Account: 3}, PublicKey: "key0"},
+			{NodeID: 1, AccountID: &hiero.AccountID{Account: 4}, PublicKey: "key1"},
+		},
+	}))
+
+	mirrorNode.DropNextStream(1, codes.Unavailable)
+	book, err := hiero.NewAddressBookQuery().
+		SetFileID(hiero.FileIDForAddressBook()).
+		SetMaxAttempts(3).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, book.NodeAddresses, 2)
+	assert.Equal(t, hiero.AccountID{Account: 4}, *book.NodeAddresses[1].AccountID)
+	assert.Equal(t, 2, mirrorNode.GetStreamCount())
+
+	book, err = hiero.NewAddressBookQuery().SetFileID(hiero.FileIDForAddressBook()).SetLimit(1).Execute(client)
+	require.NoError(t, err)
+	assert.Len(t, book.NodeAddresses, 1)
+}
+
+func TestUnitMirrorNodeRest(t *testing.T) {
+	t.Parallel()
+
+	mirrorNode, client := _NewTestMirrorNode(t)
+	evmAddress, err := hex.DecodeString("742d35cc6634c0532925a3b844bc454e4438f44e")
+	require.NoError(t, err)
+
+	mirrorNode.AddAccount(hiero.AccountID{Account: 1001}, evmAddress, hiero.NewHbar(5))
+	mirrorNode.AddAccount(hiero.AccountID{Account: 1002}, nil, hiero.NewHbar(1))
+	mirrorNode.AddContract(hiero.ContractID{Contract: 1003}, evmAddress)
+
+	accountID, err := hiero.AccountIDFromEvmAddress(0, 0, hex.EncodeToString(evmAddress))
+	require.NoError(t, err)
+	require.NoError(t, accountID.PopulateAccount(client))
+	assert.Equal(t, uint64(1001), accountID.Account)
+
+	accountID = hiero.AccountID{Account: 1002}
+	require.NoError(t, accountID.PopulateEvmAddress(client))
+	require.NotNil(t, accountID.AliasEvmAddress)
+	assert.Equal(t, hiero.AccountID{Account: 1002}.ToEvmAddress(), hex.EncodeToString(*accountID.AliasEvmAddress))
+
+	contractID, err := hiero.ContractIDFromEvmAddress(0, 0, hex.EncodeToString(evmAddress))
+	require.NoError(t, err)
+	require.NoError(t, contractID.PopulateContract(client))
+	assert.Equal(t, uint64(1003), contractID.Contract)
+
+	restClient, err := client.GetMirrorRestClient()
+	require.NoError(t, err)
+	account, err := restClient.GetAccountByID(t.Context(), hiero.AccountID{Account: 1001})
+	require.NoError(t, err)
+	assert.Equal(t, hiero.NewHbar(5), account.Balance)
+
+	_, err = restClient.GetAccountByID(t.Context(), hiero.AccountID{Account: 9999})
+	var statusErr hiero.ErrMirrorRestStatus
+	require.ErrorAs(t, err, &statusErr)
+	assert.True(t, statusErr.IsNotFound())
+
+	mirrorNode.SetContractCallResult([]byte{0x01}).SetGasEstimate(21_000)
+	result, err := hiero.NewMirrorNodeContractCallQuery().
+		SetContractID(hiero.ContractID{Contract: 1003}).
+		SetFunction("get", nil).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "0x01", result)
+
+	// failed requests are retried
+	mirrorNode.FailNextRestRequests(http.StatusServiceUnavailable, 2)
+	requests := mirrorNode.GetRestRequestCount()
+	gas, err := hiero.NewMirrorNodeContractEstimateGasQuery().
+		SetContractID(hiero.ContractID{Contract: 1003}).
+		SetFunction("get", nil).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(21_000), gas)
+	assert.Equal(t, requests+3, mirrorNode.GetRestRequestCount())
+}
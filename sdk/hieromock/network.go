@@ -0,0 +1,390 @@
+// Package hieromock provides an in-process mock Hiero network for hermetic tests.
+//
+// A Network starts one gRPC server per consensus node on a local port. The nodes implement the CryptoService,
+// TokenService, ConsensusService, FileService, SmartContractService, ScheduleService and NetworkService and share an
+// in-memory ledger of accounts, balances, tokens, NFTs, pending airdrops, topics, files, contracts, schedules, receipts
+// and records.
+// Transactions reach consensus as soon as a node accepts them, so their receipts and records are available
+// immediately. Every node also serves the same services as gRPC-web on a second local port, for clients using the
+// gRPC-web transport.
+//
+// The ledger checks the payer signature and the keys of the entities a transaction modifies, but it does not
+// charge fees, does not run the EVM and does not execute scheduled transactions. Methods the mock does not
+// implement are answered with the gRPC status UNIMPLEMENTED.
+//
+// Failures can be injected per network or per node with FailNext and FailNode, and every request can be delayed
+// with SetLatency. A request answered with an injected precheck status does not reach the ledger.
+//
+// A MirrorNode serves scripted topic message streams and the address book over gRPC, and the mirror node REST
+// endpoints used by AccountID.PopulateAccount, ContractID.PopulateContract and the mirror node contract queries.
+// Streams can be failed or dropped mid-subscription to exercise the retries of TopicMessageQuery and
+// AddressBookQuery.
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// Failure is a failure injected by a node instead of handling a request.
+type Failure int
+
+const (
+	// FailureBusy answers with the precheck status BUSY, which the SDK retries.
+	FailureBusy Failure = iota + 1
+	// FailurePlatformNotActive answers with the precheck status PLATFORM_NOT_ACTIVE, which the SDK retries.
+	FailurePlatformNotActive
+	// FailureUnavailable fails the gRPC call with the status UNAVAILABLE, which makes the SDK mark the node as
+	// unhealthy and retry with another node.
+	FailureUnavailable
+)
+
+// String returns a string representation of the Failure
+func (failure Failure) String() string {
+	switch failure {
+	case FailureBusy:
+		return "BUSY"
+	case FailurePlatformNotActive:
+		return "PLATFORM_NOT_ACTIVE"
+	case FailureUnavailable:
+		return "UNAVAILABLE"
+	}
+
+	return fmt.Sprintf("UNKNOWN(%d)", int(failure))
+}
+
+// GenesisBalance is the balance of the operator account 0.0.2 of a new Network.
+var GenesisBalance = hiero.NewHbar(50_000_000_000)
+
+var errNodeNotFound = errors.New("hieromock: no node with this account ID")
+
+// Network is an in-process mock Hiero network.
+type Network struct {
+	mutex       sync.Mutex
+	ledger      *_Ledger
+	nodes       []*_Node
+	failures    []*_InjectedFailure
+	latency     time.Duration
+	operatorID  hiero.AccountID
+	operatorKey hiero.PrivateKey
+}
+
+type _InjectedFailure struct {
+	failure   Failure
+	remaining int
+}
+
+// NewNetwork starts a mock network with nodeCount nodes, with the account IDs 0.0.3 upwards. The genesis account
+// 0.0.2 holds GenesisBalance and is used as the operator of the clients returned by NewClient.
+func NewNetwork(nodeCount int) (*Network, error) {
+	if nodeCount < 1 {
+		return nil, errors.New("hieromock: a network needs at least one node")
+	}
+
+	operatorKey, err := hiero.PrivateKeyGenerateEd25519()
+	if err != nil {
+		return nil, err
+	}
+
+	network := &Network{
+		ledger:      _NewLedger(operatorKey.PublicKey(), nodeCount),
+		operatorID:  hiero.AccountID{Account: _GenesisAccount},
+		operatorKey: operatorKey,
+	}
+
+	for i := range nodeCount {
+		node, err := _NewNode(network, hiero.AccountID{Account: uint64(_FirstNodeAccount + i)})
+		if err != nil {
+			network.Close()
+			return nil, err
+		}
+		network.nodes = append(network.nodes, node)
+	}
+
+	return network, nil
+}
+
+// NewClient returns a client for the network with ClientForNetwork. Its operator is the genesis account 0.0.2 and
+// its backoffs are disabled, so that retries of injected failures do not slow tests down.
+func (network *Network) NewClient() *hiero.Client {
+	return network._ConfigureClient(hiero.ClientForNetwork(network.GetAddresses()))
+}
+
+// NewGrpcWebClient returns a client like NewClient which sends its requests with the gRPC-web transport to the
+// gRPC-web addresses of the nodes.
+func (network *Network) NewGrpcWebClient() *hiero.Client {
+	return network._ConfigureClient(hiero.ClientForNetwork(network.GetGrpcWebAddresses()).SetTransport(hiero.TransportGrpcWeb))
+}
+
+func (network *Network) _ConfigureClient(client *hiero.Client) *hiero.Client {
+	client.SetOperator(network.operatorID, network.operatorKey)
+	client.SetMinBackoff(0)
+	client.SetMaxBackoff(0)
+	client.SetMinNodeReadmitTime(0)
+	client.SetMaxNodeReadmitTime(0)
+	client.SetNodeMinBackoff(0)
+	client.SetNodeMaxBackoff(0)
+
+	return client
+}
+
+// Close stops the nodes of the network.
+func (network *Network) Close() {
+	for _, node := range network.nodes {
+		node._Close()
+	}
+}
+
+// GetAddresses returns the address of every node mapped to its account ID, as expected by ClientForNetwork.
+func (network *Network) GetAddresses() map[string]hiero.AccountID {
+	addresses := make(map[string]hiero.AccountID, len(network.nodes))
+	for _, node := range network.nodes {
+		addresses[node.listener.Addr().String()] = node.accountID
+	}
+
+	return addresses
+}
+
+// GetGrpcWebAddresses returns the gRPC-web address of every node mapped to its account ID. Every node serves its
+// services as gRPC-web on this address, without a proxy.
+func (network *Network) GetGrpcWebAddresses() map[string]hiero.AccountID {
+	addresses := make(map[string]hiero.AccountID, len(network.nodes))
+	for _, node := range network.nodes {
+		addresses[node.webListener.Addr().String()] = node.accountID
+	}
+
+	return addresses
+}
+
+// GetNodeAddressBook returns the address book of the network, with the gRPC address of every node as its endpoint and
+// its gRPC-web address as its gRPC-web proxy endpoint.
+func (network *Network) GetNodeAddressBook() hiero.NodeAddressBook {
+	book := hiero.NodeAddressBook{}
+	for i, node := range network.nodes {
+		accountID := node.accountID
+		webEndpoint := _LocalEndpoint(node.webListener.Addr())
+		book.NodeAddresses = append(book.NodeAddresses, hiero.NodeAddress{
+			AccountID:            &accountID,
+			NodeID:               int64(i),
+			Addresses:            []hiero.Endpoint{_LocalEndpoint(node.listener.Addr())},
+			GrpcWebProxyEndpoint: &webEndpoint,
+		})
+	}
+
+	return book
+}
+
+// GetOperator returns the genesis account 0.0.2 and its key.
+func (network *Network) GetOperator() (hiero.AccountID, hiero.PrivateKey) {
+	return network.operatorID, network.operatorKey
+}
+
+// FailNext makes the next count requests to any node fail with failure. A negative count fails every request until
+// ClearFailures is called. Failures injected for a node with FailNode take precedence.
+func (network *Network) FailNext(failure Failure, count int) *Network {
+	if count == 0 {
+		return network
+	}
+
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	network.failures = append(network.failures, &_InjectedFailure{failure: failure, remaining: count})
+	return network
+}
+
+// FailNode makes the next count requests to the node with nodeAccountID fail with failure. A negative count fails
+// every request until ClearFailures is called.
+func (network *Network) FailNode(nodeAccountID hiero.AccountID, failure Failure, count int) error {
+	node := network._GetNode(nodeAccountID)
+	if node == nil {
+		return errNodeNotFound
+	}
+	if count == 0 {
+		return nil
+	}
+
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	node.failures = append(node.failures, &_InjectedFailure{failure: failure, remaining: count})
+	return nil
+}
+
+// ClearFailures removes every injected failure.
+func (network *Network) ClearFailures() *Network {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	network.failures = nil
+	for _, node := range network.nodes {
+		node.failures = nil
+	}
+
+	return network
+}
+
+// SetLatency delays every request by latency before it is handled.
+func (network *Network) SetLatency(latency time.Duration) *Network {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	network.latency = latency
+	return network
+}
+
+// GetRequestCount returns the number of requests the node with nodeAccountID received, including failed ones.
+func (network *Network) GetRequestCount(nodeAccountID hiero.AccountID) int {
+	node := network._GetNode(nodeAccountID)
+	if node == nil {
+		return 0
+	}
+
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	return node.requests
+}
+
+// CreateAccount adds an account with key and balance to the ledger without a transaction, e.g. to seed a test.
+// The balance is not taken from the genesis account.
+func (network *Network) CreateAccount(key hiero.Key, balance hiero.Hbar) (hiero.AccountID, error) {
+	protoKey, err := _KeyToProtobuf(key)
+	if err != nil {
+		return hiero.AccountID{}, err
+	}
+
+	network.ledger.mutex.Lock()
+	defer network.ledger.mutex.Unlock()
+
+	return _AccountIDFromProtobuf(network.ledger._CreateAccount(protoKey, balance.AsTinybar()).info.AccountID), nil
+}
+
+// GetBalance returns the hbar balance of an account or contract, and whether it exists.
+func (network *Network) GetBalance(accountID hiero.AccountID) (hiero.Hbar, bool) {
+	network.ledger.mutex.Lock()
+	defer network.ledger.mutex.Unlock()
+
+	account, ok := network.ledger.accounts[int64(accountID.Account)]
+	if !ok {
+		return hiero.Hbar{}, false
+	}
+
+	return hiero.HbarFromTinybar(int64(account.info.Balance)), true
+}
+
+// GetTokenBalance returns the balance of a token held by an account, and whether the account is associated with
+// the token. The balance of a non-fungible token is the number of NFTs the account owns.
+func (network *Network) GetTokenBalance(accountID hiero.AccountID, tokenID hiero.TokenID) (uint64, bool) {
+	network.ledger.mutex.Lock()
+	defer network.ledger.mutex.Unlock()
+
+	account, ok := network.ledger.accounts[int64(accountID.Account)]
+	if !ok {
+		return 0, false
+	}
+	relationship, ok := account.tokens[int64(tokenID.Token)]
+	if !ok {
+		return 0, false
+	}
+
+	return relationship.balance, true
+}
+
+// GetTopicMessages returns the messages submitted to a topic in consensus order.
+func (network *Network) GetTopicMessages(topicID hiero.TopicID) [][]byte {
+	network.ledger.mutex.Lock()
+	defer network.ledger.mutex.Unlock()
+
+	topic, ok := network.ledger.topics[int64(topicID.Topic)]
+	if !ok {
+		return nil
+	}
+
+	return append([][]byte(nil), topic.messages...)
+}
+
+// GetFileContents returns the contents of a file, and whether it exists.
+func (network *Network) GetFileContents(fileID hiero.FileID) ([]byte, bool) {
+	network.ledger.mutex.Lock()
+	defer network.ledger.mutex.Unlock()
+
+	file, ok := network.ledger.files[int64(fileID.File)]
+	if !ok {
+		return nil, false
+	}
+
+	return append([]byte(nil), file.contents...), true
+}
+
+// GetRecord returns the record of a transaction which reached consensus, and whether there is one.
+func (network *Network) GetRecord(transactionID hiero.TransactionID) (hiero.TransactionRecord, bool) {
+	data := transactionID.ToBytes()
+
+	network.ledger.mutex.Lock()
+	record, ok := network.ledger.records[_TransactionIDKeyFromBytes(data)]
+	network.ledger.mutex.Unlock()
+	if !ok {
+		return hiero.TransactionRecord{}, false
+	}
+
+	converted, err := hiero.TransactionRecordFromBytes(_Marshal(record))
+	if err != nil {
+		return hiero.TransactionRecord{}, false
+	}
+
+	return converted, true
+}
+
+func (network *Network) _GetNode(nodeAccountID hiero.AccountID) *_Node {
+	for _, node := range network.nodes {
+		if node.accountID.Account == nodeAccountID.Account {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// _NextFailure counts a request to node and returns the latency to apply and the failure to inject, if any.
+func (network *Network) _NextFailure(node *_Node) (time.Duration, Failure) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	node.requests++
+
+	for _, failures := range []*[]*_InjectedFailure{&node.failures, &network.failures} {
+		if len(*failures) == 0 {
+			continue
+		}
+
+		next := (*failures)[0]
+		if next.remaining > 0 {
+			next.remaining--
+			if next.remaining == 0 {
+				*failures = (*failures)[1:]
+			}
+		}
+
+		return network.latency, next.failure
+	}
+
+	return network.latency, 0
+}
+
+// _LocalEndpoint returns the endpoint of a local listener address
+func _LocalEndpoint(addr net.Addr) hiero.Endpoint {
+	endpoint := hiero.Endpoint{}
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+		endpoint.SetAddress(tcpAddr.IP.To4()).SetPort(int32(tcpAddr.Port)) // #nosec
+	}
+
+	return endpoint
+}
@@ -0,0 +1,623 @@
+//go:build all || unit
+
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewTestNetwork(t *testing.T, nodeCount int) (*Network, *hiero.Client) {
+	network, err := NewNetwork(nodeCount)
+	require.NoError(t, err)
+	client := network.NewClient()
+
+	t.Cleanup(func() {
+		_ = client.Close()
+		network.Close()
+	})
+
+	return network, client
+}
+
+func TestUnitNetworkAccountsAndTransfers(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 2)
+	operatorID, _ := network.GetOperator()
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	response, err := hiero.NewAccountCreateTransaction().
+		SetKeyWithoutAlias(key.PublicKey()).
+		SetInitialBalance(hiero.NewHbar(10)).
+		SetAccountMemo("alice").
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.AccountID)
+	alice := *receipt.AccountID
+	assert.Equal(t, uint64(_FirstEntity), alice.Account)
+
+	balance, err := hiero.NewAccountBalanceQuery().SetAccountID(alice).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, hiero.NewHbar(10), balance.Hbars)
+
+	// a transfer from alice needs her signature
+	transfer, err := hiero.NewTransferTransaction().
+		AddHbarTransfer(alice, hiero.NewHbar(-4)).
+		AddHbarTransfer(operatorID, hiero.NewHbar(4)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err = transfer.Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	assert.ErrorContains(t, err, "INVALID_SIGNATURE")
+
+	transfer, err = hiero.NewTransferTransaction().
+		AddHbarTransfer(alice, hiero.NewHbar(-4)).
+		AddHbarTransfer(operatorID, hiero.NewHbar(4)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err = transfer.Sign(key).Execute(client)
+	require.NoError(t, err)
+	record, err := response.GetRecord(client)
+	require.NoError(t, err)
+	assert.Equal(t, hiero.StatusSuccess, record.Receipt.Status)
+	assert.Len(t, record.Transfers, 2)
+
+	aliceBalance, ok := network.GetBalance(alice)
+	require.True(t, ok)
+	assert.Equal(t, hiero.NewHbar(6), aliceBalance)
+
+	info, err := hiero.NewAccountInfoQuery().SetAccountID(alice).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", info.AccountMemo)
+	assert.Equal(t, key.PublicKey().String(), info.Key.String())
+
+	seeded, err := network.CreateAccount(key.PublicKey(), hiero.NewHbar(1))
+	require.NoError(t, err)
+	balance, err = hiero.NewAccountBalanceQuery().SetAccountID(seeded).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, hiero.NewHbar(1), balance.Hbars)
+
+	_, err = hiero.NewAccountBalanceQuery().SetAccountID(hiero.AccountID{Account: 999_999}).Execute(client)
+	assert.ErrorContains(t, err, "INVALID_ACCOUNT_ID")
+}
+
+func TestUnitNetworkTokens(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+	operatorID, operatorKey := network.GetOperator()
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	bob, err := network.CreateAccount(key.PublicKey(), hiero.NewHbar(1))
+	require.NoError(t, err)
+
+	response, err := hiero.NewTokenCreateTransaction().
+		SetTokenName("Fungible").
+		SetTokenSymbol("F").
+		SetDecimals(2).
+		SetInitialSupply(1_000).
+		SetTreasuryAccountID(operatorID).
+		SetAdminKey(operatorKey.PublicKey()).
+		SetSupplyKey(operatorKey.PublicKey()).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	tokenID := *receipt.TokenID
+
+	transfer := hiero.NewTransferTransaction().
+		AddTokenTransfer(tokenID, operatorID, -100).
+		AddTokenTransfer(tokenID, bob, 100)
+	response, err = transfer.Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	assert.ErrorContains(t, err, "TOKEN_NOT_ASSOCIATED_TO_ACCOUNT")
+
+	associate, err := hiero.NewTokenAssociateTransaction().
+		SetAccountID(bob).
+		SetTokenIDs(tokenID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err = associate.Sign(key).Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	response, err = hiero.NewTransferTransaction().
+		AddTokenTransfer(tokenID, operatorID, -100).
+		AddTokenTransfer(tokenID, bob, 100).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	response, err = hiero.NewTokenMintTransaction().SetTokenID(tokenID).SetAmount(500).Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1_500), receipt.TotalSupply)
+
+	balance, ok := network.GetTokenBalance(bob, tokenID)
+	require.True(t, ok)
+	assert.Equal(t, uint64(100), balance)
+
+	balances, err := hiero.NewAccountBalanceQuery().SetAccountID(operatorID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1_400), balances.Tokens.Get(tokenID))
+
+	info, err := hiero.NewTokenInfoQuery().SetTokenID(tokenID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "Fungible", info.Name)
+	assert.Equal(t, uint64(1_500), info.TotalSupply)
+
+	response, err = hiero.NewTokenCreateTransaction().
+		SetTokenName("NFT").
+		SetTokenSymbol("N").
+		SetTokenType(hiero.TokenTypeNonFungibleUnique).
+		SetTreasuryAccountID(operatorID).
+		SetSupplyKey(operatorKey.PublicKey()).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	nftTokenID := *receipt.TokenID
+
+	response, err = hiero.NewTokenMintTransaction().
+		SetTokenID(nftTokenID).
+		SetMetadatas([][]byte{[]byte("one"), []byte("two")}).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, receipt.SerialNumbers)
+
+	nfts, err := hiero.NewTokenNftInfoQuery().SetNftID(nftTokenID.Nft(2)).Execute(client)
+	require.NoError(t, err)
+	require.Len(t, nfts, 1)
+	assert.Equal(t, []byte("two"), nfts[0].Metadata)
+	assert.Equal(t, operatorID, nfts[0].AccountID)
+}
+
+// _ExecuteSigned executes a transaction signed by keys and the operator, and returns its receipt.
+func _ExecuteSigned(t *testing.T, client *hiero.Client, tx hiero.TransactionInterface, keys ...hiero.PrivateKey) (hiero.TransactionReceipt, error) {
+	frozen, err := hiero.TransactionFreezeWith(tx, client)
+	require.NoError(t, err)
+	for _, key := range keys {
+		frozen, err = hiero.TransactionSign(frozen, key)
+		require.NoError(t, err)
+	}
+	response, err := hiero.TransactionExecute(frozen, client)
+	require.NoError(t, err)
+
+	return response.GetReceipt(client)
+}
+
+func TestUnitNetworkTokenAccountOperations(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+	operatorID, operatorKey := network.GetOperator()
+	operatorPublicKey := operatorKey.PublicKey()
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	bob, err := network.CreateAccount(key.PublicKey(), hiero.NewHbar(1))
+	require.NoError(t, err)
+
+	receipt, err := _ExecuteSigned(t, client, hiero.NewTokenCreateTransaction().
+		SetTokenName("Controlled").
+		SetTokenSymbol("C").
+		SetInitialSupply(1_000).
+		SetTreasuryAccountID(operatorID).
+		SetAdminKey(operatorPublicKey).
+		SetKycKey(operatorPublicKey).
+		SetFreezeKey(operatorPublicKey).
+		SetWipeKey(operatorPublicKey).
+		SetPauseKey(operatorPublicKey))
+	require.NoError(t, err)
+	tokenID := *receipt.TokenID
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenAssociateTransaction().SetAccountID(bob).SetTokenIDs(tokenID), key)
+	require.NoError(t, err)
+
+	transfer := func() error {
+		_, err := _ExecuteSigned(t, client, hiero.NewTransferTransaction().
+			AddTokenTransfer(tokenID, operatorID, -100).
+			AddTokenTransfer(tokenID, bob, 100))
+		return err
+	}
+	assert.ErrorContains(t, transfer(), "ACCOUNT_KYC_NOT_GRANTED_FOR_TOKEN")
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenGrantKycTransaction().SetTokenID(tokenID).SetAccountID(bob))
+	require.NoError(t, err)
+	require.NoError(t, transfer())
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenFreezeTransaction().SetTokenID(tokenID).SetAccountID(bob))
+	require.NoError(t, err)
+	assert.ErrorContains(t, transfer(), "ACCOUNT_FROZEN_FOR_TOKEN")
+
+	info, err := hiero.NewAccountInfoQuery().SetAccountID(bob).Execute(client)
+	require.NoError(t, err)
+	require.Len(t, info.TokenRelationships, 1)
+	assert.True(t, *info.TokenRelationships[0].FreezeStatus)
+	assert.True(t, *info.TokenRelationships[0].KycStatus)
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenUnfreezeTransaction().SetTokenID(tokenID).SetAccountID(bob))
+	require.NoError(t, err)
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenPauseTransaction().SetTokenID(tokenID))
+	require.NoError(t, err)
+	assert.ErrorContains(t, transfer(), "TOKEN_IS_PAUSED")
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenUnpauseTransaction().SetTokenID(tokenID))
+	require.NoError(t, err)
+	require.NoError(t, transfer())
+
+	receipt, err = _ExecuteSigned(t, client, hiero.NewTokenWipeTransaction().SetTokenID(tokenID).SetAccountID(bob).SetAmount(50))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(950), receipt.TotalSupply)
+	balance, ok := network.GetTokenBalance(bob, tokenID)
+	require.True(t, ok)
+	assert.Equal(t, uint64(150), balance)
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenWipeTransaction().SetTokenID(tokenID).SetAccountID(operatorID).SetAmount(1))
+	assert.ErrorContains(t, err, "CANNOT_WIPE_TOKEN_TREASURY_ACCOUNT")
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenRevokeKycTransaction().SetTokenID(tokenID).SetAccountID(bob))
+	require.NoError(t, err)
+	assert.ErrorContains(t, transfer(), "ACCOUNT_KYC_NOT_GRANTED_FOR_TOKEN")
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenUpdateTransaction().
+		SetTokenID(tokenID).
+		SetTokenName("Renamed").
+		SetTokenMemo("memo"))
+	require.NoError(t, err)
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenUpdateTransaction().SetTokenID(tokenID).SetSupplyKey(operatorPublicKey))
+	assert.ErrorContains(t, err, "TOKEN_HAS_NO_SUPPLY_KEY")
+
+	tokenInfo, err := hiero.NewTokenInfoQuery().SetTokenID(tokenID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", tokenInfo.Name)
+	assert.Equal(t, "memo", tokenInfo.TokenMemo)
+	assert.Equal(t, uint64(950), tokenInfo.TotalSupply)
+
+	receipt, err = _ExecuteSigned(t, client, hiero.NewTokenCreateTransaction().
+		SetTokenName("NFT").
+		SetTokenSymbol("N").
+		SetTokenType(hiero.TokenTypeNonFungibleUnique).
+		SetTreasuryAccountID(operatorID).
+		SetSupplyKey(operatorPublicKey).
+		SetMetadataKey(operatorPublicKey))
+	require.NoError(t, err)
+	nftTokenID := *receipt.TokenID
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenMintTransaction().SetTokenID(nftTokenID).SetMetadatas([][]byte{[]byte("one"), []byte("two")}))
+	require.NoError(t, err)
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenUpdateNftsTransaction().
+		SetTokenID(nftTokenID).
+		SetSerialNumbers([]int64{2}).
+		SetMetadata([]byte("updated")))
+	require.NoError(t, err)
+	nfts, err := hiero.NewTokenNftInfoQuery().SetNftID(nftTokenID.Nft(2)).Execute(client)
+	require.NoError(t, err)
+	require.Len(t, nfts, 1)
+	assert.Equal(t, []byte("updated"), nfts[0].Metadata)
+}
+
+func TestUnitNetworkAirdropsAndRejections(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+	operatorID, operatorKey := network.GetOperator()
+
+	bobKey, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	bob, err := network.CreateAccount(bobKey.PublicKey(), hiero.NewHbar(1))
+	require.NoError(t, err)
+	carolKey, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	carol, err := network.CreateAccount(carolKey.PublicKey(), hiero.NewHbar(1))
+	require.NoError(t, err)
+
+	receipt, err := _ExecuteSigned(t, client, hiero.NewTokenCreateTransaction().
+		SetTokenName("Fungible").
+		SetTokenSymbol("F").
+		SetInitialSupply(1_000).
+		SetTreasuryAccountID(operatorID))
+	require.NoError(t, err)
+	tokenID := *receipt.TokenID
+	receipt, err = _ExecuteSigned(t, client, hiero.NewTokenCreateTransaction().
+		SetTokenName("NFT").
+		SetTokenSymbol("N").
+		SetTokenType(hiero.TokenTypeNonFungibleUnique).
+		SetTreasuryAccountID(operatorID).
+		SetSupplyKey(operatorKey.PublicKey()))
+	require.NoError(t, err)
+	nftTokenID := *receipt.TokenID
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenMintTransaction().SetTokenID(nftTokenID).SetMetadatas([][]byte{{1}, {2}}))
+	require.NoError(t, err)
+
+	// bob is associated and receives his airdrop, carol's airdrops are pending
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenAssociateTransaction().SetAccountID(bob).SetTokenIDs(tokenID), bobKey)
+	require.NoError(t, err)
+	airdrop, err := hiero.NewTokenAirdropTransaction().
+		AddTokenTransfer(tokenID, operatorID, -300).
+		AddTokenTransfer(tokenID, bob, 100).
+		AddTokenTransfer(tokenID, carol, 200).
+		AddNftTransfer(nftTokenID.Nft(1), operatorID, carol).
+		AddNftTransfer(nftTokenID.Nft(2), operatorID, carol).
+		Execute(client)
+	require.NoError(t, err)
+	record, err := airdrop.GetRecord(client)
+	require.NoError(t, err)
+	require.Len(t, record.PendingAirdropRecords, 3)
+	assert.Equal(t, uint64(200), record.PendingAirdropRecords[0].GetPendingAirdropAmount())
+
+	balance, _ := network.GetTokenBalance(bob, tokenID)
+	assert.Equal(t, uint64(100), balance)
+	balance, _ = network.GetTokenBalance(operatorID, tokenID)
+	assert.Equal(t, uint64(900), balance)
+	_, ok := network.GetTokenBalance(carol, tokenID)
+	assert.False(t, ok)
+
+	pendingFungible := record.PendingAirdropRecords[0].GetPendingAirdropId()
+	pendingFirstNft := record.PendingAirdropRecords[1].GetPendingAirdropId()
+	pendingSecondNft := record.PendingAirdropRecords[2].GetPendingAirdropId()
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenCancelAirdropTransaction().AddPendingAirdropId(pendingSecondNft))
+	require.NoError(t, err)
+
+	// the receiver has to sign a claim, which associates it with the tokens
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenClaimAirdropTransaction().AddPendingAirdropId(pendingFungible))
+	assert.ErrorContains(t, err, "INVALID_SIGNATURE")
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenClaimAirdropTransaction().
+		AddPendingAirdropId(pendingFungible).
+		AddPendingAirdropId(pendingFirstNft), carolKey)
+	require.NoError(t, err)
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenClaimAirdropTransaction().AddPendingAirdropId(pendingSecondNft), carolKey)
+	assert.ErrorContains(t, err, "INVALID_PENDING_AIRDROP_ID")
+
+	balance, _ = network.GetTokenBalance(carol, tokenID)
+	assert.Equal(t, uint64(200), balance)
+	balance, _ = network.GetTokenBalance(carol, nftTokenID)
+	assert.Equal(t, uint64(1), balance)
+
+	// rejected tokens go back to the treasury
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenRejectTransaction().
+		SetOwnerID(carol).
+		SetTokenIDs(tokenID).
+		SetNftIDs(nftTokenID.Nft(1)), carolKey)
+	require.NoError(t, err)
+
+	balance, _ = network.GetTokenBalance(carol, tokenID)
+	assert.Equal(t, uint64(0), balance)
+	balance, _ = network.GetTokenBalance(operatorID, tokenID)
+	assert.Equal(t, uint64(900), balance)
+	nfts, err := hiero.NewTokenNftInfoQuery().SetNftID(nftTokenID.Nft(1)).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, operatorID, nfts[0].AccountID)
+
+	_, err = _ExecuteSigned(t, client, hiero.NewTokenRejectTransaction().SetOwnerID(operatorID).SetTokenIDs(tokenID))
+	assert.ErrorContains(t, err, "ACCOUNT_IS_TREASURY")
+}
+
+func TestUnitNetworkTopicsFilesContractsAndSchedules(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 1)
+	operatorID, operatorKey := network.GetOperator()
+
+	response, err := hiero.NewTopicCreateTransaction().SetTopicMemo("topic").SetAdminKey(operatorKey.PublicKey()).Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	topicID := *receipt.TopicID
+
+	for _, message := range []string{"first", "second"} {
+		response, err = hiero.NewTopicMessageSubmitTransaction().SetTopicID(topicID).SetMessage(message).Execute(client)
+		require.NoError(t, err)
+		receipt, err = response.GetReceipt(client)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, uint64(2), receipt.TopicSequenceNumber)
+	assert.Equal(t, [][]byte{[]byte("first"), []byte("second")}, network.GetTopicMessages(topicID))
+
+	topicInfo, err := hiero.NewTopicInfoQuery().SetTopicID(topicID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "topic", topicInfo.TopicMemo)
+	assert.Equal(t, uint64(2), topicInfo.SequenceNumber)
+
+	response, err = hiero.NewFileCreateTransaction().
+		SetKeys(operatorKey.PublicKey()).
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	fileID := *receipt.FileID
+
+	response, err = hiero.NewFileAppendTransaction().SetFileID(fileID).SetContents([]byte(" world")).Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	contents, err := hiero.NewFileContentsQuery().SetFileID(fileID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello world"), contents)
+
+	response, err = hiero.NewContractCreateTransaction().
+		SetBytecodeFileID(fileID).
+		SetAdminKey(operatorKey.PublicKey()).
+		SetGas(100_000).
+		SetInitialBalance(hiero.NewHbar(2)).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	contractID := *receipt.ContractID
+
+	contractInfo, err := hiero.NewContractInfoQuery().SetContractID(contractID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(hiero.NewHbar(2).AsTinybar()), contractInfo.Balance)
+
+	bytecode, err := hiero.NewContractBytecodeQuery().SetContractID(contractID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello world"), bytecode)
+
+	response, err = hiero.NewContractExecuteTransaction().SetContractID(contractID).SetGas(100_000).SetPayableAmount(hiero.NewHbar(1)).Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	balance, _ := network.GetBalance(hiero.AccountID{Account: contractID.Contract})
+	assert.Equal(t, hiero.NewHbar(3), balance)
+
+	scheduled := hiero.NewTransferTransaction().
+		AddHbarTransfer(operatorID, hiero.NewHbar(-1)).
+		AddHbarTransfer(hiero.AccountID{Account: 3}, hiero.NewHbar(1))
+	schedule, err := scheduled.Schedule()
+	require.NoError(t, err)
+	response, err = schedule.SetAdminKey(operatorKey.PublicKey()).Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.ScheduleID)
+	require.NotNil(t, receipt.ScheduledTransactionID)
+	assert.True(t, receipt.ScheduledTransactionID.GetScheduled())
+
+	scheduleInfo, err := hiero.NewScheduleInfoQuery().SetScheduleID(*receipt.ScheduleID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, operatorID, scheduleInfo.PayerAccountID)
+
+	version, err := hiero.NewNetworkVersionQuery().Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(64), version.ProtobufVersion.Minor)
+
+	rates, err := client.GetExchangeRates()
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), rates.Current.GetCents())
+}
+
+func TestUnitNetworkFailureInjection(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 2)
+	operatorID, _ := network.GetOperator()
+
+	// precheck failures are retried on the same node
+	network.FailNext(FailureBusy, 2)
+	network.FailNext(FailurePlatformNotActive, 1)
+	balance, err := hiero.NewAccountBalanceQuery().
+		SetNodeAccountIDs([]hiero.AccountID{{Account: 3}}).
+		SetAccountID(operatorID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, GenesisBalance, balance.Hbars)
+	assert.Equal(t, 4, network.GetRequestCount(hiero.AccountID{Account: 3}))
+
+	// a rejected transaction does not reach the ledger, so its retry is not a duplicate
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	network.FailNext(FailureBusy, 1)
+	receipt, err := _ExecuteSigned(t, client, hiero.NewAccountCreateTransaction().
+		SetNodeAccountIDs([]hiero.AccountID{{Account: 3}}).
+		SetKeyWithoutAlias(key.PublicKey()))
+	require.NoError(t, err)
+	assert.Equal(t, hiero.StatusSuccess, receipt.Status)
+
+	// a rejected query is answered with the precheck status only
+	network.FailNext(FailureBusy, 1)
+	response, err := network.nodes[0]._Query(context.Background(), &services.Query{
+		Query: &services.Query_CryptoGetInfo{CryptoGetInfo: &services.CryptoGetInfoQuery{
+			Header:    &services.QueryHeader{ResponseType: services.ResponseType_ANSWER_ONLY},
+			AccountID: _AccountIDToProtobuf(_GenesisAccount),
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, services.ResponseCodeEnum_BUSY, response.GetCryptoGetInfo().GetHeader().GetNodeTransactionPrecheckCode())
+	assert.Equal(t, services.ResponseType_ANSWER_ONLY, response.GetCryptoGetInfo().GetHeader().GetResponseType())
+	assert.Nil(t, response.GetCryptoGetInfo().GetAccountInfo())
+
+	// an unavailable node is skipped in favour of the other one
+	require.NoError(t, network.FailNode(hiero.AccountID{Account: 3}, FailureUnavailable, -1))
+	transfer, err := hiero.NewTransferTransaction().
+		SetNodeAccountIDs([]hiero.AccountID{{Account: 3}, {Account: 4}}).
+		AddHbarTransfer(operatorID, hiero.NewHbar(-1)).
+		AddHbarTransfer(hiero.AccountID{Account: 4}, hiero.NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, hiero.AccountID{Account: 4}, transfer.NodeID)
+
+	network.ClearFailures()
+	_, err = transfer.GetReceipt(client)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, network.FailNode(hiero.AccountID{Account: 99}, FailureBusy, 1), errNodeNotFound)
+	assert.Equal(t, "PLATFORM_NOT_ACTIVE", FailurePlatformNotActive.String())
+}
+
+func TestUnitNetworkGrpcWeb(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork(2)
+	require.NoError(t, err)
+	client := network.NewGrpcWebClient()
+	t.Cleanup(func() {
+		_ = client.Close()
+		network.Close()
+	})
+	require.Equal(t, hiero.TransportGrpcWeb, client.GetTransport())
+
+	operatorID, _ := network.GetOperator()
+	response, err := hiero.NewTransferTransaction().
+		AddHbarTransfer(operatorID, hiero.NewHbar(-5)).
+		AddHbarTransfer(hiero.AccountID{Account: 3}, hiero.NewHbar(5)).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	assert.Equal(t, hiero.StatusSuccess, receipt.Status)
+
+	balance, err := hiero.NewAccountBalanceQuery().SetAccountID(operatorID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, GenesisBalance.AsTinybar()-hiero.NewHbar(5).AsTinybar(), balance.Hbars.AsTinybar())
+
+	// Injected failures are answered over gRPC-web too, and retried
+	network.FailNext(FailureUnavailable, 1)
+	_, err = hiero.NewAccountBalanceQuery().SetAccountID(operatorID).Execute(client)
+	require.NoError(t, err)
+}
+
+func TestUnitNetworkGrpcWebFromAddressBook(t *testing.T) {
+	t.Parallel()
+
+	network, client := _NewTestNetwork(t, 2)
+	operatorID, _ := network.GetOperator()
+
+	client.SetNetworkFromAddressBook(network.GetNodeAddressBook())
+	require.Equal(t, network.GetAddresses(), client.GetNetwork())
+
+	client.SetTransport(hiero.TransportGrpcWeb)
+	require.Equal(t, network.GetGrpcWebAddresses(), client.GetNetwork())
+
+	_, err := hiero.NewAccountBalanceQuery().SetAccountID(operatorID).Execute(client)
+	require.NoError(t, err)
+
+	client.SetTransport(hiero.TransportGrpc)
+	require.Equal(t, network.GetAddresses(), client.GetNetwork())
+
+	_, err = hiero.NewAccountBalanceQuery().SetAccountID(operatorID).Execute(client)
+	require.NoError(t, err)
+}
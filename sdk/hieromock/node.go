@@ -0,0 +1,371 @@
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// _Node is a consensus node of the mock network, serving the gRPC services on a local port.
+type _Node struct {
+	network     *Network
+	accountID   hiero.AccountID
+	listener    net.Listener
+	server      *grpc.Server
+	webListener net.Listener
+	webServer   *http.Server
+	failures    []*_InjectedFailure
+	requests    int
+}
+
+func _NewNode(network *Network, accountID hiero.AccountID) (*_Node, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	webListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	webServer := _NewGrpcWebServer()
+	node := &_Node{
+		network:     network,
+		accountID:   accountID,
+		listener:    listener,
+		server:      grpc.NewServer(),
+		webListener: webListener,
+		webServer:   &http.Server{Handler: webServer, ReadHeaderTimeout: 10 * time.Second},
+	}
+
+	node._RegisterServices(node.server)
+	node._RegisterServices(webServer)
+
+	go func() {
+		_ = node.server.Serve(listener)
+	}()
+	go func() {
+		_ = node.webServer.Serve(webListener)
+	}()
+
+	return node, nil
+}
+
+// _RegisterServices registers the services of the node on the gRPC server, or on the gRPC-web server
+func (node *_Node) _RegisterServices(registrar grpc.ServiceRegistrar) {
+	services.RegisterCryptoServiceServer(registrar, &_CryptoService{node: node})
+	services.RegisterTokenServiceServer(registrar, &_TokenService{node: node})
+	services.RegisterConsensusServiceServer(registrar, &_ConsensusService{node: node})
+	services.RegisterFileServiceServer(registrar, &_FileService{node: node})
+	services.RegisterSmartContractServiceServer(registrar, &_SmartContractService{node: node})
+	services.RegisterScheduleServiceServer(registrar, &_ScheduleService{node: node})
+	services.RegisterNetworkServiceServer(registrar, &_NetworkService{node: node})
+}
+
+func (node *_Node) _Close() {
+	node.server.Stop()
+	_ = node.webServer.Close()
+}
+
+// _Intercept applies the latency and the injected failures to a request. It returns a gRPC error, or the precheck
+// status to answer with instead of handling the request, which is OK when the request should be handled.
+func (node *_Node) _Intercept(ctx context.Context) (services.ResponseCodeEnum, error) {
+	latency, failure := node.network._NextFailure(node)
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return services.ResponseCodeEnum_OK, status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+
+	switch failure {
+	case FailureBusy:
+		return services.ResponseCodeEnum_BUSY, nil
+	case FailurePlatformNotActive:
+		return services.ResponseCodeEnum_PLATFORM_NOT_ACTIVE, nil
+	case FailureUnavailable:
+		return services.ResponseCodeEnum_OK, status.Error(codes.Unavailable, "hieromock: injected failure")
+	}
+
+	return services.ResponseCodeEnum_OK, nil
+}
+
+func (node *_Node) _Submit(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	precheck, err := node._Intercept(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if precheck == services.ResponseCodeEnum_OK {
+		precheck = node.network.ledger._Submit(int64(node.accountID.Account), transaction)
+	}
+
+	return &services.TransactionResponse{NodeTransactionPrecheckCode: precheck}, nil
+}
+
+func (node *_Node) _Query(ctx context.Context, query *services.Query) (*services.Response, error) {
+	precheck, err := node._Intercept(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// a rejected query is answered before the ledger is looked at
+	if precheck != services.ResponseCodeEnum_OK {
+		return _PrecheckResponse(query, precheck), nil
+	}
+
+	return node.network.ledger._Answer(query), nil
+}
+
+type _CryptoService struct {
+	services.UnimplementedCryptoServiceServer
+	node *_Node
+}
+
+func (service *_CryptoService) CreateAccount(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_CryptoService) UpdateAccount(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_CryptoService) CryptoTransfer(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_CryptoService) CryptoDelete(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_CryptoService) CryptoGetBalance(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+func (service *_CryptoService) GetAccountInfo(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+func (service *_CryptoService) GetTransactionReceipts(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+func (service *_CryptoService) GetTxRecordByTxID(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+type _TokenService struct {
+	services.UnimplementedTokenServiceServer
+	node *_Node
+}
+
+func (service *_TokenService) CreateToken(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) MintToken(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) BurnToken(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) DeleteToken(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) AssociateTokens(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) DissociateTokens(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) UpdateToken(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) WipeTokenAccount(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) FreezeTokenAccount(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) UnfreezeTokenAccount(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) GrantKycToTokenAccount(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) RevokeKycFromTokenAccount(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) PauseToken(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) UnpauseToken(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) UpdateNfts(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) RejectToken(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) AirdropTokens(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) ClaimAirdrop(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) CancelAirdrop(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_TokenService) GetTokenInfo(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+func (service *_TokenService) GetTokenNftInfo(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+type _ConsensusService struct {
+	services.UnimplementedConsensusServiceServer
+	node *_Node
+}
+
+func (service *_ConsensusService) CreateTopic(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_ConsensusService) UpdateTopic(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_ConsensusService) DeleteTopic(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_ConsensusService) SubmitMessage(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_ConsensusService) GetTopicInfo(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+type _FileService struct {
+	services.UnimplementedFileServiceServer
+	node *_Node
+}
+
+func (service *_FileService) CreateFile(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_FileService) UpdateFile(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_FileService) DeleteFile(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_FileService) AppendContent(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_FileService) GetFileContent(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+func (service *_FileService) GetFileInfo(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+type _SmartContractService struct {
+	services.UnimplementedSmartContractServiceServer
+	node *_Node
+}
+
+func (service *_SmartContractService) CreateContract(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_SmartContractService) ContractCallMethod(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_SmartContractService) DeleteContract(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_SmartContractService) ContractCallLocalMethod(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+func (service *_SmartContractService) GetContractInfo(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+func (service *_SmartContractService) ContractGetBytecode(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+type _ScheduleService struct {
+	services.UnimplementedScheduleServiceServer
+	node *_Node
+}
+
+func (service *_ScheduleService) CreateSchedule(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_ScheduleService) SignSchedule(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_ScheduleService) DeleteSchedule(ctx context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(ctx, transaction)
+}
+
+func (service *_ScheduleService) GetScheduleInfo(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
+
+type _NetworkService struct {
+	services.UnimplementedNetworkServiceServer
+	node *_Node
+}
+
+func (service *_NetworkService) GetVersionInfo(ctx context.Context, query *services.Query) (*services.Response, error) {
+	return service.node._Query(ctx, query)
+}
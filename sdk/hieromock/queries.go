@@ -0,0 +1,342 @@
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"sort"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+// _Answer answers a query. Queries cost nothing, so cost queries are answered like any other query with a cost of 0.
+// The nodes only route the queries the mock supports here.
+func (ledger *_Ledger) _Answer(query *services.Query) *services.Response { // nolint
+	ledger.mutex.Lock()
+	defer ledger.mutex.Unlock()
+
+	switch q := query.GetQuery().(type) {
+	case *services.Query_CryptogetAccountBalance:
+		return &services.Response{Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: ledger._AccountBalance(q.CryptogetAccountBalance),
+		}}
+	case *services.Query_CryptoGetInfo:
+		return &services.Response{Response: &services.Response_CryptoGetInfo{
+			CryptoGetInfo: ledger._AccountInfo(q.CryptoGetInfo),
+		}}
+	case *services.Query_TransactionGetReceipt:
+		return &services.Response{Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: ledger._TransactionReceipt(q.TransactionGetReceipt),
+		}}
+	case *services.Query_TransactionGetRecord:
+		return &services.Response{Response: &services.Response_TransactionGetRecord{
+			TransactionGetRecord: ledger._TransactionRecord(q.TransactionGetRecord),
+		}}
+	case *services.Query_TokenGetInfo:
+		return &services.Response{Response: &services.Response_TokenGetInfo{
+			TokenGetInfo: ledger._TokenInfo(q.TokenGetInfo),
+		}}
+	case *services.Query_TokenGetNftInfo:
+		return &services.Response{Response: &services.Response_TokenGetNftInfo{
+			TokenGetNftInfo: ledger._NftInfo(q.TokenGetNftInfo),
+		}}
+	case *services.Query_ConsensusGetTopicInfo:
+		return &services.Response{Response: &services.Response_ConsensusGetTopicInfo{
+			ConsensusGetTopicInfo: ledger._TopicInfo(q.ConsensusGetTopicInfo),
+		}}
+	case *services.Query_FileGetContents:
+		return &services.Response{Response: &services.Response_FileGetContents{
+			FileGetContents: ledger._FileContents(q.FileGetContents),
+		}}
+	case *services.Query_FileGetInfo:
+		return &services.Response{Response: &services.Response_FileGetInfo{
+			FileGetInfo: ledger._FileInfo(q.FileGetInfo),
+		}}
+	case *services.Query_ContractGetInfo:
+		return &services.Response{Response: &services.Response_ContractGetInfo{
+			ContractGetInfo: ledger._ContractInfo(q.ContractGetInfo),
+		}}
+	case *services.Query_ContractGetBytecode:
+		return &services.Response{Response: &services.Response_ContractGetBytecodeResponse{
+			ContractGetBytecodeResponse: ledger._ContractBytecode(q.ContractGetBytecode),
+		}}
+	case *services.Query_ContractCallLocal:
+		return &services.Response{Response: &services.Response_ContractCallLocal{
+			ContractCallLocal: ledger._ContractCallLocal(q.ContractCallLocal),
+		}}
+	case *services.Query_ScheduleGetInfo:
+		return &services.Response{Response: &services.Response_ScheduleGetInfo{
+			ScheduleGetInfo: ledger._ScheduleInfo(q.ScheduleGetInfo),
+		}}
+	case *services.Query_NetworkGetVersionInfo:
+		return &services.Response{Response: &services.Response_NetworkGetVersionInfo{
+			NetworkGetVersionInfo: &services.NetworkGetVersionInfoResponse{
+				Header:                _ResponseHeader(q.NetworkGetVersionInfo.GetHeader(), services.ResponseCodeEnum_OK),
+				HapiProtoVersion:      &services.SemanticVersion{Minor: 64},
+				HederaServicesVersion: &services.SemanticVersion{Minor: 64},
+			},
+		}}
+	}
+
+	return &services.Response{}
+}
+
+func _ResponseHeader(header *services.QueryHeader, status services.ResponseCodeEnum) *services.ResponseHeader {
+	if status == services.ResponseCodeEnum_SUCCESS {
+		status = services.ResponseCodeEnum_OK
+	}
+
+	return &services.ResponseHeader{
+		NodeTransactionPrecheckCode: status,
+		ResponseType:                header.GetResponseType(),
+	}
+}
+
+func (ledger *_Ledger) _TokenRelationships(account *_Account) ([]*services.TokenRelationship, int64) {
+	nums := make([]int64, 0, len(account.tokens))
+	for num := range account.tokens {
+		nums = append(nums, num)
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+
+	var ownedNfts int64
+	relationships := make([]*services.TokenRelationship, 0, len(nums))
+	for _, num := range nums {
+		token := ledger.tokens[num]
+		relationship := account.tokens[num]
+		if token.info.TokenType == services.TokenType_NON_FUNGIBLE_UNIQUE {
+			ownedNfts += int64(relationship.balance)
+		}
+
+		freezeStatus := services.TokenFreezeStatus_FreezeNotApplicable
+		if token.info.FreezeKey != nil {
+			freezeStatus = services.TokenFreezeStatus_Unfrozen
+			if relationship.frozen {
+				freezeStatus = services.TokenFreezeStatus_Frozen
+			}
+		}
+		kycStatus := services.TokenKycStatus_KycNotApplicable
+		if token.info.KycKey != nil {
+			kycStatus = services.TokenKycStatus_Revoked
+			if relationship.kycGranted {
+				kycStatus = services.TokenKycStatus_Granted
+			}
+		}
+
+		relationships = append(relationships, &services.TokenRelationship{
+			TokenId:      token.info.TokenId,
+			Symbol:       token.info.Symbol,
+			Balance:      relationship.balance,
+			KycStatus:    kycStatus,
+			FreezeStatus: freezeStatus,
+			Decimals:     token.info.Decimals,
+		})
+	}
+
+	return relationships, ownedNfts
+}
+
+func (ledger *_Ledger) _AccountBalance(query *services.CryptoGetAccountBalanceQuery) *services.CryptoGetAccountBalanceResponse {
+	accountID := query.GetAccountID()
+	if contractID := query.GetContractID(); contractID != nil {
+		accountID = _AccountIDToProtobuf(contractID.GetContractNum())
+	}
+
+	account, status := ledger._GetAccount(accountID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return &services.CryptoGetAccountBalanceResponse{Header: _ResponseHeader(query.GetHeader(), status)}
+	}
+
+	relationships, _ := ledger._TokenRelationships(account)
+	tokenBalances := make([]*services.TokenBalance, 0, len(relationships))
+	for _, relationship := range relationships {
+		tokenBalances = append(tokenBalances, &services.TokenBalance{
+			TokenId:  relationship.TokenId,
+			Balance:  relationship.Balance,
+			Decimals: relationship.Decimals,
+		})
+	}
+
+	return &services.CryptoGetAccountBalanceResponse{
+		Header:        _ResponseHeader(query.GetHeader(), status),
+		AccountID:     account.info.AccountID,
+		Balance:       account.info.Balance,
+		TokenBalances: tokenBalances,
+	}
+}
+
+func (ledger *_Ledger) _AccountInfo(query *services.CryptoGetInfoQuery) *services.CryptoGetInfoResponse {
+	account, status := ledger._GetAccount(query.GetAccountID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return &services.CryptoGetInfoResponse{Header: _ResponseHeader(query.GetHeader(), status)}
+	}
+
+	info := _Clone(account.info)
+	info.TokenRelationships, info.OwnedNfts = ledger._TokenRelationships(account)
+
+	return &services.CryptoGetInfoResponse{
+		Header:      _ResponseHeader(query.GetHeader(), status),
+		AccountInfo: info,
+	}
+}
+
+func (ledger *_Ledger) _TransactionReceipt(query *services.TransactionGetReceiptQuery) *services.TransactionGetReceiptResponse {
+	record, ok := ledger.records[_TransactionIDKey(query.GetTransactionID())]
+	if !ok {
+		return &services.TransactionGetReceiptResponse{
+			Header: _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_RECEIPT_NOT_FOUND),
+		}
+	}
+
+	return &services.TransactionGetReceiptResponse{
+		Header:  _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_OK),
+		Receipt: _Clone(record.Receipt),
+	}
+}
+
+func (ledger *_Ledger) _TransactionRecord(query *services.TransactionGetRecordQuery) *services.TransactionGetRecordResponse {
+	record, ok := ledger.records[_TransactionIDKey(query.GetTransactionID())]
+	if !ok {
+		return &services.TransactionGetRecordResponse{
+			Header: _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_RECORD_NOT_FOUND),
+		}
+	}
+
+	return &services.TransactionGetRecordResponse{
+		Header:            _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_OK),
+		TransactionRecord: _Clone(record),
+	}
+}
+
+func (ledger *_Ledger) _TokenInfo(query *services.TokenGetInfoQuery) *services.TokenGetInfoResponse {
+	token, ok := ledger.tokens[query.GetToken().GetTokenNum()]
+	if !ok {
+		return &services.TokenGetInfoResponse{
+			Header: _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_INVALID_TOKEN_ID),
+		}
+	}
+
+	return &services.TokenGetInfoResponse{
+		Header:    _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_OK),
+		TokenInfo: _Clone(token.info),
+	}
+}
+
+func (ledger *_Ledger) _NftInfo(query *services.TokenGetNftInfoQuery) *services.TokenGetNftInfoResponse {
+	token, status := ledger._GetToken(query.GetNftID().GetToken_ID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return &services.TokenGetNftInfoResponse{Header: _ResponseHeader(query.GetHeader(), status)}
+	}
+	nft, ok := token.nfts[query.GetNftID().GetSerialNumber()]
+	if !ok {
+		return &services.TokenGetNftInfoResponse{
+			Header: _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_INVALID_NFT_ID),
+		}
+	}
+
+	return &services.TokenGetNftInfoResponse{
+		Header: _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_OK),
+		Nft:    _Clone(nft),
+	}
+}
+
+func (ledger *_Ledger) _TopicInfo(query *services.ConsensusGetTopicInfoQuery) *services.ConsensusGetTopicInfoResponse {
+	topic, status := ledger._GetTopic(query.GetTopicID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return &services.ConsensusGetTopicInfoResponse{Header: _ResponseHeader(query.GetHeader(), status)}
+	}
+
+	return &services.ConsensusGetTopicInfoResponse{
+		Header:    _ResponseHeader(query.GetHeader(), status),
+		TopicID:   query.GetTopicID(),
+		TopicInfo: _Clone(topic.info),
+	}
+}
+
+func (ledger *_Ledger) _FileContents(query *services.FileGetContentsQuery) *services.FileGetContentsResponse {
+	file, status := ledger._GetFile(query.GetFileID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return &services.FileGetContentsResponse{Header: _ResponseHeader(query.GetHeader(), status)}
+	}
+
+	return &services.FileGetContentsResponse{
+		Header: _ResponseHeader(query.GetHeader(), status),
+		FileContents: &services.FileGetContentsResponse_FileContents{
+			FileID:   file.info.FileID,
+			Contents: append([]byte(nil), file.contents...),
+		},
+	}
+}
+
+func (ledger *_Ledger) _FileInfo(query *services.FileGetInfoQuery) *services.FileGetInfoResponse {
+	file, ok := ledger.files[query.GetFileID().GetFileNum()]
+	if !ok {
+		return &services.FileGetInfoResponse{
+			Header: _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_INVALID_FILE_ID),
+		}
+	}
+
+	return &services.FileGetInfoResponse{
+		Header:   _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_OK),
+		FileInfo: _Clone(file.info),
+	}
+}
+
+func (ledger *_Ledger) _ContractInfo(query *services.ContractGetInfoQuery) *services.ContractGetInfoResponse {
+	contract, status := ledger._GetContract(query.GetContractID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return &services.ContractGetInfoResponse{Header: _ResponseHeader(query.GetHeader(), status)}
+	}
+
+	account := ledger.accounts[contract.info.AccountID.GetAccountNum()]
+	info := _Clone(contract.info)
+	info.Balance = account.info.Balance
+	info.TokenRelationships, _ = ledger._TokenRelationships(account)
+
+	return &services.ContractGetInfoResponse{
+		Header:       _ResponseHeader(query.GetHeader(), status),
+		ContractInfo: info,
+	}
+}
+
+func (ledger *_Ledger) _ContractBytecode(query *services.ContractGetBytecodeQuery) *services.ContractGetBytecodeResponse {
+	contract, status := ledger._GetContract(query.GetContractID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return &services.ContractGetBytecodeResponse{Header: _ResponseHeader(query.GetHeader(), status)}
+	}
+
+	return &services.ContractGetBytecodeResponse{
+		Header:   _ResponseHeader(query.GetHeader(), status),
+		Bytecode: append([]byte(nil), contract.bytecode...),
+	}
+}
+
+// _ContractCallLocal answers a local call with an empty result, as the mock does not run the EVM.
+func (ledger *_Ledger) _ContractCallLocal(query *services.ContractCallLocalQuery) *services.ContractCallLocalResponse {
+	contract, status := ledger._GetContract(query.GetContractID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return &services.ContractCallLocalResponse{Header: _ResponseHeader(query.GetHeader(), status)}
+	}
+
+	return &services.ContractCallLocalResponse{
+		Header: _ResponseHeader(query.GetHeader(), status),
+		FunctionResult: &services.ContractFunctionResult{
+			ContractID:         contract.info.ContractID,
+			Gas:                query.GetGas(),
+			FunctionParameters: query.GetFunctionParameters(),
+		},
+	}
+}
+
+func (ledger *_Ledger) _ScheduleInfo(query *services.ScheduleGetInfoQuery) *services.ScheduleGetInfoResponse {
+	schedule, ok := ledger.schedules[query.GetScheduleID().GetScheduleNum()]
+	if !ok {
+		return &services.ScheduleGetInfoResponse{
+			Header: _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_INVALID_SCHEDULE_ID),
+		}
+	}
+
+	return &services.ScheduleGetInfoResponse{
+		Header:       _ResponseHeader(query.GetHeader(), services.ResponseCodeEnum_OK),
+		ScheduleInfo: _Clone(schedule.info),
+	}
+}
@@ -0,0 +1,1724 @@
+package hieromock
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// _Apply applies a transaction which passed its precheck and returns the status of its receipt. The ledger is only
+// modified when the status is SUCCESS. NOT_SUPPORTED is returned as the precheck status instead.
+func (ledger *_Ledger) _Apply(tx *_Transaction) services.ResponseCodeEnum { // nolint
+	switch data := tx.body.GetData().(type) {
+	case *services.TransactionBody_CryptoCreateAccount:
+		return ledger._CryptoCreate(tx, data.CryptoCreateAccount)
+	case *services.TransactionBody_CryptoUpdateAccount:
+		return ledger._CryptoUpdate(tx, data.CryptoUpdateAccount)
+	case *services.TransactionBody_CryptoTransfer:
+		return ledger._CryptoTransfer(tx, data.CryptoTransfer)
+	case *services.TransactionBody_CryptoDelete:
+		return ledger._CryptoDelete(tx, data.CryptoDelete)
+	case *services.TransactionBody_TokenCreation:
+		return ledger._TokenCreate(tx, data.TokenCreation)
+	case *services.TransactionBody_TokenMint:
+		return ledger._TokenMint(tx, data.TokenMint)
+	case *services.TransactionBody_TokenBurn:
+		return ledger._TokenBurn(tx, data.TokenBurn)
+	case *services.TransactionBody_TokenAssociate:
+		return ledger._TokenAssociate(tx, data.TokenAssociate)
+	case *services.TransactionBody_TokenDissociate:
+		return ledger._TokenDissociate(tx, data.TokenDissociate)
+	case *services.TransactionBody_TokenDeletion:
+		return ledger._TokenDelete(tx, data.TokenDeletion)
+	case *services.TransactionBody_TokenFreeze:
+		return ledger._TokenSetFrozen(tx, data.TokenFreeze.GetToken(), data.TokenFreeze.GetAccount(), true)
+	case *services.TransactionBody_TokenUnfreeze:
+		return ledger._TokenSetFrozen(tx, data.TokenUnfreeze.GetToken(), data.TokenUnfreeze.GetAccount(), false)
+	case *services.TransactionBody_TokenGrantKyc:
+		return ledger._TokenSetKyc(tx, data.TokenGrantKyc.GetToken(), data.TokenGrantKyc.GetAccount(), true)
+	case *services.TransactionBody_TokenRevokeKyc:
+		return ledger._TokenSetKyc(tx, data.TokenRevokeKyc.GetToken(), data.TokenRevokeKyc.GetAccount(), false)
+	case *services.TransactionBody_TokenPause:
+		return ledger._TokenSetPaused(tx, data.TokenPause.GetToken(), true)
+	case *services.TransactionBody_TokenUnpause:
+		return ledger._TokenSetPaused(tx, data.TokenUnpause.GetToken(), false)
+	case *services.TransactionBody_TokenWipe:
+		return ledger._TokenWipe(tx, data.TokenWipe)
+	case *services.TransactionBody_TokenUpdate:
+		return ledger._TokenUpdate(tx, data.TokenUpdate)
+	case *services.TransactionBody_TokenUpdateNfts:
+		return ledger._TokenUpdateNfts(tx, data.TokenUpdateNfts)
+	case *services.TransactionBody_TokenReject:
+		return ledger._TokenReject(tx, data.TokenReject)
+	case *services.TransactionBody_TokenAirdrop:
+		return ledger._TokenAirdrop(tx, data.TokenAirdrop)
+	case *services.TransactionBody_TokenClaimAirdrop:
+		return ledger._TokenClaimAirdrop(tx, data.TokenClaimAirdrop)
+	case *services.TransactionBody_TokenCancelAirdrop:
+		return ledger._TokenCancelAirdrop(tx, data.TokenCancelAirdrop)
+	case *services.TransactionBody_ConsensusCreateTopic:
+		return ledger._TopicCreate(tx, data.ConsensusCreateTopic)
+	case *services.TransactionBody_ConsensusUpdateTopic:
+		return ledger._TopicUpdate(tx, data.ConsensusUpdateTopic)
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		return ledger._TopicDelete(tx, data.ConsensusDeleteTopic)
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		return ledger._TopicSubmitMessage(tx, data.ConsensusSubmitMessage)
+	case *services.TransactionBody_FileCreate:
+		return ledger._FileCreate(tx, data.FileCreate)
+	case *services.TransactionBody_FileAppend:
+		return ledger._FileAppend(tx, data.FileAppend)
+	case *services.TransactionBody_FileUpdate:
+		return ledger._FileUpdate(tx, data.FileUpdate)
+	case *services.TransactionBody_FileDelete:
+		return ledger._FileDelete(tx, data.FileDelete)
+	case *services.TransactionBody_ContractCreateInstance:
+		return ledger._ContractCreate(tx, data.ContractCreateInstance)
+	case *services.TransactionBody_ContractCall:
+		return ledger._ContractCall(tx, data.ContractCall)
+	case *services.TransactionBody_ContractDeleteInstance:
+		return ledger._ContractDelete(tx, data.ContractDeleteInstance)
+	case *services.TransactionBody_ScheduleCreate:
+		return ledger._ScheduleCreate(tx, data.ScheduleCreate)
+	case *services.TransactionBody_ScheduleSign:
+		return ledger._ScheduleSign(tx, data.ScheduleSign)
+	case *services.TransactionBody_ScheduleDelete:
+		return ledger._ScheduleDelete(tx, data.ScheduleDelete)
+	}
+
+	return services.ResponseCodeEnum_NOT_SUPPORTED
+}
+
+func (ledger *_Ledger) _CryptoCreate(tx *_Transaction, body *services.CryptoCreateTransactionBody) services.ResponseCodeEnum {
+	if body.GetKey() == nil {
+		return services.ResponseCodeEnum_KEY_REQUIRED
+	}
+	if body.GetInitialBalance() > tx.payer.info.Balance {
+		return services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE
+	}
+	if body.GetReceiverSigRequired() && !tx._IsSigned(body.GetKey()) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	account := ledger._CreateAccount(body.GetKey(), 0)
+	account.info.Memo = body.GetMemo()
+	account.info.ReceiverSigRequired = body.GetReceiverSigRequired()
+	account.info.MaxAutomaticTokenAssociations = body.GetMaxAutomaticTokenAssociations()
+	account.info.Alias = body.GetAlias()
+	if body.GetAutoRenewPeriod() != nil {
+		account.info.AutoRenewPeriod = body.GetAutoRenewPeriod()
+	}
+
+	tx._Transfer(tx.payer, account, int64(body.GetInitialBalance()))
+	tx.receipt.AccountID = account.info.AccountID
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _CryptoUpdate(tx *_Transaction, body *services.CryptoUpdateTransactionBody) services.ResponseCodeEnum {
+	account, status := ledger._GetAccount(body.GetAccountIDToUpdate())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if !tx._IsSigned(account.info.Key) || !tx._IsSignedIfSet(body.GetKey()) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if body.GetKey() != nil {
+		account.info.Key = body.GetKey()
+	}
+	if body.GetMemo() != nil {
+		account.info.Memo = body.GetMemo().GetValue()
+	}
+	if body.GetReceiverSigRequiredWrapper() != nil {
+		account.info.ReceiverSigRequired = body.GetReceiverSigRequiredWrapper().GetValue()
+	}
+	if body.GetMaxAutomaticTokenAssociations() != nil {
+		account.info.MaxAutomaticTokenAssociations = body.GetMaxAutomaticTokenAssociations().GetValue()
+	}
+	if body.GetAutoRenewPeriod() != nil {
+		account.info.AutoRenewPeriod = body.GetAutoRenewPeriod()
+	}
+	if body.GetExpirationTime() != nil {
+		account.info.ExpirationTime = body.GetExpirationTime()
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _CryptoTransfer(tx *_Transaction, body *services.CryptoTransferTransactionBody) services.ResponseCodeEnum { // nolint
+	hbarDeltas := make(map[*_Account]int64)
+	var sum int64
+	for _, accountAmount := range body.GetTransfers().GetAccountAmounts() {
+		account, status := ledger._GetAccount(accountAmount.GetAccountID())
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		if status := ledger._CheckTransferSignature(tx, account, accountAmount.GetAmount()); status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+
+		hbarDeltas[account] += accountAmount.GetAmount()
+		sum += accountAmount.GetAmount()
+	}
+	if sum != 0 {
+		return services.ResponseCodeEnum_INVALID_ACCOUNT_AMOUNTS
+	}
+	for account, delta := range hbarDeltas {
+		if int64(account.info.Balance)+delta < 0 {
+			return services.ResponseCodeEnum_INSUFFICIENT_ACCOUNT_BALANCE
+		}
+	}
+
+	type nftMove struct {
+		nft *services.TokenNftInfo
+		to  *_Account
+	}
+	tokenDeltas := make(map[int64]map[*_Account]int64)
+	var nftMoves []nftMove
+
+	for _, tokenTransfers := range body.GetTokenTransfers() {
+		token, status := ledger._GetUnpausedToken(tokenTransfers.GetToken())
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		tokenNum := tokenTransfers.GetToken().GetTokenNum()
+		nonFungible := token.info.TokenType == services.TokenType_NON_FUNGIBLE_UNIQUE
+		if nonFungible && len(tokenTransfers.GetTransfers()) > 0 {
+			return services.ResponseCodeEnum_ACCOUNT_AMOUNT_TRANSFERS_ONLY_ALLOWED_FOR_FUNGIBLE_COMMON
+		}
+		if tokenDeltas[tokenNum] == nil {
+			tokenDeltas[tokenNum] = make(map[*_Account]int64)
+		}
+		deltas := tokenDeltas[tokenNum]
+
+		var tokenSum int64
+		for _, accountAmount := range tokenTransfers.GetTransfers() {
+			account, status := ledger._GetAccount(accountAmount.GetAccountID())
+			if status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if _, status := ledger._GetTokenRelationship(account, token); status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if status := ledger._CheckTransferSignature(tx, account, accountAmount.GetAmount()); status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+
+			deltas[account] += accountAmount.GetAmount()
+			tokenSum += accountAmount.GetAmount()
+		}
+		if tokenSum != 0 {
+			return services.ResponseCodeEnum_TRANSFERS_NOT_ZERO_SUM_FOR_TOKEN
+		}
+
+		for _, nftTransfer := range tokenTransfers.GetNftTransfers() {
+			nft, ok := token.nfts[nftTransfer.GetSerialNumber()]
+			if !nonFungible || !ok {
+				return services.ResponseCodeEnum_INVALID_NFT_ID
+			}
+			sender, status := ledger._GetAccount(nftTransfer.GetSenderAccountID())
+			if status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			receiver, status := ledger._GetAccount(nftTransfer.GetReceiverAccountID())
+			if status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if nft.GetAccountID().GetAccountNum() != sender.info.AccountID.GetAccountNum() {
+				return services.ResponseCodeEnum_SENDER_DOES_NOT_OWN_NFT_SERIAL_NO
+			}
+			if _, status := ledger._GetTokenRelationship(sender, token); status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if _, status := ledger._GetTokenRelationship(receiver, token); status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if status := ledger._CheckTransferSignature(tx, sender, -1); status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if status := ledger._CheckTransferSignature(tx, receiver, 1); status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+
+			deltas[sender]--
+			deltas[receiver]++
+			nftMoves = append(nftMoves, nftMove{nft: nft, to: receiver})
+		}
+
+		for account, delta := range deltas {
+			if int64(account.tokens[tokenNum].balance)+delta < 0 {
+				return services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE
+			}
+		}
+	}
+
+	for account, delta := range hbarDeltas {
+		account.info.Balance = uint64(int64(account.info.Balance) + delta)
+		tx.transfers[account.info.AccountID.GetAccountNum()] += delta
+	}
+	for tokenNum, deltas := range tokenDeltas {
+		for account, delta := range deltas {
+			relationship := account.tokens[tokenNum]
+			relationship.balance = uint64(int64(relationship.balance) + delta)
+		}
+	}
+	for _, move := range nftMoves {
+		move.nft.AccountID = move.to.info.AccountID
+	}
+	for _, tokenTransfers := range body.GetTokenTransfers() {
+		tx.record.TokenTransferLists = append(tx.record.TokenTransferLists, _Clone(tokenTransfers))
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _CheckTransferSignature requires the signature of accounts which are debited, and of accounts which require
+// signatures to receive.
+func (ledger *_Ledger) _CheckTransferSignature(tx *_Transaction, account *_Account, amount int64) services.ResponseCodeEnum {
+	if (amount < 0 || (amount > 0 && account.info.ReceiverSigRequired)) && !tx._IsSigned(account.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _CryptoDelete(tx *_Transaction, body *services.CryptoDeleteTransactionBody) services.ResponseCodeEnum {
+	account, status := ledger._GetAccount(body.GetDeleteAccountID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	target, status := ledger._GetAccount(body.GetTransferAccountID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return services.ResponseCodeEnum_INVALID_TRANSFER_ACCOUNT_ID
+	}
+	if account == target {
+		return services.ResponseCodeEnum_TRANSFER_ACCOUNT_SAME_AS_DELETE_ACCOUNT
+	}
+	if !tx._IsSigned(account.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	for _, relationship := range account.tokens {
+		if relationship.balance != 0 {
+			return services.ResponseCodeEnum_TRANSACTION_REQUIRES_ZERO_TOKEN_BALANCES
+		}
+	}
+
+	tx._Transfer(account, target, int64(account.info.Balance))
+	account.info.Deleted = true
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenCreate(tx *_Transaction, body *services.TokenCreateTransactionBody) services.ResponseCodeEnum { // nolint
+	if body.GetName() == "" {
+		return services.ResponseCodeEnum_MISSING_TOKEN_NAME
+	}
+	if body.GetSymbol() == "" {
+		return services.ResponseCodeEnum_MISSING_TOKEN_SYMBOL
+	}
+	treasury, status := ledger._GetAccount(body.GetTreasury())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return services.ResponseCodeEnum_INVALID_TREASURY_ACCOUNT_FOR_TOKEN
+	}
+	if !tx._IsSigned(treasury.info.Key) || !tx._IsSignedIfSet(body.GetAdminKey()) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if body.GetTokenType() == services.TokenType_NON_FUNGIBLE_UNIQUE {
+		if body.GetInitialSupply() != 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_INITIAL_SUPPLY
+		}
+		if body.GetDecimals() != 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_DECIMALS
+		}
+	}
+	if body.GetSupplyType() == services.TokenSupplyType_FINITE {
+		if body.GetMaxSupply() <= 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_MAX_SUPPLY
+		}
+		if body.GetInitialSupply() > uint64(body.GetMaxSupply()) {
+			return services.ResponseCodeEnum_INVALID_TOKEN_INITIAL_SUPPLY
+		}
+	}
+
+	autoRenewPeriod := body.GetAutoRenewPeriod()
+	if autoRenewPeriod == nil {
+		autoRenewPeriod = _DurationToProtobuf(_DefaultAutoRenewPeriod)
+	}
+	expiry := body.GetExpiry()
+	if expiry == nil {
+		expiry = _TimestampToProtobuf(tx.consensusTime.Add(time.Duration(autoRenewPeriod.GetSeconds()) * time.Second))
+	}
+
+	freezeStatus := services.TokenFreezeStatus_FreezeNotApplicable
+	if body.GetFreezeKey() != nil {
+		freezeStatus = services.TokenFreezeStatus_Unfrozen
+		if body.GetFreezeDefault() {
+			freezeStatus = services.TokenFreezeStatus_Frozen
+		}
+	}
+	kycStatus := services.TokenKycStatus_KycNotApplicable
+	if body.GetKycKey() != nil {
+		kycStatus = services.TokenKycStatus_Revoked
+	}
+	pauseStatus := services.TokenPauseStatus_PauseNotApplicable
+	if body.GetPauseKey() != nil {
+		pauseStatus = services.TokenPauseStatus_Unpaused
+	}
+
+	num := ledger._NextEntity()
+	ledger.tokens[num] = &_Token{
+		info: &services.TokenInfo{
+			TokenId:             _TokenIDToProtobuf(num),
+			Name:                body.GetName(),
+			Symbol:              body.GetSymbol(),
+			Decimals:            body.GetDecimals(),
+			TotalSupply:         body.GetInitialSupply(),
+			Treasury:            treasury.info.AccountID,
+			AdminKey:            body.GetAdminKey(),
+			KycKey:              body.GetKycKey(),
+			FreezeKey:           body.GetFreezeKey(),
+			WipeKey:             body.GetWipeKey(),
+			SupplyKey:           body.GetSupplyKey(),
+			DefaultFreezeStatus: freezeStatus,
+			DefaultKycStatus:    kycStatus,
+			AutoRenewAccount:    body.GetAutoRenewAccount(),
+			AutoRenewPeriod:     autoRenewPeriod,
+			Expiry:              expiry,
+			Memo:                body.GetMemo(),
+			TokenType:           body.GetTokenType(),
+			SupplyType:          body.GetSupplyType(),
+			MaxSupply:           body.GetMaxSupply(),
+			FeeScheduleKey:      body.GetFeeScheduleKey(),
+			CustomFees:          body.GetCustomFees(),
+			PauseKey:            body.GetPauseKey(),
+			PauseStatus:         pauseStatus,
+			Metadata:            body.GetMetadata(),
+			MetadataKey:         body.GetMetadataKey(),
+		},
+		nfts: make(map[int64]*services.TokenNftInfo),
+	}
+	// the treasury is associated with the token, unfrozen and granted KYC
+	treasury.tokens[num] = &_TokenRelationship{balance: body.GetInitialSupply(), kycGranted: true}
+	tx.receipt.TokenID = _TokenIDToProtobuf(num)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenMint(tx *_Transaction, body *services.TokenMintTransactionBody) services.ResponseCodeEnum {
+	token, status := ledger._GetUnpausedToken(body.GetToken())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if token.info.SupplyKey == nil {
+		return services.ResponseCodeEnum_TOKEN_HAS_NO_SUPPLY_KEY
+	}
+	if !tx._IsSigned(token.info.SupplyKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	nonFungible := token.info.TokenType == services.TokenType_NON_FUNGIBLE_UNIQUE
+	amount := body.GetAmount()
+	switch {
+	case nonFungible && len(body.GetMetadata()) == 0:
+		return services.ResponseCodeEnum_INVALID_TOKEN_MINT_METADATA
+	case nonFungible && amount != 0, !nonFungible && len(body.GetMetadata()) != 0:
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+	case !nonFungible && amount == 0:
+		return services.ResponseCodeEnum_INVALID_TOKEN_MINT_AMOUNT
+	}
+	if nonFungible {
+		amount = uint64(len(body.GetMetadata()))
+	}
+
+	totalSupply := token.info.TotalSupply + amount
+	if token.info.SupplyType == services.TokenSupplyType_FINITE && totalSupply > uint64(token.info.MaxSupply) {
+		return services.ResponseCodeEnum_TOKEN_MAX_SUPPLY_REACHED
+	}
+
+	tokenNum := body.GetToken().GetTokenNum()
+	treasury := ledger.accounts[token.info.Treasury.GetAccountNum()]
+	for _, metadata := range body.GetMetadata() {
+		token.lastSerial++
+		token.nfts[token.lastSerial] = &services.TokenNftInfo{
+			NftID:        &services.NftID{Token_ID: _TokenIDToProtobuf(tokenNum), SerialNumber: token.lastSerial},
+			AccountID:    token.info.Treasury,
+			CreationTime: _TimestampToProtobuf(tx.consensusTime),
+			Metadata:     metadata,
+		}
+		tx.receipt.SerialNumbers = append(tx.receipt.SerialNumbers, token.lastSerial)
+	}
+
+	token.info.TotalSupply = totalSupply
+	treasury.tokens[tokenNum].balance += amount
+	tx.receipt.NewTotalSupply = totalSupply
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenBurn(tx *_Transaction, body *services.TokenBurnTransactionBody) services.ResponseCodeEnum {
+	token, status := ledger._GetUnpausedToken(body.GetToken())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if token.info.SupplyKey == nil {
+		return services.ResponseCodeEnum_TOKEN_HAS_NO_SUPPLY_KEY
+	}
+	if !tx._IsSigned(token.info.SupplyKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	tokenNum := body.GetToken().GetTokenNum()
+	treasury := ledger.accounts[token.info.Treasury.GetAccountNum()]
+	amount := body.GetAmount()
+	if token.info.TokenType == services.TokenType_NON_FUNGIBLE_UNIQUE {
+		if amount != 0 {
+			return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+		}
+		for _, serial := range body.GetSerialNumbers() {
+			nft, ok := token.nfts[serial]
+			if !ok {
+				return services.ResponseCodeEnum_INVALID_NFT_ID
+			}
+			if nft.GetAccountID().GetAccountNum() != token.info.Treasury.GetAccountNum() {
+				return services.ResponseCodeEnum_TREASURY_MUST_OWN_BURNED_NFT
+			}
+		}
+		amount = uint64(len(body.GetSerialNumbers()))
+	}
+	if amount == 0 {
+		return services.ResponseCodeEnum_INVALID_TOKEN_BURN_AMOUNT
+	}
+	if treasury.tokens[tokenNum].balance < amount {
+		return services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE
+	}
+
+	for _, serial := range body.GetSerialNumbers() {
+		delete(token.nfts, serial)
+	}
+	token.info.TotalSupply -= amount
+	treasury.tokens[tokenNum].balance -= amount
+	tx.receipt.NewTotalSupply = token.info.TotalSupply
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenAssociate(tx *_Transaction, body *services.TokenAssociateTransactionBody) services.ResponseCodeEnum {
+	account, status := ledger._GetAccount(body.GetAccount())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if !tx._IsSigned(account.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	seen := make(map[int64]*_Token)
+	for _, tokenID := range body.GetTokens() {
+		token, status := ledger._GetToken(tokenID)
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		if seen[tokenID.GetTokenNum()] != nil {
+			return services.ResponseCodeEnum_TOKEN_ID_REPEATED_IN_TOKEN_LIST
+		}
+		if _, ok := account.tokens[tokenID.GetTokenNum()]; ok {
+			return services.ResponseCodeEnum_TOKEN_ALREADY_ASSOCIATED_TO_ACCOUNT
+		}
+		seen[tokenID.GetTokenNum()] = token
+	}
+
+	for _, token := range seen {
+		account._Associate(token)
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenDissociate(tx *_Transaction, body *services.TokenDissociateTransactionBody) services.ResponseCodeEnum {
+	account, status := ledger._GetAccount(body.GetAccount())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if !tx._IsSigned(account.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	for _, tokenID := range body.GetTokens() {
+		relationship, ok := account.tokens[tokenID.GetTokenNum()]
+		if !ok {
+			return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+		}
+		if token := ledger.tokens[tokenID.GetTokenNum()]; !token.info.Deleted {
+			if token.info.Treasury.GetAccountNum() == account.info.AccountID.GetAccountNum() {
+				return services.ResponseCodeEnum_ACCOUNT_IS_TREASURY
+			}
+			if relationship.frozen {
+				return services.ResponseCodeEnum_ACCOUNT_FROZEN_FOR_TOKEN
+			}
+			if relationship.balance != 0 {
+				return services.ResponseCodeEnum_TRANSACTION_REQUIRES_ZERO_TOKEN_BALANCES
+			}
+		}
+	}
+
+	for _, tokenID := range body.GetTokens() {
+		delete(account.tokens, tokenID.GetTokenNum())
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenDelete(tx *_Transaction, body *services.TokenDeleteTransactionBody) services.ResponseCodeEnum {
+	token, status := ledger._GetToken(body.GetToken())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if token.info.AdminKey == nil {
+		return services.ResponseCodeEnum_TOKEN_IS_IMMUTABLE
+	}
+	if !tx._IsSigned(token.info.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	token.info.Deleted = true
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenSetFrozen freezes or unfreezes the association of an account with a token.
+func (ledger *_Ledger) _TokenSetFrozen(tx *_Transaction, tokenID *services.TokenID, accountID *services.AccountID, frozen bool) services.ResponseCodeEnum {
+	token, status := ledger._GetUnpausedToken(tokenID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if token.info.FreezeKey == nil {
+		return services.ResponseCodeEnum_TOKEN_HAS_NO_FREEZE_KEY
+	}
+	if !tx._IsSigned(token.info.FreezeKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	account, status := ledger._GetAccount(accountID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	relationship, ok := account.tokens[tokenID.GetTokenNum()]
+	if !ok {
+		return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+	}
+
+	relationship.frozen = frozen
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenSetKyc grants or revokes the KYC of the association of an account with a token.
+func (ledger *_Ledger) _TokenSetKyc(tx *_Transaction, tokenID *services.TokenID, accountID *services.AccountID, granted bool) services.ResponseCodeEnum {
+	token, status := ledger._GetUnpausedToken(tokenID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if token.info.KycKey == nil {
+		return services.ResponseCodeEnum_TOKEN_HAS_NO_KYC_KEY
+	}
+	if !tx._IsSigned(token.info.KycKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	account, status := ledger._GetAccount(accountID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	relationship, ok := account.tokens[tokenID.GetTokenNum()]
+	if !ok {
+		return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+	}
+
+	relationship.kycGranted = granted
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenSetPaused pauses or unpauses a token. A paused token rejects every transaction but its deletion and
+// unpausing.
+func (ledger *_Ledger) _TokenSetPaused(tx *_Transaction, tokenID *services.TokenID, paused bool) services.ResponseCodeEnum {
+	token, status := ledger._GetToken(tokenID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if token.info.PauseKey == nil {
+		return services.ResponseCodeEnum_TOKEN_HAS_NO_PAUSE_KEY
+	}
+	if !tx._IsSigned(token.info.PauseKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	token.info.PauseStatus = services.TokenPauseStatus_Unpaused
+	if paused {
+		token.info.PauseStatus = services.TokenPauseStatus_Paused
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenWipe(tx *_Transaction, body *services.TokenWipeAccountTransactionBody) services.ResponseCodeEnum {
+	token, status := ledger._GetUnpausedToken(body.GetToken())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if token.info.WipeKey == nil {
+		return services.ResponseCodeEnum_TOKEN_HAS_NO_WIPE_KEY
+	}
+	if !tx._IsSigned(token.info.WipeKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	account, status := ledger._GetAccount(body.GetAccount())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if account.info.AccountID.GetAccountNum() == token.info.Treasury.GetAccountNum() {
+		return services.ResponseCodeEnum_CANNOT_WIPE_TOKEN_TREASURY_ACCOUNT
+	}
+	relationship, ok := account.tokens[body.GetToken().GetTokenNum()]
+	if !ok {
+		return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+	}
+
+	amount := body.GetAmount()
+	if token.info.TokenType == services.TokenType_NON_FUNGIBLE_UNIQUE {
+		if amount != 0 {
+			return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+		}
+		for _, serial := range body.GetSerialNumbers() {
+			nft, ok := token.nfts[serial]
+			if !ok {
+				return services.ResponseCodeEnum_INVALID_NFT_ID
+			}
+			if nft.GetAccountID().GetAccountNum() != account.info.AccountID.GetAccountNum() {
+				return services.ResponseCodeEnum_ACCOUNT_DOES_NOT_OWN_WIPED_NFT
+			}
+		}
+		amount = uint64(len(body.GetSerialNumbers()))
+	} else if len(body.GetSerialNumbers()) != 0 {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+	}
+	if amount == 0 || amount > relationship.balance {
+		return services.ResponseCodeEnum_INVALID_WIPING_AMOUNT
+	}
+
+	for _, serial := range body.GetSerialNumbers() {
+		delete(token.nfts, serial)
+	}
+	token.info.TotalSupply -= amount
+	relationship.balance -= amount
+	tx.receipt.NewTotalSupply = token.info.TotalSupply
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenUpdate updates a token with the signature of its admin key. A token without an admin key can only have its
+// metadata updated, with the signature of its metadata key. Keys the token was created without cannot be added.
+func (ledger *_Ledger) _TokenUpdate(tx *_Transaction, body *services.TokenUpdateTransactionBody) services.ResponseCodeEnum { // nolint
+	token, status := ledger._GetUnpausedToken(body.GetToken())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+
+	metadataUpdate := &services.TokenUpdateTransactionBody{Token: body.GetToken(), Metadata: body.GetMetadata()}
+	metadataOnly := protobuf.Equal(body, metadataUpdate) && body.GetMetadata() != nil
+	switch {
+	case metadataOnly && token.info.MetadataKey != nil && tx._IsSigned(token.info.MetadataKey):
+	case token.info.AdminKey == nil:
+		return services.ResponseCodeEnum_TOKEN_IS_IMMUTABLE
+	case !tx._IsSigned(token.info.AdminKey) || !tx._IsSignedIfSet(body.GetAdminKey()):
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	for _, key := range []struct {
+		current *services.Key
+		updated *services.Key
+		missing services.ResponseCodeEnum
+	}{
+		{token.info.KycKey, body.GetKycKey(), services.ResponseCodeEnum_TOKEN_HAS_NO_KYC_KEY},
+		{token.info.FreezeKey, body.GetFreezeKey(), services.ResponseCodeEnum_TOKEN_HAS_NO_FREEZE_KEY},
+		{token.info.WipeKey, body.GetWipeKey(), services.ResponseCodeEnum_TOKEN_HAS_NO_WIPE_KEY},
+		{token.info.SupplyKey, body.GetSupplyKey(), services.ResponseCodeEnum_TOKEN_HAS_NO_SUPPLY_KEY},
+		{token.info.FeeScheduleKey, body.GetFeeScheduleKey(), services.ResponseCodeEnum_TOKEN_HAS_NO_FEE_SCHEDULE_KEY},
+		{token.info.PauseKey, body.GetPauseKey(), services.ResponseCodeEnum_TOKEN_HAS_NO_PAUSE_KEY},
+		{token.info.MetadataKey, body.GetMetadataKey(), services.ResponseCodeEnum_TOKEN_HAS_NO_METADATA_KEY},
+	} {
+		if key.current == nil && key.updated != nil {
+			return key.missing
+		}
+	}
+
+	tokenNum := body.GetToken().GetTokenNum()
+	var oldTreasury, newTreasury *_Account
+	if body.GetTreasury() != nil && body.GetTreasury().GetAccountNum() != token.info.Treasury.GetAccountNum() {
+		newTreasury, status = ledger._GetAccount(body.GetTreasury())
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return services.ResponseCodeEnum_INVALID_TREASURY_ACCOUNT_FOR_TOKEN
+		}
+		if !tx._IsSigned(newTreasury.info.Key) {
+			return services.ResponseCodeEnum_INVALID_SIGNATURE
+		}
+		oldTreasury = ledger.accounts[token.info.Treasury.GetAccountNum()]
+		if token.info.TokenType == services.TokenType_NON_FUNGIBLE_UNIQUE && oldTreasury.tokens[tokenNum].balance != 0 {
+			return services.ResponseCodeEnum_CURRENT_TREASURY_STILL_OWNS_NFTS
+		}
+	}
+
+	info := token.info
+	if body.GetName() != "" {
+		info.Name = body.GetName()
+	}
+	if body.GetSymbol() != "" {
+		info.Symbol = body.GetSymbol()
+	}
+	if body.GetAdminKey() != nil {
+		info.AdminKey = body.GetAdminKey()
+	}
+	if body.GetKycKey() != nil {
+		info.KycKey = body.GetKycKey()
+	}
+	if body.GetFreezeKey() != nil {
+		info.FreezeKey = body.GetFreezeKey()
+	}
+	if body.GetWipeKey() != nil {
+		info.WipeKey = body.GetWipeKey()
+	}
+	if body.GetSupplyKey() != nil {
+		info.SupplyKey = body.GetSupplyKey()
+	}
+	if body.GetFeeScheduleKey() != nil {
+		info.FeeScheduleKey = body.GetFeeScheduleKey()
+	}
+	if body.GetPauseKey() != nil {
+		info.PauseKey = body.GetPauseKey()
+	}
+	if body.GetMetadataKey() != nil {
+		info.MetadataKey = body.GetMetadataKey()
+	}
+	if body.GetAutoRenewAccount() != nil {
+		info.AutoRenewAccount = body.GetAutoRenewAccount()
+	}
+	if body.GetAutoRenewPeriod() != nil {
+		info.AutoRenewPeriod = body.GetAutoRenewPeriod()
+	}
+	if body.GetExpiry() != nil {
+		info.Expiry = body.GetExpiry()
+	}
+	if body.GetMemo() != nil {
+		info.Memo = body.GetMemo().GetValue()
+	}
+	if body.GetMetadata() != nil {
+		info.Metadata = body.GetMetadata().GetValue()
+	}
+
+	// the balance of a fungible token moves to the new treasury, which is associated, unfrozen and granted KYC
+	if newTreasury != nil {
+		relationship, ok := newTreasury.tokens[tokenNum]
+		if !ok {
+			relationship = newTreasury._Associate(token)
+		}
+		relationship.frozen = false
+		relationship.kycGranted = true
+
+		balance := oldTreasury.tokens[tokenNum].balance
+		if balance != 0 {
+			oldTreasury.tokens[tokenNum].balance = 0
+			relationship.balance += balance
+			tx.record.TokenTransferLists = append(tx.record.TokenTransferLists, &services.TokenTransferList{
+				Token: info.TokenId,
+				Transfers: []*services.AccountAmount{
+					{AccountID: oldTreasury.info.AccountID, Amount: -int64(balance)},
+					{AccountID: newTreasury.info.AccountID, Amount: int64(balance)},
+				},
+			})
+		}
+		info.Treasury = newTreasury.info.AccountID
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenUpdateNfts(tx *_Transaction, body *services.TokenUpdateNftsTransactionBody) services.ResponseCodeEnum {
+	token, status := ledger._GetUnpausedToken(body.GetToken())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if token.info.MetadataKey == nil {
+		return services.ResponseCodeEnum_TOKEN_HAS_NO_METADATA_KEY
+	}
+	if !tx._IsSigned(token.info.MetadataKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if len(body.GetSerialNumbers()) == 0 {
+		return services.ResponseCodeEnum_MISSING_SERIAL_NUMBERS
+	}
+	for _, serial := range body.GetSerialNumbers() {
+		if _, ok := token.nfts[serial]; !ok {
+			return services.ResponseCodeEnum_INVALID_NFT_ID
+		}
+	}
+
+	if body.GetMetadata() != nil {
+		for _, serial := range body.GetSerialNumbers() {
+			token.nfts[serial].Metadata = body.GetMetadata().GetValue()
+		}
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenMove is a movement of a fungible amount or of an NFT between two accounts, applied by _MoveTokens once the
+// whole transaction was checked.
+type _TokenMove struct {
+	token  *_Token
+	from   *_Account
+	to     *_Account
+	amount uint64
+	nft    *services.TokenNftInfo
+}
+
+// _MoveTokens applies token movements, associating the receivers which are not associated yet, and adds them to
+// the token transfer lists of the record.
+func (tx *_Transaction) _MoveTokens(moves []_TokenMove) {
+	lists := make(map[int64]*services.TokenTransferList)
+	for _, move := range moves {
+		tokenNum := move.token.info.TokenId.GetTokenNum()
+		to, ok := move.to.tokens[tokenNum]
+		if !ok {
+			to = move.to._Associate(move.token)
+		}
+
+		list, ok := lists[tokenNum]
+		if !ok {
+			list = &services.TokenTransferList{Token: move.token.info.TokenId}
+			lists[tokenNum] = list
+			tx.record.TokenTransferLists = append(tx.record.TokenTransferLists, list)
+		}
+
+		if move.nft != nil {
+			move.nft.AccountID = move.to.info.AccountID
+			move.from.tokens[tokenNum].balance--
+			to.balance++
+			list.NftTransfers = append(list.NftTransfers, &services.NftTransfer{
+				SenderAccountID:   move.from.info.AccountID,
+				ReceiverAccountID: move.to.info.AccountID,
+				SerialNumber:      move.nft.GetNftID().GetSerialNumber(),
+			})
+			continue
+		}
+
+		move.from.tokens[tokenNum].balance -= move.amount
+		to.balance += move.amount
+		list.Transfers = append(list.Transfers,
+			&services.AccountAmount{AccountID: move.from.info.AccountID, Amount: -int64(move.amount)},
+			&services.AccountAmount{AccountID: move.to.info.AccountID, Amount: int64(move.amount)},
+		)
+	}
+}
+
+// _TokenReject returns the full balance of fungible tokens and single NFTs of an owner to the treasuries of the tokens.
+func (ledger *_Ledger) _TokenReject(tx *_Transaction, body *services.TokenRejectTransactionBody) services.ResponseCodeEnum { // nolint
+	owner := tx.payer
+	if body.GetOwner() != nil {
+		var status services.ResponseCodeEnum
+		if owner, status = ledger._GetAccount(body.GetOwner()); status != services.ResponseCodeEnum_SUCCESS {
+			return services.ResponseCodeEnum_INVALID_OWNER_ID
+		}
+	}
+	if !tx._IsSigned(owner.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if len(body.GetRejections()) == 0 {
+		return services.ResponseCodeEnum_EMPTY_TOKEN_REFERENCE_LIST
+	}
+
+	type reference struct {
+		token  int64
+		serial int64
+	}
+	seen := make(map[reference]bool)
+	moves := make([]_TokenMove, 0, len(body.GetRejections()))
+	for _, rejection := range body.GetRejections() {
+		var tokenID *services.TokenID
+		var serial int64
+		switch identifier := rejection.GetTokenIdentifier().(type) {
+		case *services.TokenReference_FungibleToken:
+			tokenID = identifier.FungibleToken
+		case *services.TokenReference_Nft:
+			tokenID = identifier.Nft.GetToken_ID()
+			serial = identifier.Nft.GetSerialNumber()
+		default:
+			return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+		}
+		key := reference{token: tokenID.GetTokenNum(), serial: serial}
+		if seen[key] {
+			return services.ResponseCodeEnum_TOKEN_REFERENCE_REPEATED
+		}
+		seen[key] = true
+
+		token, status := ledger._GetUnpausedToken(tokenID)
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		if token.info.Treasury.GetAccountNum() == owner.info.AccountID.GetAccountNum() {
+			return services.ResponseCodeEnum_ACCOUNT_IS_TREASURY
+		}
+		relationship, status := ledger._GetTokenRelationship(owner, token)
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		move := _TokenMove{token: token, from: owner, to: ledger.accounts[token.info.Treasury.GetAccountNum()]}
+
+		nonFungible := token.info.TokenType == services.TokenType_NON_FUNGIBLE_UNIQUE
+		switch {
+		case serial == 0 && nonFungible:
+			return services.ResponseCodeEnum_ACCOUNT_AMOUNT_TRANSFERS_ONLY_ALLOWED_FOR_FUNGIBLE_COMMON
+		case serial == 0:
+			if relationship.balance == 0 {
+				return services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE
+			}
+			move.amount = relationship.balance
+		default:
+			nft, ok := token.nfts[serial]
+			if !nonFungible || !ok {
+				return services.ResponseCodeEnum_INVALID_NFT_ID
+			}
+			if nft.GetAccountID().GetAccountNum() != owner.info.AccountID.GetAccountNum() {
+				return services.ResponseCodeEnum_INVALID_OWNER_ID
+			}
+			move.nft = nft
+		}
+		moves = append(moves, move)
+	}
+
+	tx._MoveTokens(moves)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenAirdrop transfers tokens to the receivers associated with them, and leaves the tokens for the other
+// receivers pending until they claim them. The pending amount of a fungible token is taken from its only sender.
+func (ledger *_Ledger) _TokenAirdrop(tx *_Transaction, body *services.TokenAirdropTransactionBody) services.ResponseCodeEnum { // nolint
+	if len(body.GetTokenTransfers()) == 0 {
+		return services.ResponseCodeEnum_EMPTY_TOKEN_TRANSFER_BODY
+	}
+
+	transfers := make([]*services.TokenTransferList, 0, len(body.GetTokenTransfers()))
+	pending := make(map[_PendingAirdrop]uint64)
+	for _, tokenTransfers := range body.GetTokenTransfers() {
+		token, status := ledger._GetUnpausedToken(tokenTransfers.GetToken())
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		tokenNum := tokenTransfers.GetToken().GetTokenNum()
+		transfer := &services.TokenTransferList{Token: tokenTransfers.GetToken(), ExpectedDecimals: tokenTransfers.GetExpectedDecimals()}
+
+		// the amounts for receivers which are not associated with the token are pending, and stay with the only
+		// debited account until they are claimed
+		var sender *_Account
+		var debit int64
+		for _, accountAmount := range tokenTransfers.GetTransfers() {
+			if accountAmount.GetAmount() >= 0 {
+				continue
+			}
+			account, status := ledger._GetAccount(accountAmount.GetAccountID())
+			if status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if sender != nil && sender != account {
+				return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+			}
+			sender = account
+			debit -= accountAmount.GetAmount()
+		}
+
+		var pendingAmount int64
+		for _, accountAmount := range tokenTransfers.GetTransfers() {
+			receiver, status := ledger._GetAccount(accountAmount.GetAccountID())
+			if status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if _, ok := receiver.tokens[tokenNum]; ok || sender == nil || accountAmount.GetAmount() <= 0 {
+				transfer.Transfers = append(transfer.Transfers, _Clone(accountAmount))
+				continue
+			}
+
+			key := _PendingAirdrop{
+				sender:   sender.info.AccountID.GetAccountNum(),
+				receiver: receiver.info.AccountID.GetAccountNum(),
+				token:    tokenNum,
+			}
+			pending[key] += uint64(accountAmount.GetAmount())
+			pendingAmount += accountAmount.GetAmount()
+		}
+
+		if pendingAmount != 0 {
+			if !tx._IsSigned(sender.info.Key) {
+				return services.ResponseCodeEnum_INVALID_SIGNATURE
+			}
+			relationship, status := ledger._GetTokenRelationship(sender, token)
+			if status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if relationship.balance < uint64(debit) {
+				return services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE
+			}
+
+			kept := transfer.Transfers[:0]
+			for _, accountAmount := range transfer.Transfers {
+				if accountAmount.GetAccountID().GetAccountNum() == sender.info.AccountID.GetAccountNum() && accountAmount.GetAmount() < 0 {
+					taken := min(-accountAmount.GetAmount(), pendingAmount)
+					accountAmount.Amount += taken
+					pendingAmount -= taken
+				}
+				if accountAmount.GetAmount() != 0 {
+					kept = append(kept, accountAmount)
+				}
+			}
+			transfer.Transfers = kept
+		}
+
+		for _, nftTransfer := range tokenTransfers.GetNftTransfers() {
+			receiver, status := ledger._GetAccount(nftTransfer.GetReceiverAccountID())
+			if status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if _, ok := receiver.tokens[tokenNum]; ok {
+				transfer.NftTransfers = append(transfer.NftTransfers, nftTransfer)
+				continue
+			}
+
+			nft, ok := token.nfts[nftTransfer.GetSerialNumber()]
+			if token.info.TokenType != services.TokenType_NON_FUNGIBLE_UNIQUE || !ok {
+				return services.ResponseCodeEnum_INVALID_NFT_ID
+			}
+			nftSender, status := ledger._GetAccount(nftTransfer.GetSenderAccountID())
+			if status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if nft.GetAccountID().GetAccountNum() != nftSender.info.AccountID.GetAccountNum() {
+				return services.ResponseCodeEnum_SENDER_DOES_NOT_OWN_NFT_SERIAL_NO
+			}
+			if !tx._IsSigned(nftSender.info.Key) {
+				return services.ResponseCodeEnum_INVALID_SIGNATURE
+			}
+			key := _PendingAirdrop{
+				sender:   nftSender.info.AccountID.GetAccountNum(),
+				receiver: receiver.info.AccountID.GetAccountNum(),
+				token:    tokenNum,
+				serial:   nftTransfer.GetSerialNumber(),
+			}
+			if _, ok := ledger.airdrops[key]; ok {
+				return services.ResponseCodeEnum_PENDING_NFT_AIRDROP_ALREADY_EXISTS
+			}
+			pending[key] = 0
+		}
+
+		if len(transfer.Transfers) != 0 || len(transfer.NftTransfers) != 0 {
+			transfers = append(transfers, transfer)
+		}
+	}
+
+	if len(transfers) != 0 {
+		if status := ledger._CryptoTransfer(tx, &services.CryptoTransferTransactionBody{TokenTransfers: transfers}); status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+	}
+
+	keys := make([]_PendingAirdrop, 0, len(pending))
+	for key := range pending {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.token != b.token {
+			return a.token < b.token
+		}
+		if a.serial != b.serial {
+			return a.serial < b.serial
+		}
+		if a.sender != b.sender {
+			return a.sender < b.sender
+		}
+		return a.receiver < b.receiver
+	})
+	for _, key := range keys {
+		ledger.airdrops[key] += pending[key]
+		record := &services.PendingAirdropRecord{PendingAirdropId: key._ToProtobuf()}
+		if key.serial == 0 {
+			record.PendingAirdropValue = &services.PendingAirdropValue{Amount: pending[key]}
+		}
+		tx.record.NewPendingAirdrops = append(tx.record.NewPendingAirdrops, record)
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _GetPendingAirdrops returns the pending airdrops with the given IDs, which must exist and be distinct.
+func (ledger *_Ledger) _GetPendingAirdrops(ids []*services.PendingAirdropId) ([]_PendingAirdrop, services.ResponseCodeEnum) {
+	if len(ids) == 0 {
+		return nil, services.ResponseCodeEnum_EMPTY_PENDING_AIRDROP_ID_LIST
+	}
+
+	airdrops := make([]_PendingAirdrop, 0, len(ids))
+	seen := make(map[_PendingAirdrop]bool)
+	for _, id := range ids {
+		airdrop := _PendingAirdrop{
+			sender:   id.GetSenderId().GetAccountNum(),
+			receiver: id.GetReceiverId().GetAccountNum(),
+		}
+		switch reference := id.GetTokenReference().(type) {
+		case *services.PendingAirdropId_FungibleTokenType:
+			airdrop.token = reference.FungibleTokenType.GetTokenNum()
+		case *services.PendingAirdropId_NonFungibleToken:
+			airdrop.token = reference.NonFungibleToken.GetToken_ID().GetTokenNum()
+			airdrop.serial = reference.NonFungibleToken.GetSerialNumber()
+		}
+		if _, ok := ledger.airdrops[airdrop]; !ok {
+			return nil, services.ResponseCodeEnum_INVALID_PENDING_AIRDROP_ID
+		}
+		if seen[airdrop] {
+			return nil, services.ResponseCodeEnum_PENDING_AIRDROP_ID_REPEATED
+		}
+		seen[airdrop] = true
+		airdrops = append(airdrops, airdrop)
+	}
+
+	return airdrops, services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenClaimAirdrop transfers pending airdrops to their receivers, which are associated with the tokens if needed.
+func (ledger *_Ledger) _TokenClaimAirdrop(tx *_Transaction, body *services.TokenClaimAirdropTransactionBody) services.ResponseCodeEnum {
+	airdrops, status := ledger._GetPendingAirdrops(body.GetPendingAirdrops())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+
+	debits := make(map[*_TokenRelationship]uint64)
+	moves := make([]_TokenMove, 0, len(airdrops))
+	for _, airdrop := range airdrops {
+		receiver, status := ledger._GetAccount(_AccountIDToProtobuf(airdrop.receiver))
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		if !tx._IsSigned(receiver.info.Key) {
+			return services.ResponseCodeEnum_INVALID_SIGNATURE
+		}
+		sender, status := ledger._GetAccount(_AccountIDToProtobuf(airdrop.sender))
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		token, status := ledger._GetUnpausedToken(_TokenIDToProtobuf(airdrop.token))
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		relationship, status := ledger._GetTokenRelationship(sender, token)
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		if _, ok := receiver.tokens[airdrop.token]; ok {
+			if _, status := ledger._GetTokenRelationship(receiver, token); status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+		} else if token.info.DefaultFreezeStatus == services.TokenFreezeStatus_Frozen {
+			return services.ResponseCodeEnum_ACCOUNT_FROZEN_FOR_TOKEN
+		} else if token.info.KycKey != nil {
+			return services.ResponseCodeEnum_ACCOUNT_KYC_NOT_GRANTED_FOR_TOKEN
+		}
+
+		move := _TokenMove{token: token, from: sender, to: receiver, amount: ledger.airdrops[airdrop]}
+		if airdrop.serial != 0 {
+			nft, ok := token.nfts[airdrop.serial]
+			if !ok || nft.GetAccountID().GetAccountNum() != airdrop.sender {
+				return services.ResponseCodeEnum_SENDER_DOES_NOT_OWN_NFT_SERIAL_NO
+			}
+			move.nft = nft
+		} else {
+			debits[relationship] += move.amount
+			if debits[relationship] > relationship.balance {
+				return services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE
+			}
+		}
+		moves = append(moves, move)
+	}
+
+	tx._MoveTokens(moves)
+	for _, airdrop := range airdrops {
+		delete(ledger.airdrops, airdrop)
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenCancelAirdrop removes pending airdrops with the signature of their senders.
+func (ledger *_Ledger) _TokenCancelAirdrop(tx *_Transaction, body *services.TokenCancelAirdropTransactionBody) services.ResponseCodeEnum {
+	airdrops, status := ledger._GetPendingAirdrops(body.GetPendingAirdrops())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	for _, airdrop := range airdrops {
+		if sender, ok := ledger.accounts[airdrop.sender]; !ok || !tx._IsSigned(sender.info.Key) {
+			return services.ResponseCodeEnum_INVALID_SIGNATURE
+		}
+	}
+
+	for _, airdrop := range airdrops {
+		delete(ledger.airdrops, airdrop)
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TopicCreate(tx *_Transaction, body *services.ConsensusCreateTopicTransactionBody) services.ResponseCodeEnum {
+	if !tx._IsSignedIfSet(body.GetAdminKey()) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if body.GetAutoRenewAccount() != nil {
+		account, status := ledger._GetAccount(body.GetAutoRenewAccount())
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return services.ResponseCodeEnum_INVALID_AUTORENEW_ACCOUNT
+		}
+		if !tx._IsSigned(account.info.Key) {
+			return services.ResponseCodeEnum_INVALID_SIGNATURE
+		}
+	}
+
+	autoRenewPeriod := body.GetAutoRenewPeriod()
+	if autoRenewPeriod == nil {
+		autoRenewPeriod = _DurationToProtobuf(_DefaultAutoRenewPeriod)
+	}
+
+	num := ledger._NextEntity()
+	ledger.topics[num] = &_Topic{
+		info: &services.ConsensusTopicInfo{
+			Memo:             body.GetMemo(),
+			RunningHash:      make([]byte, sha512.Size384),
+			ExpirationTime:   _TimestampToProtobuf(tx.consensusTime.Add(time.Duration(autoRenewPeriod.GetSeconds()) * time.Second)),
+			AdminKey:         body.GetAdminKey(),
+			SubmitKey:        body.GetSubmitKey(),
+			AutoRenewPeriod:  autoRenewPeriod,
+			AutoRenewAccount: body.GetAutoRenewAccount(),
+			FeeScheduleKey:   body.GetFeeScheduleKey(),
+			FeeExemptKeyList: body.GetFeeExemptKeyList(),
+			CustomFees:       body.GetCustomFees(),
+		},
+	}
+	tx.receipt.TopicID = _TopicIDToProtobuf(num)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TopicUpdate(tx *_Transaction, body *services.ConsensusUpdateTopicTransactionBody) services.ResponseCodeEnum {
+	topic, status := ledger._GetTopic(body.GetTopicID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if topic.info.AdminKey == nil {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+	if !tx._IsSigned(topic.info.AdminKey) || !tx._IsSignedIfSet(body.GetAdminKey()) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if body.GetMemo() != nil {
+		topic.info.Memo = body.GetMemo().GetValue()
+	}
+	if body.GetAdminKey() != nil {
+		topic.info.AdminKey = body.GetAdminKey()
+	}
+	if body.GetSubmitKey() != nil {
+		topic.info.SubmitKey = body.GetSubmitKey()
+	}
+	if body.GetAutoRenewPeriod() != nil {
+		topic.info.AutoRenewPeriod = body.GetAutoRenewPeriod()
+	}
+	if body.GetAutoRenewAccount() != nil {
+		topic.info.AutoRenewAccount = body.GetAutoRenewAccount()
+	}
+	if body.GetExpirationTime() != nil {
+		topic.info.ExpirationTime = body.GetExpirationTime()
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TopicDelete(tx *_Transaction, body *services.ConsensusDeleteTopicTransactionBody) services.ResponseCodeEnum {
+	topic, status := ledger._GetTopic(body.GetTopicID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if topic.info.AdminKey == nil {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+	if !tx._IsSigned(topic.info.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	topic.deleted = true
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TopicSubmitMessage(tx *_Transaction, body *services.ConsensusSubmitMessageTransactionBody) services.ResponseCodeEnum {
+	topic, status := ledger._GetTopic(body.GetTopicID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if !tx._IsSignedIfSet(topic.info.SubmitKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if len(body.GetMessage()) == 0 {
+		return services.ResponseCodeEnum_INVALID_TOPIC_MESSAGE
+	}
+
+	// the running hash only chains the messages, it is not computed as the network does
+	hash := sha512.New384()
+	hash.Write(topic.info.RunningHash)
+	hash.Write(body.GetMessage())
+
+	topic.info.SequenceNumber++
+	topic.info.RunningHash = hash.Sum(nil)
+	topic.messages = append(topic.messages, body.GetMessage())
+
+	tx.receipt.TopicSequenceNumber = topic.info.SequenceNumber
+	tx.receipt.TopicRunningHash = topic.info.RunningHash
+	tx.receipt.TopicRunningHashVersion = _RunningHashVersion
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _FileKey returns the key which has to sign changes to a file: every key of its key list.
+func _FileKey(keys *services.KeyList) *services.Key {
+	if len(keys.GetKeys()) == 0 {
+		return nil
+	}
+
+	return &services.Key{Key: &services.Key_KeyList{KeyList: keys}}
+}
+
+func (ledger *_Ledger) _FileCreate(tx *_Transaction, body *services.FileCreateTransactionBody) services.ResponseCodeEnum {
+	if !tx._IsSignedIfSet(_FileKey(body.GetKeys())) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	file := ledger._PutFile(ledger._NextEntity(), body.GetKeys(), body.GetContents())
+	file.info.Memo = body.GetMemo()
+	if body.GetExpirationTime() != nil {
+		file.info.ExpirationTime = body.GetExpirationTime()
+	}
+	tx.receipt.FileID = file.info.FileID
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileAppend(tx *_Transaction, body *services.FileAppendTransactionBody) services.ResponseCodeEnum {
+	file, status := ledger._GetFile(body.GetFileID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	key := _FileKey(file.info.Keys)
+	if key == nil {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+	if !tx._IsSigned(key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	file.contents = append(file.contents, body.GetContents()...)
+	file.info.Size = int64(len(file.contents))
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileUpdate(tx *_Transaction, body *services.FileUpdateTransactionBody) services.ResponseCodeEnum {
+	file, status := ledger._GetFile(body.GetFileID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	key := _FileKey(file.info.Keys)
+	if key == nil {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+	if !tx._IsSigned(key) || !tx._IsSignedIfSet(_FileKey(body.GetKeys())) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if body.GetContents() != nil {
+		file.contents = body.GetContents()
+		file.info.Size = int64(len(file.contents))
+	}
+	if body.GetKeys() != nil {
+		file.info.Keys = body.GetKeys()
+	}
+	if body.GetMemo() != nil {
+		file.info.Memo = body.GetMemo().GetValue()
+	}
+	if body.GetExpirationTime() != nil {
+		file.info.ExpirationTime = body.GetExpirationTime()
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileDelete(tx *_Transaction, body *services.FileDeleteTransactionBody) services.ResponseCodeEnum {
+	file, status := ledger._GetFile(body.GetFileID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	key := _FileKey(file.info.Keys)
+	if key == nil {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+	if !tx._IsSigned(key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	file.info.Deleted = true
+	file.info.Size = 0
+	file.contents = nil
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ContractCreate(tx *_Transaction, body *services.ContractCreateTransactionBody) services.ResponseCodeEnum {
+	var bytecode []byte
+	switch source := body.GetInitcodeSource().(type) {
+	case *services.ContractCreateTransactionBody_FileID:
+		file, status := ledger._GetFile(source.FileID)
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		bytecode = file.contents
+	case *services.ContractCreateTransactionBody_Initcode:
+		bytecode = source.Initcode
+	}
+	if len(bytecode) == 0 {
+		return services.ResponseCodeEnum_CONTRACT_BYTECODE_EMPTY
+	}
+	if body.GetInitialBalance() < 0 {
+		return services.ResponseCodeEnum_CONTRACT_NEGATIVE_VALUE
+	}
+	if uint64(body.GetInitialBalance()) > tx.payer.info.Balance {
+		return services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE
+	}
+	if !tx._IsSignedIfSet(body.GetAdminKey()) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	autoRenewPeriod := body.GetAutoRenewPeriod()
+	if autoRenewPeriod == nil {
+		autoRenewPeriod = _DurationToProtobuf(_DefaultAutoRenewPeriod)
+	}
+
+	num := ledger._NextEntity()
+	contractID := _ContractIDToProtobuf(num)
+	// only the contract itself can move the balance of its account
+	account := ledger._PutAccount(num, &services.Key{Key: &services.Key_ContractID{ContractID: contractID}}, 0)
+	account.info.ContractAccountID = _LongZeroAddress(num)
+
+	// without an EVM the deployed bytecode is the initcode
+	ledger.contracts[num] = &_Contract{
+		info: &services.ContractGetInfoResponse_ContractInfo{
+			ContractID:                    contractID,
+			AccountID:                     account.info.AccountID,
+			ContractAccountID:             account.info.ContractAccountID,
+			AdminKey:                      body.GetAdminKey(),
+			ExpirationTime:                _TimestampToProtobuf(tx.consensusTime.Add(time.Duration(autoRenewPeriod.GetSeconds()) * time.Second)),
+			AutoRenewPeriod:               autoRenewPeriod,
+			Memo:                          body.GetMemo(),
+			AutoRenewAccountId:            body.GetAutoRenewAccountId(),
+			MaxAutomaticTokenAssociations: body.GetMaxAutomaticTokenAssociations(),
+		},
+		bytecode: bytecode,
+	}
+
+	tx._Transfer(tx.payer, account, body.GetInitialBalance())
+	tx.receipt.ContractID = contractID
+	tx.record.Body = &services.TransactionRecord_ContractCreateResult{
+		ContractCreateResult: &services.ContractFunctionResult{
+			ContractID: contractID,
+			Gas:        body.GetGas(),
+			Amount:     body.GetInitialBalance(),
+		},
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ContractCall(tx *_Transaction, body *services.ContractCallTransactionBody) services.ResponseCodeEnum {
+	contract, status := ledger._GetContract(body.GetContractID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if body.GetAmount() < 0 {
+		return services.ResponseCodeEnum_CONTRACT_NEGATIVE_VALUE
+	}
+	if uint64(body.GetAmount()) > tx.payer.info.Balance {
+		return services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE
+	}
+
+	tx._Transfer(tx.payer, ledger.accounts[contract.info.AccountID.GetAccountNum()], body.GetAmount())
+	tx.receipt.ContractID = contract.info.ContractID
+	tx.record.Body = &services.TransactionRecord_ContractCallResult{
+		ContractCallResult: &services.ContractFunctionResult{
+			ContractID:         contract.info.ContractID,
+			Gas:                body.GetGas(),
+			Amount:             body.GetAmount(),
+			FunctionParameters: body.GetFunctionParameters(),
+			SenderId:           tx.payer.info.AccountID,
+		},
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ContractDelete(tx *_Transaction, body *services.ContractDeleteTransactionBody) services.ResponseCodeEnum {
+	contract, status := ledger._GetContract(body.GetContractID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if contract.info.AdminKey == nil {
+		return services.ResponseCodeEnum_MODIFYING_IMMUTABLE_CONTRACT
+	}
+	if !tx._IsSigned(contract.info.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	var obtainer *_Account
+	switch target := body.GetObtainers().(type) {
+	case *services.ContractDeleteTransactionBody_TransferAccountID:
+		obtainer, status = ledger._GetAccount(target.TransferAccountID)
+	case *services.ContractDeleteTransactionBody_TransferContractID:
+		obtainer, status = ledger._GetAccount(_AccountIDToProtobuf(target.TransferContractID.GetContractNum()))
+	default:
+		return services.ResponseCodeEnum_OBTAINER_REQUIRED
+	}
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return services.ResponseCodeEnum_INVALID_TRANSFER_ACCOUNT_ID
+	}
+
+	account := ledger.accounts[contract.info.AccountID.GetAccountNum()]
+	if obtainer == account {
+		return services.ResponseCodeEnum_OBTAINER_SAME_CONTRACT_ID
+	}
+
+	tx._Transfer(account, obtainer, int64(account.info.Balance))
+	account.info.Deleted = true
+	contract.info.Deleted = true
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ScheduleCreate(tx *_Transaction, body *services.ScheduleCreateTransactionBody) services.ResponseCodeEnum {
+	if body.GetScheduledTransactionBody() == nil {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION
+	}
+	if !tx._IsSignedIfSet(body.GetAdminKey()) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	payerID := tx.payer.info.AccountID
+	if body.GetPayerAccountID() != nil {
+		if _, status := ledger._GetAccount(body.GetPayerAccountID()); status != services.ResponseCodeEnum_SUCCESS {
+			return services.ResponseCodeEnum_INVALID_SCHEDULE_PAYER_ID
+		}
+		payerID = body.GetPayerAccountID()
+	}
+
+	expirationTime := body.GetExpirationTime()
+	if expirationTime == nil {
+		expirationTime = _TimestampToProtobuf(tx.consensusTime.Add(_DefaultScheduleLifetime))
+	}
+
+	scheduledTransactionID := _Clone(tx.body.GetTransactionID())
+	scheduledTransactionID.Scheduled = true
+
+	num := ledger._NextEntity()
+	schedule := &_Schedule{
+		info: &services.ScheduleInfo{
+			ScheduleID:               _ScheduleIDToProtobuf(num),
+			ExpirationTime:           expirationTime,
+			ScheduledTransactionBody: body.GetScheduledTransactionBody(),
+			Memo:                     body.GetMemo(),
+			AdminKey:                 body.GetAdminKey(),
+			Signers:                  &services.KeyList{},
+			CreatorAccountID:         tx.payer.info.AccountID,
+			PayerAccountID:           payerID,
+			ScheduledTransactionID:   scheduledTransactionID,
+			WaitForExpiry:            body.GetWaitForExpiry(),
+		},
+	}
+	schedule._AddSigners(tx)
+	ledger.schedules[num] = schedule
+
+	tx.receipt.ScheduleID = schedule.info.ScheduleID
+	tx.receipt.ScheduledTransactionID = scheduledTransactionID
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ScheduleSign(tx *_Transaction, body *services.ScheduleSignTransactionBody) services.ResponseCodeEnum {
+	schedule, status := ledger._GetSchedule(body.GetScheduleID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+
+	schedule._AddSigners(tx)
+	tx.receipt.ScheduledTransactionID = schedule.info.ScheduledTransactionID
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ScheduleDelete(tx *_Transaction, body *services.ScheduleDeleteTransactionBody) services.ResponseCodeEnum {
+	schedule, status := ledger._GetSchedule(body.GetScheduleID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if schedule.info.AdminKey == nil {
+		return services.ResponseCodeEnum_SCHEDULE_IS_IMMUTABLE
+	}
+	if !tx._IsSigned(schedule.info.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	schedule.info.Data = &services.ScheduleInfo_DeletionTime{DeletionTime: _TimestampToProtobuf(tx.consensusTime)}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _AddSigners adds the keys which signed tx to the signers of the schedule.
+func (schedule *_Schedule) _AddSigners(tx *_Transaction) {
+	for _, key := range tx.signed {
+		protoKey, err := _KeyToProtobuf(key)
+		if err != nil {
+			continue
+		}
+
+		known := false
+		for _, signer := range schedule.info.Signers.Keys {
+			known = known || _IsSigned(signer, []hiero.PublicKey{key})
+		}
+		if !known {
+			schedule.info.Signers.Keys = append(schedule.info.Signers.Keys, protoKey)
+		}
+	}
+}
+
+// _LongZeroAddress returns the hex EVM address of an entity without an alias.
+func _LongZeroAddress(num int64) string {
+	address := make([]byte, 20)
+	for i := 19; i >= 12 && num > 0; i-- {
+		address[i] = byte(num)
+		num >>= 8
+	}
+
+	return hex.EncodeToString(address)
+}
@@ -0,0 +1,200 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"google.golang.org/grpc"
+)
+
+// MiddlewareRequest describes a single attempt to send a transaction or query to a consensus node.
+type MiddlewareRequest struct {
+	// Executable is the transaction or query being executed.
+	Executable Executable
+	// NodeAccountID is the account ID of the node the request is sent to.
+	NodeAccountID AccountID
+	// NodeAddress is the address of the node the request is sent to.
+	NodeAddress string
+	// Attempt is the zero based number of the attempt within the execution.
+	Attempt int64
+	// Request is the *services.Transaction or *services.Query sent to the node.
+	Request any
+}
+
+// MiddlewareHandler sends a request to a node and returns the *services.TransactionResponse or
+// *services.Response received from it.
+type MiddlewareHandler func(ctx context.Context, request MiddlewareRequest) (any, error)
+
+// Middleware wraps every attempt of a transaction or query execution. It may inspect or modify the context and the
+// request before calling next, inspect the response afterwards, or return its own response or error without calling
+// next. Errors are handled like gRPC errors returned by the node, so a status error with a retryable code such as
+// codes.Unavailable makes the SDK retry with another node.
+type Middleware func(next MiddlewareHandler) MiddlewareHandler
+
+// _Interceptors holds the gRPC interceptors and middleware added to a client. The connections to the nodes call the
+// interceptors added at the time of the call, so interceptors can be added after the connections are opened.
+type _Interceptors struct {
+	mutex      sync.RWMutex
+	unary      []grpc.UnaryClientInterceptor
+	stream     []grpc.StreamClientInterceptor
+	middleware []Middleware
+}
+
+func (interceptors *_Interceptors) _AddUnary(interceptor grpc.UnaryClientInterceptor) {
+	interceptors.mutex.Lock()
+	defer interceptors.mutex.Unlock()
+
+	interceptors.unary = append(interceptors.unary, interceptor)
+}
+
+func (interceptors *_Interceptors) _AddStream(interceptor grpc.StreamClientInterceptor) {
+	interceptors.mutex.Lock()
+	defer interceptors.mutex.Unlock()
+
+	interceptors.stream = append(interceptors.stream, interceptor)
+}
+
+func (interceptors *_Interceptors) _AddMiddleware(middleware Middleware) {
+	interceptors.mutex.Lock()
+	defer interceptors.mutex.Unlock()
+
+	interceptors.middleware = append(interceptors.middleware, middleware)
+}
+
+// _DialOptions returns the dial options which install the interceptors on a connection.
+func (interceptors *_Interceptors) _DialOptions() []grpc.DialOption {
+	if interceptors == nil {
+		return nil
+	}
+
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(interceptors._UnaryInterceptor),
+		grpc.WithChainStreamInterceptor(interceptors._StreamInterceptor),
+	}
+}
+
+// _UnaryInterceptor calls the unary interceptors in the order they were added.
+func (interceptors *_Interceptors) _UnaryInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	interceptors.mutex.RLock()
+	unary := interceptors.unary
+	interceptors.mutex.RUnlock()
+
+	for i := len(unary) - 1; i >= 0; i-- {
+		interceptor, next := unary[i], invoker
+		invoker = func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return interceptor(ctx, method, req, reply, cc, next, opts...)
+		}
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// _StreamInterceptor calls the stream interceptors in the order they were added.
+func (interceptors *_Interceptors) _StreamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	interceptors.mutex.RLock()
+	stream := interceptors.stream
+	interceptors.mutex.RUnlock()
+
+	for i := len(stream) - 1; i >= 0; i-- {
+		interceptor, next := stream[i], streamer
+		streamer = func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return interceptor(ctx, desc, cc, method, next, opts...)
+		}
+	}
+
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// _Wrap returns a method which passes the calls of method through the middleware in the order it was added.
+func (interceptors *_Interceptors) _Wrap(e Executable, node *_Node, attempt int64, method _Method) _Method {
+	if interceptors == nil {
+		return method
+	}
+
+	interceptors.mutex.RLock()
+	middleware := interceptors.middleware
+	interceptors.mutex.RUnlock()
+
+	if len(middleware) == 0 {
+		return method
+	}
+
+	handle := func(ctx context.Context, request any, opts []grpc.CallOption) (any, error) {
+		var handler MiddlewareHandler = func(ctx context.Context, request MiddlewareRequest) (any, error) {
+			switch req := request.Request.(type) {
+			case *services.Query:
+				return method.query(ctx, req, opts...)
+			case *services.Transaction:
+				return method.transaction(ctx, req, opts...)
+			default:
+				return nil, fmt.Errorf("middleware passed an unexpected request type %T", request.Request)
+			}
+		}
+
+		for i := len(middleware) - 1; i >= 0; i-- {
+			handler = middleware[i](handler)
+		}
+
+		return handler(ctx, MiddlewareRequest{
+			Executable:    e,
+			NodeAccountID: node.accountID,
+			NodeAddress:   node.address._String(),
+			Attempt:       attempt,
+			Request:       request,
+		})
+	}
+
+	wrapped := _Method{}
+
+	if method.query != nil {
+		wrapped.query = func(ctx context.Context, query *services.Query, opts ...grpc.CallOption) (*services.Response, error) {
+			resp, err := handle(ctx, query, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			response, ok := resp.(*services.Response)
+			if !ok || response == nil {
+				return nil, fmt.Errorf("middleware returned an unexpected response type %T", resp)
+			}
+
+			return response, nil
+		}
+	}
+
+	if method.transaction != nil {
+		wrapped.transaction = func(ctx context.Context, transaction *services.Transaction, opts ...grpc.CallOption) (*services.TransactionResponse, error) {
+			resp, err := handle(ctx, transaction, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			response, ok := resp.(*services.TransactionResponse)
+			if !ok || response == nil {
+				return nil, fmt.Errorf("middleware returned an unexpected response type %T", resp)
+			}
+
+			return response, nil
+		}
+	}
+
+	return wrapped
+}
@@ -0,0 +1,227 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnitMockUnaryInterceptors(t *testing.T) {
+	t.Parallel()
+
+	call := func(ctx context.Context, request *services.Transaction) *services.TransactionResponse {
+		md, ok := metadata.FromIncomingContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, []string{"Bearer token"}, md.Get("proxy-authorization"))
+		require.Equal(t, []string{"hiero-sdk-go/DEV"}, md.Get("x-user-agent"))
+
+		return &services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		}
+	}
+	responses := [][]interface{}{{
+		MockTransactionHandlerFunc(call),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	var calls []string
+	client.AddUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		calls = append(calls, "first "+method)
+		ctx = metadata.AppendToOutgoingContext(ctx, "proxy-authorization", "Bearer token")
+		return invoker(ctx, method, req, reply, cc, opts...)
+	})
+	client.AddUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		calls = append(calls, "second "+method)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	})
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"first /proto.CryptoService/cryptoTransfer",
+		"second /proto.CryptoService/cryptoTransfer",
+	}, calls)
+}
+
+func TestUnitMockMiddleware(t *testing.T) {
+	t.Parallel()
+
+	call := func(request *services.Transaction) *services.TransactionResponse {
+		return &services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		}
+	}
+	responses := [][]interface{}{{
+		call,
+	}, {
+		call,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	var requests []MiddlewareRequest
+	var statuses []services.ResponseCodeEnum
+	client.AddMiddleware(func(next MiddlewareHandler) MiddlewareHandler {
+		return func(ctx context.Context, request MiddlewareRequest) (any, error) {
+			requests = append(requests, request)
+			resp, err := next(ctx, request)
+			if err == nil {
+				statuses = append(statuses, resp.(*services.TransactionResponse).NodeTransactionPrecheckCode)
+			}
+			return resp, err
+		}
+	})
+	// Fail the first attempt as if the node was unavailable
+	client.AddMiddleware(func(next MiddlewareHandler) MiddlewareHandler {
+		return func(ctx context.Context, request MiddlewareRequest) (any, error) {
+			if request.Attempt == 0 {
+				return nil, status.Error(codes.Unavailable, "chaos")
+			}
+			return next(ctx, request)
+		}
+	})
+
+	tx := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1))
+	response, err := tx.Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 4}, response.NodeID)
+
+	require.Len(t, requests, 2)
+	require.Equal(t, int64(0), requests[0].Attempt)
+	require.Equal(t, AccountID{Account: 3}, requests[0].NodeAccountID)
+	require.Equal(t, int64(1), requests[1].Attempt)
+	require.Equal(t, AccountID{Account: 4}, requests[1].NodeAccountID)
+	require.Equal(t, tx, requests[1].Executable)
+	require.IsType(t, &services.Transaction{}, requests[1].Request)
+	require.Equal(t, []services.ResponseCodeEnum{services.ResponseCodeEnum_OK}, statuses)
+}
+
+func TestUnitMockMiddlewareReplacesResponse(t *testing.T) {
+	t.Parallel()
+
+	call := func(request *services.Query) *services.Response {
+		return &services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+					AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 5}},
+					Balance:   1,
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{
+		call,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	client.AddMiddleware(func(next MiddlewareHandler) MiddlewareHandler {
+		return func(ctx context.Context, request MiddlewareRequest) (any, error) {
+			if _, err := next(ctx, request); err != nil {
+				return nil, err
+			}
+			return &services.Response{
+				Response: &services.Response_CryptogetAccountBalance{
+					CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+						Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+						AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 5}},
+						Balance:   42,
+					},
+				},
+			}, nil
+		}
+	})
+
+	balance, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 5}).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, HbarFromTinybar(42), balance.Hbars)
+}
+
+func TestUnitMockMiddlewareUnexpectedResponse(t *testing.T) {
+	t.Parallel()
+
+	call := func(request *services.Query) *services.Response {
+		return &services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+					AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 5}},
+					Balance:   1,
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{
+		call,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	client.AddMiddleware(func(next MiddlewareHandler) MiddlewareHandler {
+		return func(ctx context.Context, request MiddlewareRequest) (any, error) {
+			if _, err := next(ctx, request); err != nil {
+				return nil, err
+			}
+			return &services.TransactionResponse{}, nil
+		}
+	})
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 5}).
+		Execute(client)
+	require.ErrorContains(t, err, "middleware returned an unexpected response type *services.TransactionResponse")
+}
+
+func TestUnitMockStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.NodeAddress{
+			NodeAccountId: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 3}},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	var methods []string
+	client.AddStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		methods = append(methods, method)
+		return streamer(ctx, desc, cc, method, opts...)
+	})
+
+	result, err := NewAddressBookQuery().
+		SetFileID(FileID{0, 0, 101, nil}).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, result.NodeAddresses, 1)
+	require.Equal(t, []string{"/com.hedera.mirror.api.proto.NetworkService/getNodes"}, methods)
+}
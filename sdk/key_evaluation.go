@@ -0,0 +1,98 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import "fmt"
+
+// KeyEvaluation is the result of evaluating a key structure against the keys which signed a transaction.
+type KeyEvaluation struct {
+	// Satisfied is true when the signatures satisfy the key.
+	Satisfied bool
+	// MissingKeys are the unsigned public keys in the unsatisfied parts of the key structure.
+	// Any subset of them which satisfies the thresholds is enough.
+	MissingKeys []PublicKey
+	// MissingContracts are the contracts of ContractID and DelegatableContractID keys in the unsatisfied parts of the
+	// key structure. Contract keys are satisfied by the contract calling the network, not by signatures.
+	MissingContracts []ContractID
+}
+
+// EvaluateKey reports whether signatures by signedKeys satisfy key, evaluating the thresholds of nested KeyLists.
+// A KeyList without a threshold requires all of its keys and an empty KeyList is never satisfied. ContractID and
+// DelegatableContractID keys are only satisfied by the contracts in authorizedContracts, e.g. when the transaction is
+// a call made by that contract.
+func EvaluateKey(key Key, signedKeys []PublicKey, authorizedContracts ...ContractID) KeyEvaluation {
+	signed := make(map[string]bool, len(signedKeys))
+	for _, signedKey := range signedKeys {
+		signed[signedKey.String()] = true
+	}
+
+	contracts := make(map[string]bool, len(authorizedContracts))
+	for _, contractID := range authorizedContracts {
+		contracts[_ContractKeyID(contractID.Shard, contractID.Realm, contractID.Contract)] = true
+	}
+
+	return _EvaluateKey(key, signed, contracts)
+}
+
+func _ContractKeyID(shard uint64, realm uint64, contract uint64) string {
+	return fmt.Sprintf("%d.%d.%d", shard, realm, contract)
+}
+
+func _EvaluateKey(key Key, signed map[string]bool, contracts map[string]bool) KeyEvaluation {
+	switch k := key.(type) {
+	case PublicKey:
+		if signed[k.String()] {
+			return KeyEvaluation{Satisfied: true, MissingKeys: []PublicKey{}, MissingContracts: []ContractID{}}
+		}
+		return KeyEvaluation{MissingKeys: []PublicKey{k}, MissingContracts: []ContractID{}}
+	case *PublicKey:
+		return _EvaluateKey(*k, signed, contracts)
+	case PrivateKey:
+		return _EvaluateKey(k.PublicKey(), signed, contracts)
+	case *PrivateKey:
+		return _EvaluateKey(k.PublicKey(), signed, contracts)
+	case ContractID:
+		return _EvaluateContractKey(k, signed, contracts)
+	case *ContractID:
+		return _EvaluateContractKey(*k, signed, contracts)
+	case DelegatableContractID:
+		return _EvaluateContractKey(ContractID{Shard: k.Shard, Realm: k.Realm, Contract: k.Contract}, signed, contracts)
+	case *DelegatableContractID:
+		return _EvaluateContractKey(ContractID{Shard: k.Shard, Realm: k.Realm, Contract: k.Contract}, signed, contracts)
+	case KeyList:
+		return _EvaluateKey(&k, signed, contracts)
+	case *KeyList:
+		required := len(k.keys)
+		if k.threshold >= 0 && k.threshold < required {
+			required = k.threshold
+		}
+
+		satisfiedCount := 0
+		result := KeyEvaluation{MissingKeys: []PublicKey{}, MissingContracts: []ContractID{}}
+		for _, child := range k.keys {
+			evaluation := _EvaluateKey(child, signed, contracts)
+			if evaluation.Satisfied {
+				satisfiedCount++
+			} else {
+				result.MissingKeys = append(result.MissingKeys, evaluation.MissingKeys...)
+				result.MissingContracts = append(result.MissingContracts, evaluation.MissingContracts...)
+			}
+		}
+
+		if len(k.keys) > 0 && satisfiedCount >= required {
+			return KeyEvaluation{Satisfied: true, MissingKeys: []PublicKey{}, MissingContracts: []ContractID{}}
+		}
+
+		return result
+	default:
+		return KeyEvaluation{MissingKeys: []PublicKey{}, MissingContracts: []ContractID{}}
+	}
+}
+
+func _EvaluateContractKey(contractID ContractID, _ map[string]bool, contracts map[string]bool) KeyEvaluation {
+	if contracts[_ContractKeyID(contractID.Shard, contractID.Realm, contractID.Contract)] {
+		return KeyEvaluation{Satisfied: true, MissingKeys: []PublicKey{}, MissingContracts: []ContractID{}}
+	}
+
+	return KeyEvaluation{MissingKeys: []PublicKey{}, MissingContracts: []ContractID{contractID}}
+}
@@ -0,0 +1,439 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// EntityKeyType identifies which key of a network entity an EntityKey refers to.
+type EntityKeyType int
+
+const (
+	// EntityKeyTypeAccount is the key of an account.
+	EntityKeyTypeAccount EntityKeyType = iota
+	// EntityKeyTypeAccountReceiver is the key of an account which requires receiver signatures.
+	EntityKeyTypeAccountReceiver
+	EntityKeyTypeTokenAdmin
+	EntityKeyTypeTokenKyc
+	EntityKeyTypeTokenFreeze
+	EntityKeyTypeTokenWipe
+	EntityKeyTypeTokenSupply
+	EntityKeyTypeTokenFeeSchedule
+	EntityKeyTypeTokenPause
+	EntityKeyTypeTokenMetadata
+	// EntityKeyTypeFile is the key list of a file.
+	EntityKeyTypeFile
+	EntityKeyTypeTopicAdmin
+	EntityKeyTypeTopicSubmit
+	EntityKeyTypeContractAdmin
+	EntityKeyTypeScheduleAdmin
+	EntityKeyTypeNodeAdmin
+)
+
+// String returns a string representation of the EntityKeyType
+func (keyType EntityKeyType) String() string {
+	switch keyType {
+	case EntityKeyTypeAccount:
+		return "key of account"
+	case EntityKeyTypeAccountReceiver:
+		return "receiver key of account"
+	case EntityKeyTypeTokenAdmin:
+		return "admin key of token"
+	case EntityKeyTypeTokenKyc:
+		return "KYC key of token"
+	case EntityKeyTypeTokenFreeze:
+		return "freeze key of token"
+	case EntityKeyTypeTokenWipe:
+		return "wipe key of token"
+	case EntityKeyTypeTokenSupply:
+		return "supply key of token"
+	case EntityKeyTypeTokenFeeSchedule:
+		return "fee schedule key of token"
+	case EntityKeyTypeTokenPause:
+		return "pause key of token"
+	case EntityKeyTypeTokenMetadata:
+		return "metadata key of token"
+	case EntityKeyTypeFile:
+		return "keys of file"
+	case EntityKeyTypeTopicAdmin:
+		return "admin key of topic"
+	case EntityKeyTypeTopicSubmit:
+		return "submit key of topic"
+	case EntityKeyTypeContractAdmin:
+		return "admin key of contract"
+	case EntityKeyTypeScheduleAdmin:
+		return "admin key of schedule"
+	case EntityKeyTypeNodeAdmin:
+		return "admin key of node"
+	default:
+		return "unknown key"
+	}
+}
+
+// EntityKey refers to one key of a network entity, e.g. the supply key of a token.
+type EntityKey struct {
+	Type EntityKeyType
+	// ID is the entity ID without checksum, e.g. "0.0.1234", or the node ID for EntityKeyTypeNodeAdmin.
+	ID string
+}
+
+// String returns a string representation of the EntityKey
+func (entity EntityKey) String() string {
+	return entity.Type.String() + " " + entity.ID
+}
+
+// KeyResolver looks up the current keys of network entities, e.g. for RequiredSigners.
+type KeyResolver interface {
+	// ResolveKey returns the key the entity holds, or nil when the entity has no such key. For
+	// EntityKeyTypeAccountReceiver the account key is only returned when the account requires receiver signatures.
+	ResolveKey(ctx context.Context, entity EntityKey) (Key, error)
+}
+
+// ErrKeyNotResolved is returned by a KeyResolver which does not know the key of an entity.
+type ErrKeyNotResolved struct {
+	Entity EntityKey
+}
+
+// Error() implements the Error interface
+func (e ErrKeyNotResolved) Error() string {
+	return fmt.Sprintf("unable to resolve the %s", e.Entity.String())
+}
+
+// StaticKeyResolver resolves keys from a fixed map, e.g. in tests or for offline tooling.
+// Entities missing from the map are reported with ErrKeyNotResolved, except that accounts without a receiver entry do
+// not require receiver signatures.
+type StaticKeyResolver struct {
+	mutex sync.RWMutex
+	keys  map[EntityKey]Key
+}
+
+// NewStaticKeyResolver creates an empty StaticKeyResolver.
+func NewStaticKeyResolver() *StaticKeyResolver {
+	return &StaticKeyResolver{
+		keys: make(map[EntityKey]Key),
+	}
+}
+
+// SetKey sets the key of an entity. A nil key records that the entity has no such key.
+func (resolver *StaticKeyResolver) SetKey(entity EntityKey, key Key) *StaticKeyResolver {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	resolver.keys[entity] = key
+	return resolver
+}
+
+// SetAccountKey sets the key of an account and whether it requires receiver signatures.
+func (resolver *StaticKeyResolver) SetAccountKey(accountID AccountID, key Key, receiverSignatureRequired bool) *StaticKeyResolver {
+	resolver.SetKey(EntityKey{Type: EntityKeyTypeAccount, ID: accountID.String()}, key)
+	if receiverSignatureRequired {
+		resolver.SetKey(EntityKey{Type: EntityKeyTypeAccountReceiver, ID: accountID.String()}, key)
+	}
+
+	return resolver
+}
+
+// SetTokenKey sets one of the keys of a token, keyType must be one of the EntityKeyTypeToken types.
+func (resolver *StaticKeyResolver) SetTokenKey(tokenID TokenID, keyType EntityKeyType, key Key) *StaticKeyResolver {
+	return resolver.SetKey(EntityKey{Type: keyType, ID: tokenID.String()}, key)
+}
+
+// SetFileKeys sets the key list of a file.
+func (resolver *StaticKeyResolver) SetFileKeys(fileID FileID, keys KeyList) *StaticKeyResolver {
+	return resolver.SetKey(EntityKey{Type: EntityKeyTypeFile, ID: fileID.String()}, &keys)
+}
+
+// SetTopicKey sets the admin or submit key of a topic.
+func (resolver *StaticKeyResolver) SetTopicKey(topicID TopicID, keyType EntityKeyType, key Key) *StaticKeyResolver {
+	return resolver.SetKey(EntityKey{Type: keyType, ID: topicID.String()}, key)
+}
+
+// SetContractAdminKey sets the admin key of a contract.
+func (resolver *StaticKeyResolver) SetContractAdminKey(contractID ContractID, key Key) *StaticKeyResolver {
+	return resolver.SetKey(EntityKey{Type: EntityKeyTypeContractAdmin, ID: contractID.String()}, key)
+}
+
+// SetScheduleAdminKey sets the admin key of a schedule.
+func (resolver *StaticKeyResolver) SetScheduleAdminKey(scheduleID ScheduleID, key Key) *StaticKeyResolver {
+	return resolver.SetKey(EntityKey{Type: EntityKeyTypeScheduleAdmin, ID: scheduleID.String()}, key)
+}
+
+// SetNodeAdminKey sets the admin key of a consensus node.
+func (resolver *StaticKeyResolver) SetNodeAdminKey(nodeID uint64, key Key) *StaticKeyResolver {
+	return resolver.SetKey(EntityKey{Type: EntityKeyTypeNodeAdmin, ID: strconv.FormatUint(nodeID, 10)}, key)
+}
+
+// ResolveKey implements KeyResolver
+func (resolver *StaticKeyResolver) ResolveKey(_ context.Context, entity EntityKey) (Key, error) {
+	resolver.mutex.RLock()
+	defer resolver.mutex.RUnlock()
+
+	key, ok := resolver.keys[entity]
+	if !ok {
+		if entity.Type == EntityKeyTypeAccountReceiver {
+			return nil, nil
+		}
+		return nil, ErrKeyNotResolved{Entity: entity}
+	}
+
+	return key, nil
+}
+
+// NetworkKeyResolver resolves keys with info queries paid by the client's operator.
+// Every entity is queried once and cached for the lifetime of the resolver. Node admin keys cannot be queried from
+// the consensus nodes, use a MirrorKeyResolver for them.
+type NetworkKeyResolver struct {
+	_KeyResolverCache
+	client *Client
+}
+
+// NewNetworkKeyResolver creates a NetworkKeyResolver which queries the network of client.
+func NewNetworkKeyResolver(client *Client) *NetworkKeyResolver {
+	return &NetworkKeyResolver{
+		_KeyResolverCache: _KeyResolverCache{entries: make(map[string]any)},
+		client:            client,
+	}
+}
+
+// ResolveKey implements KeyResolver
+func (resolver *NetworkKeyResolver) ResolveKey(ctx context.Context, entity EntityKey) (Key, error) {
+	switch entity.Type {
+	case EntityKeyTypeAccount, EntityKeyTypeAccountReceiver:
+		accountID, err := AccountIDFromString(entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		info, err := _KeyResolverCached(&resolver._KeyResolverCache, "account "+entity.ID, func() (AccountInfo, error) {
+			return NewAccountInfoQuery().SetAccountID(accountID).ExecuteWithContext(ctx, resolver.client)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return _AccountKeyFor(entity.Type, info.Key, info.ReceiverSigRequired), nil
+	case EntityKeyTypeTokenAdmin, EntityKeyTypeTokenKyc, EntityKeyTypeTokenFreeze, EntityKeyTypeTokenWipe,
+		EntityKeyTypeTokenSupply, EntityKeyTypeTokenFeeSchedule, EntityKeyTypeTokenPause, EntityKeyTypeTokenMetadata:
+		tokenID, err := TokenIDFromString(entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		info, err := _KeyResolverCached(&resolver._KeyResolverCache, "token "+entity.ID, func() (TokenInfo, error) {
+			return NewTokenInfoQuery().SetTokenID(tokenID).ExecuteWithContext(ctx, resolver.client)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return _TokenKeyFor(entity.Type, info.AdminKey, info.KycKey, info.FreezeKey, info.WipeKey, info.SupplyKey,
+			info.FeeScheduleKey, info.PauseKey, info.MetadataKey), nil
+	case EntityKeyTypeFile:
+		fileID, err := FileIDFromString(entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		info, err := _KeyResolverCached(&resolver._KeyResolverCache, "file "+entity.ID, func() (FileInfo, error) {
+			return NewFileInfoQuery().SetFileID(fileID).ExecuteWithContext(ctx, resolver.client)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &info.Keys, nil
+	case EntityKeyTypeTopicAdmin, EntityKeyTypeTopicSubmit:
+		topicID, err := TopicIDFromString(entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		info, err := _KeyResolverCached(&resolver._KeyResolverCache, "topic "+entity.ID, func() (TopicInfo, error) {
+			return NewTopicInfoQuery().SetTopicID(topicID).ExecuteWithContext(ctx, resolver.client)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if entity.Type == EntityKeyTypeTopicAdmin {
+			return info.AdminKey, nil
+		}
+		return info.SubmitKey, nil
+	case EntityKeyTypeContractAdmin:
+		contractID, err := ContractIDFromString(entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		info, err := _KeyResolverCached(&resolver._KeyResolverCache, "contract "+entity.ID, func() (ContractInfo, error) {
+			return NewContractInfoQuery().SetContractID(contractID).ExecuteWithContext(ctx, resolver.client)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return info.AdminKey, nil
+	case EntityKeyTypeScheduleAdmin:
+		scheduleID, err := ScheduleIDFromString(entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		info, err := _KeyResolverCached(&resolver._KeyResolverCache, "schedule "+entity.ID, func() (ScheduleInfo, error) {
+			return NewScheduleInfoQuery().SetScheduleID(scheduleID).ExecuteWithContext(ctx, resolver.client)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return info.AdminKey, nil
+	default:
+		return nil, ErrKeyNotResolved{Entity: entity}
+	}
+}
+
+// MirrorKeyResolver resolves keys with the mirror node REST API.
+// Every entity is fetched once and cached for the lifetime of the resolver. The mirror node does not expose file keys,
+// use a NetworkKeyResolver for them.
+type MirrorKeyResolver struct {
+	_KeyResolverCache
+	mirror *MirrorRestClient
+}
+
+// NewMirrorKeyResolver creates a MirrorKeyResolver which queries mirror.
+func NewMirrorKeyResolver(mirror *MirrorRestClient) *MirrorKeyResolver {
+	return &MirrorKeyResolver{
+		_KeyResolverCache: _KeyResolverCache{entries: make(map[string]any)},
+		mirror:            mirror,
+	}
+}
+
+// ResolveKey implements KeyResolver
+func (resolver *MirrorKeyResolver) ResolveKey(ctx context.Context, entity EntityKey) (Key, error) {
+	switch entity.Type {
+	case EntityKeyTypeAccount, EntityKeyTypeAccountReceiver:
+		account, err := _KeyResolverCached(&resolver._KeyResolverCache, "account "+entity.ID, func() (MirrorAccount, error) {
+			return resolver.mirror.GetAccount(ctx, entity.ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return _AccountKeyFor(entity.Type, account.Key, account.ReceiverSignatureRequired), nil
+	case EntityKeyTypeTokenAdmin, EntityKeyTypeTokenKyc, EntityKeyTypeTokenFreeze, EntityKeyTypeTokenWipe,
+		EntityKeyTypeTokenSupply, EntityKeyTypeTokenFeeSchedule, EntityKeyTypeTokenPause, EntityKeyTypeTokenMetadata:
+		tokenID, err := TokenIDFromString(entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		token, err := _KeyResolverCached(&resolver._KeyResolverCache, "token "+entity.ID, func() (MirrorToken, error) {
+			return resolver.mirror.GetToken(ctx, tokenID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return _TokenKeyFor(entity.Type, token.AdminKey, token.KycKey, token.FreezeKey, token.WipeKey, token.SupplyKey,
+			token.FeeScheduleKey, token.PauseKey, token.MetadataKey), nil
+	case EntityKeyTypeTopicAdmin, EntityKeyTypeTopicSubmit:
+		topicID, err := TopicIDFromString(entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		topic, err := _KeyResolverCached(&resolver._KeyResolverCache, "topic "+entity.ID, func() (MirrorTopic, error) {
+			return resolver.mirror.GetTopic(ctx, topicID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if entity.Type == EntityKeyTypeTopicAdmin {
+			return topic.AdminKey, nil
+		}
+		return topic.SubmitKey, nil
+	case EntityKeyTypeContractAdmin:
+		contract, err := _KeyResolverCached(&resolver._KeyResolverCache, "contract "+entity.ID, func() (MirrorContract, error) {
+			return resolver.mirror.GetContract(ctx, entity.ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return contract.AdminKey, nil
+	case EntityKeyTypeScheduleAdmin:
+		scheduleID, err := ScheduleIDFromString(entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		schedule, err := _KeyResolverCached(&resolver._KeyResolverCache, "schedule "+entity.ID, func() (MirrorSchedule, error) {
+			return resolver.mirror.GetSchedule(ctx, scheduleID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return schedule.AdminKey, nil
+	case EntityKeyTypeNodeAdmin:
+		node, err := _KeyResolverCached(&resolver._KeyResolverCache, "node "+entity.ID, func() (MirrorNetworkNode, error) {
+			params := NewMirrorRestParams().Add("node.id", "eq:"+entity.ID)
+			nodes, err := MirrorRestCollect(resolver.mirror.NetworkNodes(ctx, params))
+			if err != nil {
+				return MirrorNetworkNode{}, err
+			}
+			if len(nodes) == 0 {
+				return MirrorNetworkNode{}, ErrKeyNotResolved{Entity: entity}
+			}
+			return nodes[0], nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return node.AdminKey, nil
+	default:
+		return nil, ErrKeyNotResolved{Entity: entity}
+	}
+}
+
+// _KeyResolverCache caches the entities fetched by a resolver. Failed fetches are not cached.
+type _KeyResolverCache struct {
+	mutex   sync.Mutex
+	entries map[string]any
+}
+
+func _KeyResolverCached[T any](cache *_KeyResolverCache, id string, fetch func() (T, error)) (T, error) {
+	cache.mutex.Lock()
+	cached, ok := cache.entries[id]
+	cache.mutex.Unlock()
+	if ok {
+		return cached.(T), nil
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+
+	cache.mutex.Lock()
+	cache.entries[id] = value
+	cache.mutex.Unlock()
+
+	return value, nil
+}
+
+func _AccountKeyFor(keyType EntityKeyType, key Key, receiverSignatureRequired bool) Key {
+	if keyType == EntityKeyTypeAccountReceiver && !receiverSignatureRequired {
+		return nil
+	}
+
+	return key
+}
+
+func _TokenKeyFor(keyType EntityKeyType, admin, kyc, freeze, wipe, supply, feeSchedule, pause, metadata Key) Key {
+	switch keyType {
+	case EntityKeyTypeTokenAdmin:
+		return admin
+	case EntityKeyTypeTokenKyc:
+		return kyc
+	case EntityKeyTypeTokenFreeze:
+		return freeze
+	case EntityKeyTypeTokenWipe:
+		return wipe
+	case EntityKeyTypeTokenSupply:
+		return supply
+	case EntityKeyTypeTokenFeeSchedule:
+		return feeSchedule
+	case EntityKeyTypeTokenPause:
+		return pause
+	case EntityKeyTypeTokenMetadata:
+		return metadata
+	default:
+		return nil
+	}
+}
@@ -25,6 +25,7 @@ type _ManagedNetwork struct {
 	minNodeReadmitPeriod   time.Duration
 	maxNodeReadmitPeriod   time.Duration
 	earliestReadmitTime    time.Time
+	telemetry              *_Telemetry
 }
 
 func _NewManagedNetwork() _ManagedNetwork {
@@ -84,6 +85,12 @@ func (mn *_ManagedNetwork) _SetNetwork(network map[string]_IManagedNode) error {
 		newNodes = append(newNodes, value)
 	}
 
+	for _, node := range newNodes {
+		if managed := node._GetManagedNode(); managed != nil {
+			managed._SetTelemetry(mn.telemetry)
+		}
+	}
+
 	newNetwork, newHealthyNodes := _CreateNetworkFromNodes(newNodes)
 
 	mn.nodes = newNodes
@@ -293,6 +300,16 @@ func (mn *_ManagedNetwork) _SetVerifyCertificate(verify bool) *_ManagedNetwork {
 	return mn
 }
 
+func (mn *_ManagedNetwork) _SetTelemetry(telemetry *_Telemetry) {
+	for _, node := range mn.nodes {
+		if managed := node._GetManagedNode(); managed != nil {
+			managed._SetTelemetry(telemetry)
+		}
+	}
+
+	mn.telemetry = telemetry
+}
+
 func (mn *_ManagedNetwork) _GetVerifyCertificate() bool {
 	return mn.verifyCertificate
 }
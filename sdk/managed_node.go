@@ -40,9 +40,17 @@ type _ManagedNode struct {
 	maxBackoff         time.Duration
 	badGrpcStatusCount int64
 	readmitTime        *time.Time
+	unhealthy          bool
+	averageLatency     time.Duration
+	lastError          error
+	lastErrorTime      *time.Time
+	telemetry          *_Telemetry
 	mutex              sync.RWMutex
 }
 
+// latencyWeight is the weight of the newest response time in the moving average latency of a node
+const latencyWeight = 0.2
+
 func (node *_ManagedNode) _GetAttempts() int64 {
 	node.mutex.RLock()
 	defer node.mutex.RUnlock()
@@ -126,6 +134,11 @@ func (node *_ManagedNode) _IncreaseBackoff() {
 	}
 	readmitTime := time.Now().Add(node.currentBackoff)
 	node.readmitTime = &readmitTime
+
+	if !node.unhealthy {
+		node.unhealthy = true
+		node.telemetry._RecordHealthTransition(node._GetAddress(), false)
+	}
 }
 
 func (node *_ManagedNode) _DecreaseBackoff() {
@@ -136,6 +149,48 @@ func (node *_ManagedNode) _DecreaseBackoff() {
 	if node.currentBackoff < node.minBackoff {
 		node.currentBackoff = node.minBackoff
 	}
+
+	// A successful request after the node was marked unhealthy means it recovered
+	if node.unhealthy {
+		node.unhealthy = false
+		node.telemetry._RecordHealthTransition(node._GetAddress(), true)
+	}
+}
+
+// _RecordLatency adds the response time of a successful request to the moving average latency of the node
+func (node *_ManagedNode) _RecordLatency(latency time.Duration) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	if node.averageLatency == 0 {
+		node.averageLatency = latency
+		return
+	}
+
+	node.averageLatency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(node.averageLatency))
+}
+
+func (node *_ManagedNode) _GetAverageLatency() time.Duration {
+	node.mutex.RLock()
+	defer node.mutex.RUnlock()
+	return node.averageLatency
+}
+
+// _RecordError records the last error returned by the node or encountered while connecting to it
+func (node *_ManagedNode) _RecordError(err error) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	now := time.Now()
+	node.lastError = err
+	node.lastErrorTime = &now
+}
+
+func (node *_ManagedNode) _SetTelemetry(telemetry *_Telemetry) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.telemetry = telemetry
 }
 
 func (node *_ManagedNode) _Wait() time.Duration {
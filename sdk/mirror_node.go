@@ -142,7 +142,7 @@ func (node *_MirrorNode) _GetReadmitTime() *time.Time {
 	return node._ManagedNode._GetReadmitTime()
 }
 
-func (node *_MirrorNode) _GetConsensusServiceClient() (mirror.ConsensusServiceClient, error) {
+func (node *_MirrorNode) _GetConsensusServiceClient(interceptors *_Interceptors) (mirror.ConsensusServiceClient, error) {
 	if node.consensusServiceClient != nil {
 		return node.consensusServiceClient, nil
 	} else if node.client != nil {
@@ -165,7 +165,9 @@ func (node *_MirrorNode) _GetConsensusServiceClient() (mirror.ConsensusServiceCl
 		security = grpc.WithTransportCredentials(insecure.NewCredentials())
 	}
 
-	conn, err := grpc.NewClient(node._ManagedNode.address._String(), security, grpc.WithKeepaliveParams(kacp))
+	options := append([]grpc.DialOption{security, grpc.WithKeepaliveParams(kacp)}, interceptors._DialOptions()...)
+
+	conn, err := grpc.NewClient(node._ManagedNode.address._String(), options...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error connecting to mirror at %s", node._ManagedNode.address._String())
 	}
@@ -177,7 +179,7 @@ func (node *_MirrorNode) _GetConsensusServiceClient() (mirror.ConsensusServiceCl
 	return node.consensusServiceClient, nil
 }
 
-func (node *_MirrorNode) _GetNetworkServiceClient() (mirror.NetworkServiceClient, error) {
+func (node *_MirrorNode) _GetNetworkServiceClient(interceptors *_Interceptors) (mirror.NetworkServiceClient, error) {
 	if node.networkServiceClient != nil {
 		return node.networkServiceClient, nil
 	} else if node.client != nil {
@@ -200,7 +202,9 @@ func (node *_MirrorNode) _GetNetworkServiceClient() (mirror.NetworkServiceClient
 		security = grpc.WithTransportCredentials(insecure.NewCredentials())
 	}
 
-	conn, err := grpc.NewClient(node._ManagedNode.address._String(), security, grpc.WithKeepaliveParams(kacp))
+	options := append([]grpc.DialOption{security, grpc.WithKeepaliveParams(kacp)}, interceptors._DialOptions()...)
+
+	conn, err := grpc.NewClient(node._ManagedNode.address._String(), options...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error connecting to mirror at %s", node._ManagedNode.address._String())
 	}
@@ -221,6 +225,11 @@ func (node *_MirrorNode) _ToSecure() _IManagedNode {
 		useCount:           node.useCount,
 		minBackoff:         node.minBackoff,
 		badGrpcStatusCount: node.badGrpcStatusCount,
+		unhealthy:          node.unhealthy,
+		averageLatency:     node.averageLatency,
+		lastError:          node.lastError,
+		lastErrorTime:      node.lastErrorTime,
+		telemetry:          node.telemetry,
 	}
 
 	return &_MirrorNode{
@@ -239,6 +248,11 @@ func (node *_MirrorNode) _ToInsecure() _IManagedNode {
 		useCount:           node.useCount,
 		minBackoff:         node.minBackoff,
 		badGrpcStatusCount: node.badGrpcStatusCount,
+		unhealthy:          node.unhealthy,
+		averageLatency:     node.averageLatency,
+		lastError:          node.lastError,
+		lastErrorTime:      node.lastErrorTime,
+		telemetry:          node.telemetry,
 	}
 
 	return &_MirrorNode{
@@ -265,36 +279,65 @@ type recvStream[T any] interface {
 // processProtoMessageStream is a generic method that works with any gRPC client stream
 // that implements the RecvStream interface. This allows it to work with any stream type
 // such as mirror.NetworkService_GetNodesClient, mirror.ConsensusService_SubscribeTopicClient, etc.
-func processProtoMessageStream[T any](ctx context.Context, stream recvStream[T], attempt uint64, maxAttempts uint64, retryHandler func(err error) bool) <-chan streamResult[T] {
+// A failed stream cannot be received from again, so when the retry handler accepts an error the stream is replaced
+// with a new one from resubscribe, which is given the last received message and the number of messages received so
+// far in order to resume after them. Once ctx is done nothing more is sent, so the goroutine never blocks on a
+// consumer which stopped receiving; consumers have to check ctx when the channel is closed.
+func processProtoMessageStream[T any](
+	ctx context.Context,
+	stream recvStream[T],
+	resubscribe func(last T, received uint64) (recvStream[T], error),
+	attempt uint64,
+	maxAttempts uint64,
+	retryHandler func(err error) bool,
+) <-chan streamResult[T] {
 	resultStream := make(chan streamResult[T])
 
+	// send reports whether the result was received before ctx was done
+	send := func(result streamResult[T]) bool {
+		select {
+		case resultStream <- result:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
 	go func() {
 		var zero T
+		var last T
+		var received uint64
 		var err error
 		defer close(resultStream)
 		for {
-			select {
-			case <-ctx.Done():
-				resultStream <- streamResult[T]{zero, ctx.Err()}
+			if ctx.Err() != nil {
 				return
-			default:
 			}
 			if err != nil {
 				if err == io.EOF {
 					return
 				}
 				if !retryHandler(err) { // should we retry?
-					resultStream <- streamResult[T]{zero, err}
+					send(streamResult[T]{zero, err})
 					return
 				}
 				if attempt < maxAttempts { // do we have attempts left?
 					delay := math.Min(250.0*math.Pow(2.0, float64(attempt)), 8000)
-					time.Sleep(time.Duration(delay) * time.Millisecond)
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(time.Duration(delay) * time.Millisecond):
+					}
 					attempt++
 				} else { // max attempts reached
-					resultStream <- streamResult[T]{zero, errors.Wrap(err, "max attempts reached")}
+					send(streamResult[T]{zero, errors.Wrap(err, "max attempts reached")})
 					return
 				}
+
+				stream, err = resubscribe(last, received)
+				if err != nil {
+					continue
+				}
 			}
 
 			resp, e := stream.Recv()
@@ -302,7 +345,11 @@ func processProtoMessageStream[T any](ctx context.Context, stream recvStream[T],
 				err = e
 				continue
 			}
-			resultStream <- streamResult[T]{resp, nil}
+			last = resp
+			received++
+			if !send(streamResult[T]{resp, nil}) {
+				return
+			}
 		}
 	}()
 
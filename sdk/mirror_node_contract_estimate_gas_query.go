@@ -2,6 +2,8 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
+import "context"
+
 // MirrorNodeContractEstimateGasQuery returns a result from EVM gas estimation of read-write operations.
 type MirrorNodeContractEstimateGasQuery struct {
 	mirrorNodeContractQuery
@@ -72,5 +74,10 @@ func (mirrorNodeEstimateGasQuery *MirrorNodeContractEstimateGasQuery) SetGasPric
 
 // Returns gas estimation for the EVM execution
 func (mirrorNodeEstimateGasQuery *MirrorNodeContractEstimateGasQuery) Execute(client *Client) (uint64, error) {
-	return mirrorNodeEstimateGasQuery.estimateGas(client)
+	return mirrorNodeEstimateGasQuery.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext does the same as Execute, returning ctx.Err() if ctx is cancelled before the mirror node answers.
+func (mirrorNodeEstimateGasQuery *MirrorNodeContractEstimateGasQuery) ExecuteWithContext(ctx context.Context, client *Client) (uint64, error) {
+	return mirrorNodeEstimateGasQuery.estimateGas(ctx, client)
 }
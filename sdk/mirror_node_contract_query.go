@@ -1,11 +1,10 @@
 package hiero
 
 import (
-	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -122,7 +121,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) GetBlockNumber() int64 {
 }
 
 // Returns gas estimation for the EVM execution
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(client *Client) (uint64, error) {
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(ctx context.Context, client *Client) (uint64, error) {
 	err := mirrorNodeContractQuery.fillEvmAddresses()
 	if err != nil {
 		return 0, err
@@ -133,7 +132,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(client *Clie
 		return 0, err
 	}
 
-	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(client, jsonPayload)
+	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(ctx, client, jsonPayload)
 	if err != nil {
 		return 0, err
 	}
@@ -151,7 +150,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(client *Clie
 }
 
 // Does transient simulation of read-write operations and returns the result in hexadecimal string format. The result can be any solidity type.
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) call(client *Client) (string, error) {
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) call(ctx context.Context, client *Client) (string, error) {
 	err := mirrorNodeContractQuery.fillEvmAddresses()
 	if err != nil {
 		return "", err
@@ -168,7 +167,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) call(client *Client) (st
 		return "", err
 	}
 
-	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(client, jsonPayload)
+	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(ctx, client, jsonPayload)
 	if err != nil {
 		return "", err
 	}
@@ -199,35 +198,20 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) fillEvmAddresses() error
 	return nil
 }
 
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) performContractCallToMirrorNode(client *Client, jsonPayload string) (map[string]any, error) {
-	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
-		return nil, errors.New("mirror node is not set")
-	}
-	mirrorUrl, err := client.GetMirrorRestApiBaseUrl()
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) performContractCallToMirrorNode(ctx context.Context, client *Client, jsonPayload string) (map[string]any, error) {
+	restClient, err := client.GetMirrorRestClient()
 	if err != nil {
 		return nil, err
 	}
 
+	mirrorUrl := restClient.GetBaseURL()
 	isLocalHost := strings.Contains(mirrorUrl, "localhost") || strings.Contains(mirrorUrl, "127.0.0.1")
-	if isLocalHost {
-		mirrorUrl = "http://localhost:8545/api/v1"
-	}
-
-	mirrorUrl = fmt.Sprintf("%s/contracts/call", mirrorUrl)
-
-	resp, err := http.Post(mirrorUrl, "application/json", bytes.NewBuffer([]byte(jsonPayload))) // #nosec
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("received non-200 response from Mirror Node: %d, details: %s", resp.StatusCode, body)
+	if isLocalHost && client.mirrorRestApiBaseUrl == "" {
+		restClient.baseURL = "http://localhost:8545/api/v1"
 	}
 
 	var result map[string]any
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := restClient._Do(ctx, http.MethodPost, restClient._URL("/contracts/call", nil), []byte(jsonPayload), &result); err != nil {
 		return nil, err
 	}
 	return result, nil
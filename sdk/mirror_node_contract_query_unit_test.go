@@ -5,6 +5,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -127,7 +128,7 @@ func TestMirrorNodeContractQuerySetAndGetGasPrice(t *testing.T) {
 func TestMirrorNodeContractQueryEstimateGasWithMissingContractIDOrEvmAddressThrowsException(t *testing.T) {
 	query1 := &mirrorNodeContractQuery{}
 	query1.setFunction("testFunction", NewContractFunctionParameters().AddString("params"))
-	_, err1 := query1.estimateGas(nil)
+	_, err1 := query1.estimateGas(context.Background(), nil)
 	require.Error(t, err1)
 
 	query2 := NewMirrorNodeContractEstimateGasQuery()
@@ -318,3 +319,29 @@ func TestUnitMirrorNodeContractQueryWithDifferentPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitMirrorNodeContractQueryExecuteWithContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be sent with a cancelled context")
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestApiBaseUrl(server.URL + "/api/v1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = NewMirrorNodeContractCallQuery().
+		SetContractEvmAddress("0x742d35Cc6634C0532925a3b844Bc454e4438f44e").
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = NewMirrorNodeContractEstimateGasQuery().
+		SetContractEvmAddress("0x742d35Cc6634C0532925a3b844Bc454e4438f44e").
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.Canceled)
+}
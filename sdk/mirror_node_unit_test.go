@@ -5,6 +5,8 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -209,3 +211,53 @@ func TestUnitMirrorNodeClose(t *testing.T) {
 	err = node._Close()
 	assert.NoError(t, err)
 }
+
+// _TestRecvStream returns its error, or an incrementing value when it has none.
+type _TestRecvStream struct {
+	err  error
+	next int
+}
+
+func (stream *_TestRecvStream) Recv() (int, error) {
+	if stream.err != nil {
+		return 0, stream.err
+	}
+
+	stream.next++
+	return stream.next, nil
+}
+
+func TestUnitMirrorNodeMessageStreamStopsWhenContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	errUnavailable := errors.New("unavailable")
+	resubscribe := func(stream recvStream[int]) func(int, uint64) (recvStream[int], error) {
+		return func(int, uint64) (recvStream[int], error) {
+			return stream, nil
+		}
+	}
+
+	for name, stream := range map[string]*_TestRecvStream{
+		"receiving": {},
+		"backoff":   {err: errUnavailable},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			results := processProtoMessageStream[int](ctx, stream, resubscribe(stream), 0, 10, func(error) bool { return true })
+
+			// the consumer stops receiving, as an unsubscribed topic query does
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+			time.Sleep(50 * time.Millisecond)
+
+			select {
+			case _, ok := <-results:
+				assert.False(t, ok)
+			case <-time.After(time.Second):
+				t.Fatal("stream was not closed")
+			}
+		})
+	}
+}
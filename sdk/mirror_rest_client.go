@@ -0,0 +1,374 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MirrorRestClient is a typed client for the mirror node REST API.
+// List endpoints are exposed as iterators which follow `links.next` until the mirror node reports no more pages.
+// Failed requests (network errors, HTTP 429 and 5xx) are retried using the same exponential backoff settings as the
+// gRPC execution path.
+type MirrorRestClient struct {
+	baseURL     string
+	httpClient  *http.Client
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	maxAttempts int
+}
+
+// ErrMirrorRestStatus is returned when the mirror node REST API responds with a non-2xx status code.
+type ErrMirrorRestStatus struct {
+	StatusCode int
+	URL        string
+	Body       string
+}
+
+func (e ErrMirrorRestStatus) Error() string {
+	return fmt.Sprintf("received non-200 response from Mirror Node: %d, details: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound returns true if the mirror node reported that the requested entity does not exist.
+func (e ErrMirrorRestStatus) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// MirrorRestParams holds the query string parameters of a mirror node REST request,
+// e.g. `limit`, `order` or filters such as `account.id=gte:0.0.1000`.
+type MirrorRestParams struct {
+	values url.Values
+}
+
+// NewMirrorRestParams creates an empty set of mirror node REST query parameters.
+func NewMirrorRestParams() *MirrorRestParams {
+	return &MirrorRestParams{
+		values: url.Values{},
+	}
+}
+
+// SetLimit sets the maximum number of items the mirror node returns per page.
+func (params *MirrorRestParams) SetLimit(limit int) *MirrorRestParams {
+	params.values.Set("limit", strconv.Itoa(limit))
+	return params
+}
+
+// SetOrder sets the sort order of the results, either "asc" or "desc".
+func (params *MirrorRestParams) SetOrder(order string) *MirrorRestParams {
+	params.values.Set("order", order)
+	return params
+}
+
+// Add appends a raw query parameter, e.g. Add("timestamp", "gte:1700000000.000000000").
+// A parameter may be added more than once to combine filters.
+func (params *MirrorRestParams) Add(key string, value string) *MirrorRestParams {
+	params.values.Add(key, value)
+	return params
+}
+
+func (params *MirrorRestParams) _Encode() string {
+	if params == nil {
+		return ""
+	}
+
+	return params.values.Encode()
+}
+
+type _MirrorRestLinks struct {
+	Next *string `json:"next"`
+}
+
+// NewMirrorRestClient creates a mirror node REST client for the given base URL, e.g.
+// "https://testnet.mirrornode.hedera.com/api/v1".
+func NewMirrorRestClient(baseURL string) *MirrorRestClient {
+	return &MirrorRestClient{
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		httpClient:  http.DefaultClient,
+		minBackoff:  250 * time.Millisecond,
+		maxBackoff:  8 * time.Second,
+		maxAttempts: 10,
+	}
+}
+
+// GetMirrorRestClient returns a mirror node REST client for the client's mirror network,
+// configured with the client's backoff and max attempts settings.
+func (client *Client) GetMirrorRestClient() (*MirrorRestClient, error) {
+	if client.mirrorRestApiBaseUrl == "" && (client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0) {
+		return nil, errMirrorNodeNotSet
+	}
+
+	baseURL, err := client.GetMirrorRestApiBaseUrl()
+	if err != nil {
+		return nil, err
+	}
+
+	restClient := NewMirrorRestClient(baseURL)
+	restClient.minBackoff = client.GetMinBackoff()
+	restClient.maxBackoff = client.GetMaxBackoff()
+	if maxAttempts := client.GetMaxAttempts(); maxAttempts > 0 {
+		restClient.maxAttempts = maxAttempts
+	}
+
+	return restClient, nil
+}
+
+// GetBaseURL returns the base URL all request paths are relative to.
+func (c *MirrorRestClient) GetBaseURL() string {
+	return c.baseURL
+}
+
+// SetHTTPClient sets the HTTP client used to send requests.
+func (c *MirrorRestClient) SetHTTPClient(httpClient *http.Client) *MirrorRestClient {
+	c.httpClient = httpClient
+	return c
+}
+
+// GetHTTPClient returns the HTTP client used to send requests.
+func (c *MirrorRestClient) GetHTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// SetMinBackoff sets the minimum amount of time to wait between retries.
+func (c *MirrorRestClient) SetMinBackoff(min time.Duration) *MirrorRestClient {
+	if min.Nanoseconds() < 0 {
+		panic("minBackoff must be a positive duration")
+	} else if c.maxBackoff.Nanoseconds() < min.Nanoseconds() {
+		panic("minBackoff must be less than or equal to maxBackoff")
+	}
+	c.minBackoff = min
+	return c
+}
+
+// GetMinBackoff returns the minimum amount of time to wait between retries.
+func (c *MirrorRestClient) GetMinBackoff() time.Duration {
+	return c.minBackoff
+}
+
+// SetMaxBackoff sets the maximum amount of time to wait between retries.
+func (c *MirrorRestClient) SetMaxBackoff(max time.Duration) *MirrorRestClient {
+	if max.Nanoseconds() < 0 {
+		panic("maxBackoff must be a positive duration")
+	} else if max.Nanoseconds() < c.minBackoff.Nanoseconds() {
+		panic("maxBackoff must be greater than or equal to minBackoff")
+	}
+	c.maxBackoff = max
+	return c
+}
+
+// GetMaxBackoff returns the maximum amount of time to wait between retries.
+func (c *MirrorRestClient) GetMaxBackoff() time.Duration {
+	return c.maxBackoff
+}
+
+// SetMaxAttempts sets the maximum number of times a request is sent before giving up.
+func (c *MirrorRestClient) SetMaxAttempts(max int) *MirrorRestClient {
+	c.maxAttempts = max
+	return c
+}
+
+// GetMaxAttempts returns the maximum number of times a request is sent before giving up.
+func (c *MirrorRestClient) GetMaxAttempts() int {
+	return c.maxAttempts
+}
+
+func (c *MirrorRestClient) _URL(path string, params *MirrorRestParams) string {
+	requestURL := c.baseURL + path
+	if query := params._Encode(); query != "" {
+		requestURL += "?" + query
+	}
+
+	return requestURL
+}
+
+// _ResolveNext turns a `links.next` value, which is an absolute path such as "/api/v1/accounts?limit=25&account.id=gt:0.0.10",
+// into a full URL on the same host as the base URL.
+func (c *MirrorRestClient) _ResolveNext(next string) (string, error) {
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+
+	return base.ResolveReference(ref).String(), nil
+}
+
+func (c *MirrorRestClient) get(ctx context.Context, path string, params *MirrorRestParams, out any) error {
+	return c._Do(ctx, http.MethodGet, c._URL(path, params), nil, out)
+}
+
+func (c *MirrorRestClient) post(ctx context.Context, path string, body any, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	return c._Do(ctx, http.MethodPost, c._URL(path, nil), payload, out)
+}
+
+func (c *MirrorRestClient) _Do(ctx context.Context, method string, requestURL string, payload []byte, out any) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	maxAttempts := c.maxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	backoff := c.minBackoff
+	var errPersistent error
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+
+			if backoff*2 <= c.maxBackoff {
+				backoff *= 2
+			} else {
+				backoff = c.maxBackoff
+			}
+		}
+
+		retry, err := c._Attempt(ctx, method, requestURL, payload, out)
+		if err == nil {
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		errPersistent = err
+		if !retry {
+			return err
+		}
+	}
+
+	return errPersistent
+}
+
+func (c *MirrorRestClient) _Attempt(ctx context.Context, method string, requestURL string, payload []byte, out any) (bool, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, body)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// an unknown host will not start resolving between attempts
+		var dnsErr *net.DNSError
+		retry := !(errors.As(err, &dnsErr) && dnsErr.IsNotFound)
+		return retry, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		details, _ := io.ReadAll(resp.Body)
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return retry, ErrMirrorRestStatus{
+			StatusCode: resp.StatusCode,
+			URL:        requestURL,
+			Body:       string(details),
+		}
+	}
+
+	if out == nil {
+		return false, nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return false, fmt.Errorf("failed to decode mirror node response: %w", err)
+	}
+
+	return false, nil
+}
+
+// _MirrorRestList iterates over every item of a paginated mirror node endpoint. `extract` converts a decoded page
+// of type P into SDK items and returns the page's `links.next` value.
+func _MirrorRestList[P any, T any](
+	ctx context.Context,
+	c *MirrorRestClient,
+	path string,
+	params *MirrorRestParams,
+	extract func(*P) ([]T, *string, error),
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		requestURL := c._URL(path, params)
+
+		for requestURL != "" {
+			var page P
+			if err := c._Do(ctx, http.MethodGet, requestURL, nil, &page); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, next, err := extract(&page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if next == nil || *next == "" {
+				return
+			}
+
+			requestURL, err = c._ResolveNext(*next)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+		}
+	}
+}
+
+// MirrorRestCollect drains a mirror node iterator into a slice, stopping at the first error.
+func MirrorRestCollect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	items := make([]T, 0)
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,275 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewTestMirrorRestClient(server *httptest.Server) *MirrorRestClient {
+	return NewMirrorRestClient(server.URL + "/api/v1").
+		SetMinBackoff(time.Millisecond).
+		SetMaxBackoff(5 * time.Millisecond).
+		SetMaxAttempts(3)
+}
+
+func TestUnitMirrorRestClientGetAccount(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/accounts/0.0.1001", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"account": "0.0.1001",
+			"alias": null,
+			"auto_renew_period": 7776000,
+			"balance": {
+				"balance": 150000000,
+				"timestamp": "1700000000.123456789",
+				"tokens": [{"token_id": "0.0.2002", "balance": 42}]
+			},
+			"created_timestamp": "1690000000.000000001",
+			"decline_reward": false,
+			"deleted": false,
+			"ethereum_nonce": 3,
+			"evm_address": "0x00000000000000000000000000000000000003e9",
+			"expiry_timestamp": "1800000000.000000000",
+			"key": {"_type": "ED25519", "key": "2b60955bcbf0cf5e9ea880b52e5b63f664b08edf6ed15e301049517438d61864"},
+			"max_automatic_token_associations": 10,
+			"memo": "hello",
+			"pending_reward": 7,
+			"receiver_sig_required": true,
+			"staked_account_id": null,
+			"staked_node_id": 3
+		}`))
+	}))
+	defer server.Close()
+
+	account, err := _NewTestMirrorRestClient(server).GetAccountByID(context.Background(), AccountID{Account: 1001})
+	require.NoError(t, err)
+
+	assert.Equal(t, AccountID{Account: 1001}, account.AccountID)
+	assert.Equal(t, HbarFromTinybar(150000000), account.Balance)
+	assert.Equal(t, time.Unix(1700000000, 123456789), account.BalanceTimestamp)
+	require.Len(t, account.TokenBalances, 1)
+	assert.Equal(t, TokenID{Token: 2002}, account.TokenBalances[0].TokenID)
+	assert.Equal(t, uint64(42), account.TokenBalances[0].Balance)
+	assert.Equal(t, 90*24*time.Hour, account.AutoRenewPeriod)
+	assert.Equal(t, "hello", account.Memo)
+	assert.True(t, account.ReceiverSignatureRequired)
+	assert.Equal(t, int64(3), *account.StakedNodeID)
+	assert.Nil(t, account.StakedAccountID)
+	assert.Equal(t, int32(10), account.MaxAutomaticTokenAssociations)
+	assert.Equal(t, HbarFromTinybar(7), account.PendingReward)
+	assert.Equal(t, int64(3), account.EthereumNonce)
+
+	key, ok := account.Key.(PublicKey)
+	require.True(t, ok)
+	assert.Equal(t, "2b60955bcbf0cf5e9ea880b52e5b63f664b08edf6ed15e301049517438d61864", key.StringRaw())
+}
+
+func TestUnitMirrorRestClientPaginatesLinksNext(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "/api/v1/accounts/0.0.5/nfts", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Query().Get("serialnumber") == "" {
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			_, _ = w.Write([]byte(`{
+				"nfts": [
+					{"token_id": "0.0.100", "serial_number": 1, "account_id": "0.0.5", "metadata": "aGVsbG8="},
+					{"token_id": "0.0.100", "serial_number": 2, "account_id": "0.0.5", "metadata": ""}
+				],
+				"links": {"next": "/api/v1/accounts/0.0.5/nfts?limit=2&serialnumber=lt:2"}
+			}`))
+			return
+		}
+
+		assert.Equal(t, "lt:2", r.URL.Query().Get("serialnumber"))
+		_, _ = w.Write([]byte(`{
+			"nfts": [
+				{"token_id": "0.0.101", "serial_number": 9, "account_id": "0.0.5", "spender": "0.0.7", "metadata": ""}
+			],
+			"links": {"next": null}
+		}`))
+	}))
+	defer server.Close()
+
+	nfts, err := MirrorRestCollect(_NewTestMirrorRestClient(server).
+		AccountNfts(context.Background(), AccountID{Account: 5}, NewMirrorRestParams().SetLimit(2)))
+	require.NoError(t, err)
+
+	require.Len(t, nfts, 3)
+	assert.Equal(t, int32(2), requests.Load())
+	assert.Equal(t, NftID{TokenID: TokenID{Token: 100}, SerialNumber: 1}, nfts[0].NftID)
+	assert.Equal(t, []byte("hello"), nfts[0].Metadata)
+	assert.Equal(t, NftID{TokenID: TokenID{Token: 101}, SerialNumber: 9}, nfts[2].NftID)
+	assert.Equal(t, AccountID{Account: 7}, *nfts[2].SpenderID)
+}
+
+func TestUnitMirrorRestClientIteratorStopsEarly(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"balances": [
+				{"account": "0.0.1", "balance": 1, "tokens": []},
+				{"account": "0.0.2", "balance": 2, "tokens": []}
+			],
+			"links": {"next": "/api/v1/balances?account.id=gt:0.0.2"}
+		}`))
+	}))
+	defer server.Close()
+
+	count := 0
+	for balance, err := range _NewTestMirrorRestClient(server).Balances(context.Background(), nil) {
+		require.NoError(t, err)
+		assert.Equal(t, AccountID{Account: 1}, balance.AccountID)
+		count++
+		break
+	}
+
+	assert.Equal(t, 1, count)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestUnitMirrorRestClientRetriesServerErrors(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"released_supply": "5000000000000000000", "total_supply": "5000000000000000000", "timestamp": "1700000000.000000000"}`))
+	}))
+	defer server.Close()
+
+	supply, err := _NewTestMirrorRestClient(server).GetNetworkSupply(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), requests.Load())
+	assert.Equal(t, HbarFromTinybar(5000000000000000000), supply.TotalSupply)
+}
+
+func TestUnitMirrorRestClientDoesNotRetryNotFound(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"_status": {"messages": [{"message": "Not found"}]}}`))
+	}))
+	defer server.Close()
+
+	_, err := _NewTestMirrorRestClient(server).GetToken(context.Background(), TokenID{Token: 404})
+	require.Error(t, err)
+
+	var statusErr ErrMirrorRestStatus
+	require.ErrorAs(t, err, &statusErr)
+	assert.True(t, statusErr.IsNotFound())
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestUnitMirrorRestClientGetTransaction(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/transactions/0.0.2-1700000000-000000123", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"transactions": [{
+				"transaction_id": "0.0.2-1700000000-000000123",
+				"consensus_timestamp": "1700000001.5",
+				"name": "CRYPTOTRANSFER",
+				"result": "SUCCESS",
+				"node": "0.0.3",
+				"charged_tx_fee": 84000,
+				"max_fee": "100000000",
+				"memo_base64": "bWVtbw==",
+				"transaction_hash": "AQID",
+				"valid_duration_seconds": "120",
+				"transfers": [{"account": "0.0.2", "amount": -10, "is_approval": false}, {"account": "0.0.3", "amount": 10, "is_approval": false}],
+				"token_transfers": [{"token_id": "0.0.9", "account": "0.0.2", "amount": -1, "is_approval": true}],
+				"nft_transfers": [{"token_id": "0.0.8", "serial_number": 4, "sender_account_id": "0.0.2", "receiver_account_id": "0.0.3", "is_approval": false}]
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	transactionID := NewTransactionIDWithValidStart(AccountID{Account: 2}, time.Unix(1700000000, 123))
+	transactions, err := _NewTestMirrorRestClient(server).GetTransaction(context.Background(), transactionID)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+
+	transaction := transactions[0]
+	assert.Equal(t, transactionID.String(), transaction.TransactionID.String())
+	assert.Equal(t, time.Unix(1700000001, 500000000), transaction.ConsensusTimestamp)
+	assert.Equal(t, AccountID{Account: 3}, *transaction.NodeID)
+	assert.Equal(t, HbarFromTinybar(84000), transaction.ChargedTransactionFee)
+	assert.Equal(t, NewHbar(1), transaction.MaxFee)
+	assert.Equal(t, []byte("memo"), transaction.Memo)
+	assert.Equal(t, []byte{1, 2, 3}, transaction.TransactionHash)
+	assert.Equal(t, 2*time.Minute, transaction.ValidDuration)
+	require.Len(t, transaction.Transfers, 2)
+	assert.Equal(t, HbarFromTinybar(-10), transaction.Transfers[0].Amount)
+	require.Len(t, transaction.TokenTransfers, 1)
+	assert.True(t, transaction.TokenTransfers[0].IsApproval)
+	require.Len(t, transaction.NftTransfers, 1)
+	assert.Equal(t, NftID{TokenID: TokenID{Token: 8}, SerialNumber: 4}, transaction.NftTransfers[0].NftID)
+}
+
+func TestUnitMirrorRestClientGetExchangeRates(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"current_rate": {"cent_equivalent": 596987, "expiration_time": 1649689200, "hbar_equivalent": 30000},
+			"next_rate": {"cent_equivalent": 596987, "expiration_time": 1649692800, "hbar_equivalent": 30000},
+			"timestamp": "1649689200.123456789"
+		}`))
+	}))
+	defer server.Close()
+
+	rates, err := _NewTestMirrorRestClient(server).GetExchangeRates(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(30000), rates.CurrentRate.Hbars)
+	assert.Equal(t, int32(596987), rates.CurrentRate.cents)
+	assert.Equal(t, int64(1649692800), rates.NextRate.expirationTime.Seconds)
+}
+
+func TestUnitMirrorRestClientContextCanceledDuringBackoff(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewMirrorRestClient(server.URL + "/api/v1").SetMaxAttempts(5)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetTopic(ctx, TopicID{Topic: 1})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
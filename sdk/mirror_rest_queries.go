@@ -0,0 +1,377 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+)
+
+type _MirrorRestAccountsPage struct {
+	Accounts []_MirrorRestAccount `json:"accounts"`
+	Links    _MirrorRestLinks     `json:"links"`
+}
+
+type _MirrorRestBalancesPage struct {
+	Balances []_MirrorRestAccountBalance `json:"balances"`
+	Links    _MirrorRestLinks            `json:"links"`
+}
+
+type _MirrorRestTokensPage struct {
+	Tokens []_MirrorRestToken `json:"tokens"`
+	Links  _MirrorRestLinks   `json:"links"`
+}
+
+type _MirrorRestTokenRelationshipsPage struct {
+	Tokens []_MirrorRestTokenRelationship `json:"tokens"`
+	Links  _MirrorRestLinks               `json:"links"`
+}
+
+type _MirrorRestNftsPage struct {
+	Nfts  []_MirrorRestNft `json:"nfts"`
+	Links _MirrorRestLinks `json:"links"`
+}
+
+type _MirrorRestAirdropsPage struct {
+	Airdrops []_MirrorRestAirdrop `json:"airdrops"`
+	Links    _MirrorRestLinks     `json:"links"`
+}
+
+type _MirrorRestTransactionsPage struct {
+	Transactions []_MirrorRestTransaction `json:"transactions"`
+	Links        _MirrorRestLinks         `json:"links"`
+}
+
+type _MirrorRestTopicMessagesPage struct {
+	Messages []_MirrorRestTopicMessage `json:"messages"`
+	Links    _MirrorRestLinks          `json:"links"`
+}
+
+type _MirrorRestContractsPage struct {
+	Contracts []_MirrorRestContract `json:"contracts"`
+	Links     _MirrorRestLinks      `json:"links"`
+}
+
+type _MirrorRestContractResultsPage struct {
+	Results []_MirrorRestContractResult `json:"results"`
+	Links   _MirrorRestLinks            `json:"links"`
+}
+
+type _MirrorRestContractLogsPage struct {
+	Logs  []_MirrorRestContractLog `json:"logs"`
+	Links _MirrorRestLinks         `json:"links"`
+}
+
+type _MirrorRestSchedulesPage struct {
+	Schedules []_MirrorRestSchedule `json:"schedules"`
+	Links     _MirrorRestLinks      `json:"links"`
+}
+
+type _MirrorRestNetworkNodesPage struct {
+	Nodes []_MirrorRestNetworkNode `json:"nodes"`
+	Links _MirrorRestLinks         `json:"links"`
+}
+
+// _MirrorRestConvertAll converts every raw item of a page, stopping at the first conversion error.
+func _MirrorRestConvertAll[R any, T any](raw []R, convert func(R) (T, error)) ([]T, error) {
+	result := make([]T, 0, len(raw))
+	for _, item := range raw {
+		converted, err := convert(item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+
+	return result, nil
+}
+
+// ---------- accounts ----------
+
+// GetAccount returns an account by its ID (`0.0.123`), alias or EVM address.
+func (c *MirrorRestClient) GetAccount(ctx context.Context, idOrAliasOrEvmAddress string) (MirrorAccount, error) {
+	var account _MirrorRestAccount
+	if err := c.get(ctx, "/accounts/"+url.PathEscape(idOrAliasOrEvmAddress), nil, &account); err != nil {
+		return MirrorAccount{}, err
+	}
+
+	return _MirrorAccountFromRest(account)
+}
+
+// GetAccountByID returns an account by its AccountID.
+func (c *MirrorRestClient) GetAccountByID(ctx context.Context, accountID AccountID) (MirrorAccount, error) {
+	return c.GetAccount(ctx, accountID.String())
+}
+
+// Accounts iterates over all accounts matching params.
+func (c *MirrorRestClient) Accounts(ctx context.Context, params *MirrorRestParams) iter.Seq2[MirrorAccount, error] {
+	return _MirrorRestList(ctx, c, "/accounts", params, func(page *_MirrorRestAccountsPage) ([]MirrorAccount, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Accounts, _MirrorAccountFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// Balances iterates over the account balances matching params.
+func (c *MirrorRestClient) Balances(ctx context.Context, params *MirrorRestParams) iter.Seq2[MirrorAccountBalance, error] {
+	return _MirrorRestList(ctx, c, "/balances", params, func(page *_MirrorRestBalancesPage) ([]MirrorAccountBalance, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Balances, _MirrorAccountBalanceFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// AccountTokens iterates over the tokens associated with an account.
+func (c *MirrorRestClient) AccountTokens(ctx context.Context, accountID AccountID, params *MirrorRestParams) iter.Seq2[MirrorTokenRelationship, error] {
+	path := fmt.Sprintf("/accounts/%s/tokens", accountID.String())
+	return _MirrorRestList(ctx, c, path, params, func(page *_MirrorRestTokenRelationshipsPage) ([]MirrorTokenRelationship, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Tokens, _MirrorTokenRelationshipFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// AccountNfts iterates over the NFTs owned by an account.
+func (c *MirrorRestClient) AccountNfts(ctx context.Context, accountID AccountID, params *MirrorRestParams) iter.Seq2[MirrorNft, error] {
+	path := fmt.Sprintf("/accounts/%s/nfts", accountID.String())
+	return _MirrorRestList(ctx, c, path, params, func(page *_MirrorRestNftsPage) ([]MirrorNft, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Nfts, _MirrorNftFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// AccountPendingAirdrops iterates over the pending airdrops an account received and can claim.
+func (c *MirrorRestClient) AccountPendingAirdrops(ctx context.Context, accountID AccountID, params *MirrorRestParams) iter.Seq2[MirrorAirdrop, error] {
+	path := fmt.Sprintf("/accounts/%s/airdrops/pending", accountID.String())
+	return _MirrorRestList(ctx, c, path, params, func(page *_MirrorRestAirdropsPage) ([]MirrorAirdrop, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Airdrops, _MirrorAirdropFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// AccountOutstandingAirdrops iterates over the pending airdrops an account sent and can cancel.
+func (c *MirrorRestClient) AccountOutstandingAirdrops(ctx context.Context, accountID AccountID, params *MirrorRestParams) iter.Seq2[MirrorAirdrop, error] {
+	path := fmt.Sprintf("/accounts/%s/airdrops/outstanding", accountID.String())
+	return _MirrorRestList(ctx, c, path, params, func(page *_MirrorRestAirdropsPage) ([]MirrorAirdrop, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Airdrops, _MirrorAirdropFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// ---------- tokens ----------
+
+// GetToken returns the details of a token.
+func (c *MirrorRestClient) GetToken(ctx context.Context, tokenID TokenID) (MirrorToken, error) {
+	var token _MirrorRestToken
+	if err := c.get(ctx, "/tokens/"+tokenID.String(), nil, &token); err != nil {
+		return MirrorToken{}, err
+	}
+
+	return _MirrorTokenFromRest(token)
+}
+
+// Tokens iterates over all tokens matching params.
+func (c *MirrorRestClient) Tokens(ctx context.Context, params *MirrorRestParams) iter.Seq2[MirrorToken, error] {
+	return _MirrorRestList(ctx, c, "/tokens", params, func(page *_MirrorRestTokensPage) ([]MirrorToken, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Tokens, _MirrorTokenFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// TokenNfts iterates over the NFTs of a token.
+func (c *MirrorRestClient) TokenNfts(ctx context.Context, tokenID TokenID, params *MirrorRestParams) iter.Seq2[MirrorNft, error] {
+	path := fmt.Sprintf("/tokens/%s/nfts", tokenID.String())
+	return _MirrorRestList(ctx, c, path, params, func(page *_MirrorRestNftsPage) ([]MirrorNft, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Nfts, _MirrorNftFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// GetNft returns a single NFT.
+func (c *MirrorRestClient) GetNft(ctx context.Context, nftID NftID) (MirrorNft, error) {
+	var nft _MirrorRestNft
+	path := fmt.Sprintf("/tokens/%s/nfts/%d", nftID.TokenID.String(), nftID.SerialNumber)
+	if err := c.get(ctx, path, nil, &nft); err != nil {
+		return MirrorNft{}, err
+	}
+
+	return _MirrorNftFromRest(nft)
+}
+
+// ---------- transactions ----------
+
+// GetTransaction returns every transaction with the given TransactionID, which includes the parent transaction,
+// its child transactions and any duplicates.
+func (c *MirrorRestClient) GetTransaction(ctx context.Context, transactionID TransactionID) ([]MirrorTransaction, error) {
+	var page _MirrorRestTransactionsPage
+	if err := c.get(ctx, "/transactions/"+_MirrorTransactionIDToString(transactionID), nil, &page); err != nil {
+		return nil, err
+	}
+
+	return _MirrorRestConvertAll(page.Transactions, _MirrorTransactionFromRest)
+}
+
+// Transactions iterates over all transactions matching params.
+func (c *MirrorRestClient) Transactions(ctx context.Context, params *MirrorRestParams) iter.Seq2[MirrorTransaction, error] {
+	return _MirrorRestList(ctx, c, "/transactions", params, func(page *_MirrorRestTransactionsPage) ([]MirrorTransaction, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Transactions, _MirrorTransactionFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// ---------- topics ----------
+
+// GetTopic returns the details of a topic.
+func (c *MirrorRestClient) GetTopic(ctx context.Context, topicID TopicID) (MirrorTopic, error) {
+	var topic _MirrorRestTopic
+	if err := c.get(ctx, "/topics/"+topicID.String(), nil, &topic); err != nil {
+		return MirrorTopic{}, err
+	}
+
+	return _MirrorTopicFromRest(topic)
+}
+
+// TopicMessages iterates over the messages of a topic.
+func (c *MirrorRestClient) TopicMessages(ctx context.Context, topicID TopicID, params *MirrorRestParams) iter.Seq2[MirrorTopicMessage, error] {
+	path := fmt.Sprintf("/topics/%s/messages", topicID.String())
+	return _MirrorRestList(ctx, c, path, params, func(page *_MirrorRestTopicMessagesPage) ([]MirrorTopicMessage, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Messages, _MirrorTopicMessageFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// GetTopicMessage returns a single topic message by its sequence number.
+func (c *MirrorRestClient) GetTopicMessage(ctx context.Context, topicID TopicID, sequenceNumber uint64) (MirrorTopicMessage, error) {
+	var message _MirrorRestTopicMessage
+	path := fmt.Sprintf("/topics/%s/messages/%d", topicID.String(), sequenceNumber)
+	if err := c.get(ctx, path, nil, &message); err != nil {
+		return MirrorTopicMessage{}, err
+	}
+
+	return _MirrorTopicMessageFromRest(message)
+}
+
+// ---------- contracts ----------
+
+// GetContract returns a contract by its ID (`0.0.123`) or EVM address.
+func (c *MirrorRestClient) GetContract(ctx context.Context, idOrEvmAddress string) (MirrorContract, error) {
+	var contract _MirrorRestContract
+	if err := c.get(ctx, "/contracts/"+url.PathEscape(idOrEvmAddress), nil, &contract); err != nil {
+		return MirrorContract{}, err
+	}
+
+	return _MirrorContractFromRest(contract)
+}
+
+// Contracts iterates over all contracts matching params.
+func (c *MirrorRestClient) Contracts(ctx context.Context, params *MirrorRestParams) iter.Seq2[MirrorContract, error] {
+	return _MirrorRestList(ctx, c, "/contracts", params, func(page *_MirrorRestContractsPage) ([]MirrorContract, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Contracts, _MirrorContractFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// ContractResults iterates over the execution results of a contract.
+func (c *MirrorRestClient) ContractResults(ctx context.Context, contractID ContractID, params *MirrorRestParams) iter.Seq2[MirrorContractResult, error] {
+	path := fmt.Sprintf("/contracts/%s/results", contractID.String())
+	return _MirrorRestList(ctx, c, path, params, func(page *_MirrorRestContractResultsPage) ([]MirrorContractResult, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Results, _MirrorContractResultFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// ContractLogs iterates over the logs emitted by a contract.
+func (c *MirrorRestClient) ContractLogs(ctx context.Context, contractID ContractID, params *MirrorRestParams) iter.Seq2[MirrorContractLog, error] {
+	path := fmt.Sprintf("/contracts/%s/results/logs", contractID.String())
+	return _MirrorRestList(ctx, c, path, params, func(page *_MirrorRestContractLogsPage) ([]MirrorContractLog, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Logs, _MirrorContractLogFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// ContractCall posts a raw `/contracts/call` request and returns the decoded JSON response.
+func (c *MirrorRestClient) ContractCall(ctx context.Context, payload map[string]any) (map[string]any, error) {
+	var result map[string]any
+	if err := c.post(ctx, "/contracts/call", payload, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ---------- schedules ----------
+
+// GetSchedule returns the details of a schedule.
+func (c *MirrorRestClient) GetSchedule(ctx context.Context, scheduleID ScheduleID) (MirrorSchedule, error) {
+	var schedule _MirrorRestSchedule
+	if err := c.get(ctx, "/schedules/"+scheduleID.String(), nil, &schedule); err != nil {
+		return MirrorSchedule{}, err
+	}
+
+	return _MirrorScheduleFromRest(schedule)
+}
+
+// Schedules iterates over all schedules matching params.
+func (c *MirrorRestClient) Schedules(ctx context.Context, params *MirrorRestParams) iter.Seq2[MirrorSchedule, error] {
+	return _MirrorRestList(ctx, c, "/schedules", params, func(page *_MirrorRestSchedulesPage) ([]MirrorSchedule, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Schedules, _MirrorScheduleFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// ---------- network ----------
+
+// NetworkNodes iterates over the consensus nodes of the network.
+func (c *MirrorRestClient) NetworkNodes(ctx context.Context, params *MirrorRestParams) iter.Seq2[MirrorNetworkNode, error] {
+	return _MirrorRestList(ctx, c, "/network/nodes", params, func(page *_MirrorRestNetworkNodesPage) ([]MirrorNetworkNode, *string, error) {
+		items, err := _MirrorRestConvertAll(page.Nodes, _MirrorNetworkNodeFromRest)
+		return items, page.Links.Next, err
+	})
+}
+
+// GetExchangeRates returns the current and next hbar to USD cent exchange rates.
+func (c *MirrorRestClient) GetExchangeRates(ctx context.Context) (MirrorExchangeRates, error) {
+	var rates _MirrorRestExchangeRates
+	if err := c.get(ctx, "/network/exchangerate", nil, &rates); err != nil {
+		return MirrorExchangeRates{}, err
+	}
+
+	timestamp, err := _MirrorTimestampFromString(rates.Timestamp)
+	if err != nil {
+		return MirrorExchangeRates{}, err
+	}
+
+	return MirrorExchangeRates{
+		CurrentRate: _MirrorExchangeRateFromRest(rates.CurrentRate),
+		NextRate:    _MirrorExchangeRateFromRest(rates.NextRate),
+		Timestamp:   timestamp,
+	}, nil
+}
+
+// GetNetworkSupply returns the total and released hbar supply.
+func (c *MirrorRestClient) GetNetworkSupply(ctx context.Context) (MirrorNetworkSupply, error) {
+	var supply _MirrorRestNetworkSupply
+	if err := c.get(ctx, "/network/supply", nil, &supply); err != nil {
+		return MirrorNetworkSupply{}, err
+	}
+
+	released, err := _MirrorNumberToInt64(supply.ReleasedSupply)
+	if err != nil {
+		return MirrorNetworkSupply{}, err
+	}
+
+	total, err := _MirrorNumberToInt64(supply.TotalSupply)
+	if err != nil {
+		return MirrorNetworkSupply{}, err
+	}
+
+	timestamp, err := _MirrorTimestampFromString(supply.Timestamp)
+	if err != nil {
+		return MirrorNetworkSupply{}, err
+	}
+
+	return MirrorNetworkSupply{
+		ReleasedSupply: HbarFromTinybar(released),
+		TotalSupply:    HbarFromTinybar(total),
+		Timestamp:      timestamp,
+	}, nil
+}
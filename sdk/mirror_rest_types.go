@@ -0,0 +1,1432 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+// MirrorTokenBalance is the balance of a single token held by an account, as reported by the mirror node.
+type MirrorTokenBalance struct {
+	TokenID TokenID
+	Balance uint64
+}
+
+// MirrorAccount is an account as reported by the mirror node `/accounts` endpoints.
+type MirrorAccount struct {
+	AccountID                     AccountID
+	Alias                         string
+	EvmAddress                    string
+	Balance                       Hbar
+	BalanceTimestamp              time.Time
+	TokenBalances                 []MirrorTokenBalance
+	Key                           Key
+	Memo                          string
+	Deleted                       bool
+	ReceiverSignatureRequired     bool
+	DeclineStakingReward          bool
+	StakedAccountID               *AccountID
+	StakedNodeID                  *int64
+	PendingReward                 Hbar
+	EthereumNonce                 int64
+	MaxAutomaticTokenAssociations int32
+	AutoRenewPeriod               time.Duration
+	CreatedTimestamp              time.Time
+	ExpiryTimestamp               time.Time
+}
+
+// MirrorAccountBalance is an entry of the mirror node `/balances` endpoint.
+type MirrorAccountBalance struct {
+	AccountID     AccountID
+	Balance       Hbar
+	TokenBalances []MirrorTokenBalance
+}
+
+// MirrorToken is a token as reported by the mirror node `/tokens` endpoints.
+// The list endpoint only populates TokenID, Name, Symbol, Decimals, Type and AdminKey.
+type MirrorToken struct {
+	TokenID           TokenID
+	Name              string
+	Symbol            string
+	Memo              string
+	Decimals          uint32
+	Type              TokenType
+	SupplyType        TokenSupplyType
+	TotalSupply       uint64
+	MaxSupply         uint64
+	TreasuryAccountID *AccountID
+	AutoRenewAccount  *AccountID
+	AutoRenewPeriod   time.Duration
+	AdminKey          Key
+	KycKey            Key
+	FreezeKey         Key
+	WipeKey           Key
+	SupplyKey         Key
+	FeeScheduleKey    Key
+	PauseKey          Key
+	MetadataKey       Key
+	Metadata          []byte
+	FreezeDefault     bool
+	Paused            bool
+	Deleted           bool
+	CreatedTimestamp  time.Time
+	ExpiryTimestamp   time.Time
+}
+
+// MirrorTokenRelationship is a token associated with an account, as reported by the mirror node
+// `/accounts/{id}/tokens` endpoint.
+type MirrorTokenRelationship struct {
+	TokenID              TokenID
+	Balance              uint64
+	Decimals             uint32
+	AutomaticAssociation bool
+	FreezeStatus         string
+	KycStatus            string
+	CreatedTimestamp     time.Time
+}
+
+// MirrorNft is a non-fungible token as reported by the mirror node NFT endpoints.
+type MirrorNft struct {
+	NftID             NftID
+	AccountID         *AccountID
+	SpenderID         *AccountID
+	DelegatingSpender *AccountID
+	Metadata          []byte
+	Deleted           bool
+	CreatedTimestamp  time.Time
+	ModifiedTimestamp time.Time
+}
+
+// MirrorAirdrop is a pending airdrop as reported by the mirror node `/accounts/{id}/airdrops` endpoints.
+type MirrorAirdrop struct {
+	SenderID   AccountID
+	ReceiverID AccountID
+	TokenID    TokenID
+	// SerialNumber is the serial number of an NFT airdrop, nil for a fungible token airdrop.
+	SerialNumber *int64
+	// Amount is the amount of a fungible token airdrop.
+	Amount    uint64
+	Timestamp time.Time
+}
+
+// GetPendingAirdropId returns the ID which claims or cancels the airdrop.
+func (airdrop MirrorAirdrop) GetPendingAirdropId() PendingAirdropId { // nolint
+	id := PendingAirdropId{}
+	id.SetSender(airdrop.SenderID).SetReceiver(airdrop.ReceiverID)
+	if airdrop.SerialNumber != nil {
+		id.SetNftID(NftID{TokenID: airdrop.TokenID, SerialNumber: *airdrop.SerialNumber})
+	} else {
+		id.SetTokenID(airdrop.TokenID)
+	}
+
+	return id
+}
+
+// MirrorTransfer is an hbar transfer of a mirror node transaction.
+type MirrorTransfer struct {
+	AccountID  AccountID
+	Amount     Hbar
+	IsApproval bool
+}
+
+// MirrorTokenTransfer is a fungible token transfer of a mirror node transaction.
+type MirrorTokenTransfer struct {
+	TokenID    TokenID
+	AccountID  AccountID
+	Amount     int64
+	IsApproval bool
+}
+
+// MirrorNftTransfer is an NFT transfer of a mirror node transaction.
+type MirrorNftTransfer struct {
+	NftID      NftID
+	SenderID   *AccountID
+	ReceiverID *AccountID
+	IsApproval bool
+}
+
+// MirrorTransaction is a transaction as reported by the mirror node `/transactions` endpoints.
+type MirrorTransaction struct {
+	TransactionID            TransactionID
+	ConsensusTimestamp       time.Time
+	ParentConsensusTimestamp *time.Time
+	Name                     string
+	Result                   string
+	EntityID                 string
+	NodeID                   *AccountID
+	ChargedTransactionFee    Hbar
+	MaxFee                   Hbar
+	Memo                     []byte
+	TransactionHash          []byte
+	Bytes                    []byte
+	Scheduled                bool
+	Nonce                    int32
+	ValidDuration            time.Duration
+	Transfers                []MirrorTransfer
+	TokenTransfers           []MirrorTokenTransfer
+	NftTransfers             []MirrorNftTransfer
+}
+
+// MirrorTopic is a topic as reported by the mirror node `/topics/{id}` endpoint.
+type MirrorTopic struct {
+	TopicID          TopicID
+	Memo             string
+	AdminKey         Key
+	SubmitKey        Key
+	AutoRenewAccount *AccountID
+	AutoRenewPeriod  time.Duration
+	Deleted          bool
+	CreatedTimestamp time.Time
+}
+
+// MirrorTopicMessage is a topic message as reported by the mirror node `/topics/{id}/messages` endpoint.
+type MirrorTopicMessage struct {
+	TopicID            TopicID
+	ConsensusTimestamp time.Time
+	SequenceNumber     uint64
+	Message            []byte
+	RunningHash        []byte
+	RunningHashVersion uint64
+	PayerAccountID     *AccountID
+	// Only set for chunked messages
+	InitialTransactionID *TransactionID
+	ChunkNumber          int32
+	ChunkTotal           int32
+}
+
+// MirrorContract is a contract as reported by the mirror node `/contracts/{id}` endpoint.
+type MirrorContract struct {
+	ContractID                    ContractID
+	EvmAddress                    string
+	FileID                        *FileID
+	AdminKey                      Key
+	AutoRenewAccount              *AccountID
+	AutoRenewPeriod               time.Duration
+	MaxAutomaticTokenAssociations int32
+	Memo                          string
+	Deleted                       bool
+	Bytecode                      string
+	RuntimeBytecode               string
+	CreatedTimestamp              time.Time
+	ExpirationTimestamp           time.Time
+}
+
+// MirrorContractResult is a contract execution result as reported by the mirror node `/contracts/{id}/results` endpoint.
+type MirrorContractResult struct {
+	ContractID         *ContractID
+	Timestamp          time.Time
+	From               string
+	To                 string
+	Amount             Hbar
+	GasLimit           uint64
+	GasUsed            uint64
+	CallResult         string
+	ErrorMessage       string
+	FunctionParameters string
+	Hash               string
+	Result             string
+	Status             string
+}
+
+// MirrorContractLog is a log emitted by a contract as reported by the mirror node `/contracts/{id}/results/logs` endpoint.
+type MirrorContractLog struct {
+	ContractID      *ContractID
+	RootContractID  *ContractID
+	Address         string
+	Data            string
+	Topics          []string
+	Index           int64
+	Timestamp       time.Time
+	TransactionHash string
+	BlockHash       string
+	BlockNumber     int64
+}
+
+// MirrorSchedule is a schedule as reported by the mirror node `/schedules` endpoints.
+type MirrorSchedule struct {
+	ScheduleID         ScheduleID
+	CreatorAccountID   *AccountID
+	PayerAccountID     *AccountID
+	AdminKey           Key
+	Memo               string
+	Deleted            bool
+	WaitForExpiry      bool
+	TransactionBody    []byte
+	ConsensusTimestamp time.Time
+	ExecutedTimestamp  *time.Time
+	ExpirationTime     *time.Time
+}
+
+// MirrorServiceEndpoint is a gRPC endpoint of a consensus node as reported by the mirror node.
+type MirrorServiceEndpoint struct {
+	DomainName  string
+	IPAddressV4 string
+	Port        int32
+}
+
+// MirrorNetworkNode is a consensus node as reported by the mirror node `/network/nodes` endpoint.
+type MirrorNetworkNode struct {
+	NodeID            int64
+	AccountID         AccountID
+	AdminKey          Key
+	Description       string
+	Memo              string
+	FileID            *FileID
+	PublicKey         string
+	NodeCertHash      string
+	ServiceEndpoints  []MirrorServiceEndpoint
+	GrpcProxyEndpoint *MirrorServiceEndpoint
+	Stake             Hbar
+	StakeRewarded     Hbar
+	StakeNotRewarded  Hbar
+	MinStake          Hbar
+	MaxStake          Hbar
+	DeclineReward     bool
+}
+
+// MirrorExchangeRates is the current and next exchange rate as reported by the mirror node `/network/exchangerate` endpoint.
+type MirrorExchangeRates struct {
+	CurrentRate ExchangeRate
+	NextRate    ExchangeRate
+	Timestamp   time.Time
+}
+
+// MirrorNetworkSupply is the hbar supply as reported by the mirror node `/network/supply` endpoint.
+type MirrorNetworkSupply struct {
+	ReleasedSupply Hbar
+	TotalSupply    Hbar
+	Timestamp      time.Time
+}
+
+// ---------- raw JSON representations ----------
+
+type _MirrorRestKey struct {
+	Type string `json:"_type"`
+	Key  string `json:"key"`
+}
+
+type _MirrorRestTokenBalance struct {
+	TokenID string      `json:"token_id"`
+	Balance json.Number `json:"balance"`
+}
+
+type _MirrorRestAccountBalance struct {
+	Account   string                    `json:"account"`
+	Balance   json.Number               `json:"balance"`
+	Timestamp string                    `json:"timestamp"`
+	Tokens    []_MirrorRestTokenBalance `json:"tokens"`
+}
+
+type _MirrorRestAccount struct {
+	Account                       string                     `json:"account"`
+	Alias                         *string                    `json:"alias"`
+	EvmAddress                    *string                    `json:"evm_address"`
+	Balance                       *_MirrorRestAccountBalance `json:"balance"`
+	Key                           *_MirrorRestKey            `json:"key"`
+	Memo                          string                     `json:"memo"`
+	Deleted                       *bool                      `json:"deleted"`
+	ReceiverSigRequired           *bool                      `json:"receiver_sig_required"`
+	DeclineReward                 bool                       `json:"decline_reward"`
+	StakedAccountID               *string                    `json:"staked_account_id"`
+	StakedNodeID                  *int64                     `json:"staked_node_id"`
+	PendingReward                 json.Number                `json:"pending_reward"`
+	EthereumNonce                 json.Number                `json:"ethereum_nonce"`
+	MaxAutomaticTokenAssociations int32                      `json:"max_automatic_token_associations"`
+	AutoRenewPeriod               *int64                     `json:"auto_renew_period"`
+	CreatedTimestamp              *string                    `json:"created_timestamp"`
+	ExpiryTimestamp               *string                    `json:"expiry_timestamp"`
+}
+
+type _MirrorRestToken struct {
+	TokenID           string          `json:"token_id"`
+	Name              string          `json:"name"`
+	Symbol            string          `json:"symbol"`
+	Memo              string          `json:"memo"`
+	Decimals          json.Number     `json:"decimals"`
+	Type              string          `json:"type"`
+	SupplyType        string          `json:"supply_type"`
+	TotalSupply       json.Number     `json:"total_supply"`
+	MaxSupply         json.Number     `json:"max_supply"`
+	TreasuryAccountID *string         `json:"treasury_account_id"`
+	AutoRenewAccount  *string         `json:"auto_renew_account"`
+	AutoRenewPeriod   *int64          `json:"auto_renew_period"`
+	AdminKey          *_MirrorRestKey `json:"admin_key"`
+	KycKey            *_MirrorRestKey `json:"kyc_key"`
+	FreezeKey         *_MirrorRestKey `json:"freeze_key"`
+	WipeKey           *_MirrorRestKey `json:"wipe_key"`
+	SupplyKey         *_MirrorRestKey `json:"supply_key"`
+	FeeScheduleKey    *_MirrorRestKey `json:"fee_schedule_key"`
+	PauseKey          *_MirrorRestKey `json:"pause_key"`
+	MetadataKey       *_MirrorRestKey `json:"metadata_key"`
+	Metadata          string          `json:"metadata"`
+	FreezeDefault     bool            `json:"freeze_default"`
+	PauseStatus       string          `json:"pause_status"`
+	Deleted           bool            `json:"deleted"`
+	CreatedTimestamp  *string         `json:"created_timestamp"`
+	ExpiryTimestamp   json.Number     `json:"expiry_timestamp"`
+}
+
+type _MirrorRestTokenRelationship struct {
+	TokenID              string      `json:"token_id"`
+	Balance              json.Number `json:"balance"`
+	Decimals             json.Number `json:"decimals"`
+	AutomaticAssociation bool        `json:"automatic_association"`
+	FreezeStatus         string      `json:"freeze_status"`
+	KycStatus            string      `json:"kyc_status"`
+	CreatedTimestamp     *string     `json:"created_timestamp"`
+}
+
+type _MirrorRestNft struct {
+	TokenID           string  `json:"token_id"`
+	SerialNumber      int64   `json:"serial_number"`
+	AccountID         *string `json:"account_id"`
+	Spender           *string `json:"spender"`
+	DelegatingSpender *string `json:"delegating_spender"`
+	Metadata          string  `json:"metadata"`
+	Deleted           bool    `json:"deleted"`
+	CreatedTimestamp  *string `json:"created_timestamp"`
+	ModifiedTimestamp *string `json:"modified_timestamp"`
+}
+
+type _MirrorRestAirdrop struct {
+	Amount       json.Number               `json:"amount"`
+	ReceiverID   string                    `json:"receiver_id"`
+	SenderID     string                    `json:"sender_id"`
+	SerialNumber *int64                    `json:"serial_number"`
+	Timestamp    _MirrorRestTimestampRange `json:"timestamp"`
+	TokenID      string                    `json:"token_id"`
+}
+
+type _MirrorRestTimestampRange struct {
+	From string  `json:"from"`
+	To   *string `json:"to"`
+}
+
+type _MirrorRestTransfer struct {
+	Account    string `json:"account"`
+	Amount     int64  `json:"amount"`
+	IsApproval bool   `json:"is_approval"`
+}
+
+type _MirrorRestTokenTransfer struct {
+	TokenID    string `json:"token_id"`
+	Account    string `json:"account"`
+	Amount     int64  `json:"amount"`
+	IsApproval bool   `json:"is_approval"`
+}
+
+type _MirrorRestNftTransfer struct {
+	TokenID           string  `json:"token_id"`
+	SerialNumber      int64   `json:"serial_number"`
+	SenderAccountID   *string `json:"sender_account_id"`
+	ReceiverAccountID *string `json:"receiver_account_id"`
+	IsApproval        bool    `json:"is_approval"`
+}
+
+type _MirrorRestTransaction struct {
+	TransactionID            string                     `json:"transaction_id"`
+	ConsensusTimestamp       string                     `json:"consensus_timestamp"`
+	ParentConsensusTimestamp *string                    `json:"parent_consensus_timestamp"`
+	Name                     string                     `json:"name"`
+	Result                   string                     `json:"result"`
+	EntityID                 *string                    `json:"entity_id"`
+	Node                     *string                    `json:"node"`
+	ChargedTxFee             int64                      `json:"charged_tx_fee"`
+	MaxFee                   json.Number                `json:"max_fee"`
+	MemoBase64               string                     `json:"memo_base64"`
+	TransactionHash          string                     `json:"transaction_hash"`
+	Bytes                    *string                    `json:"bytes"`
+	Scheduled                bool                       `json:"scheduled"`
+	Nonce                    int32                      `json:"nonce"`
+	ValidDurationSeconds     json.Number                `json:"valid_duration_seconds"`
+	Transfers                []_MirrorRestTransfer      `json:"transfers"`
+	TokenTransfers           []_MirrorRestTokenTransfer `json:"token_transfers"`
+	NftTransfers             []_MirrorRestNftTransfer   `json:"nft_transfers"`
+}
+
+type _MirrorRestTopic struct {
+	TopicID          string          `json:"topic_id"`
+	Memo             string          `json:"memo"`
+	AdminKey         *_MirrorRestKey `json:"admin_key"`
+	SubmitKey        *_MirrorRestKey `json:"submit_key"`
+	AutoRenewAccount *string         `json:"auto_renew_account"`
+	AutoRenewPeriod  *int64          `json:"auto_renew_period"`
+	Deleted          *bool           `json:"deleted"`
+	CreatedTimestamp *string         `json:"created_timestamp"`
+}
+
+type _MirrorRestChunkInfo struct {
+	InitialTransactionID *struct {
+		AccountID             string `json:"account_id"`
+		Nonce                 int32  `json:"nonce"`
+		Scheduled             bool   `json:"scheduled"`
+		TransactionValidStart string `json:"transaction_valid_start"`
+	} `json:"initial_transaction_id"`
+	Number int32 `json:"number"`
+	Total  int32 `json:"total"`
+}
+
+type _MirrorRestTopicMessage struct {
+	TopicID            string                `json:"topic_id"`
+	ConsensusTimestamp string                `json:"consensus_timestamp"`
+	SequenceNumber     uint64                `json:"sequence_number"`
+	Message            string                `json:"message"`
+	RunningHash        string                `json:"running_hash"`
+	RunningHashVersion uint64                `json:"running_hash_version"`
+	PayerAccountID     *string               `json:"payer_account_id"`
+	ChunkInfo          *_MirrorRestChunkInfo `json:"chunk_info"`
+}
+
+type _MirrorRestContract struct {
+	ContractID                    string          `json:"contract_id"`
+	EvmAddress                    string          `json:"evm_address"`
+	FileID                        *string         `json:"file_id"`
+	AdminKey                      *_MirrorRestKey `json:"admin_key"`
+	AutoRenewAccount              *string         `json:"auto_renew_account"`
+	AutoRenewPeriod               *int64          `json:"auto_renew_period"`
+	MaxAutomaticTokenAssociations int32           `json:"max_automatic_token_associations"`
+	Memo                          string          `json:"memo"`
+	Deleted                       bool            `json:"deleted"`
+	Bytecode                      string          `json:"bytecode"`
+	RuntimeBytecode               string          `json:"runtime_bytecode"`
+	CreatedTimestamp              *string         `json:"created_timestamp"`
+	ExpirationTimestamp           *string         `json:"expiration_timestamp"`
+}
+
+type _MirrorRestContractResult struct {
+	ContractID         *string `json:"contract_id"`
+	Timestamp          string  `json:"timestamp"`
+	From               string  `json:"from"`
+	To                 string  `json:"to"`
+	Amount             int64   `json:"amount"`
+	GasLimit           uint64  `json:"gas_limit"`
+	GasUsed            uint64  `json:"gas_used"`
+	CallResult         string  `json:"call_result"`
+	ErrorMessage       *string `json:"error_message"`
+	FunctionParameters string  `json:"function_parameters"`
+	Hash               string  `json:"hash"`
+	Result             string  `json:"result"`
+	Status             string  `json:"status"`
+}
+
+type _MirrorRestContractLog struct {
+	ContractID      *string  `json:"contract_id"`
+	RootContractID  *string  `json:"root_contract_id"`
+	Address         string   `json:"address"`
+	Data            string   `json:"data"`
+	Topics          []string `json:"topics"`
+	Index           int64    `json:"index"`
+	Timestamp       string   `json:"timestamp"`
+	TransactionHash string   `json:"transaction_hash"`
+	BlockHash       string   `json:"block_hash"`
+	BlockNumber     int64    `json:"block_number"`
+}
+
+type _MirrorRestSchedule struct {
+	ScheduleID         string          `json:"schedule_id"`
+	CreatorAccountID   *string         `json:"creator_account_id"`
+	PayerAccountID     *string         `json:"payer_account_id"`
+	AdminKey           *_MirrorRestKey `json:"admin_key"`
+	Memo               string          `json:"memo"`
+	Deleted            bool            `json:"deleted"`
+	WaitForExpiry      bool            `json:"wait_for_expiry"`
+	TransactionBody    string          `json:"transaction_body"`
+	ConsensusTimestamp string          `json:"consensus_timestamp"`
+	ExecutedTimestamp  *string         `json:"executed_timestamp"`
+	ExpirationTime     *string         `json:"expiration_time"`
+}
+
+type _MirrorRestServiceEndpoint struct {
+	DomainName  string `json:"domain_name"`
+	IPAddressV4 string `json:"ip_address_v4"`
+	Port        int32  `json:"port"`
+}
+
+type _MirrorRestNetworkNode struct {
+	NodeID            int64                        `json:"node_id"`
+	NodeAccountID     string                       `json:"node_account_id"`
+	AdminKey          *_MirrorRestKey              `json:"admin_key"`
+	Description       string                       `json:"description"`
+	Memo              string                       `json:"memo"`
+	FileID            *string                      `json:"file_id"`
+	PublicKey         string                       `json:"public_key"`
+	NodeCertHash      string                       `json:"node_cert_hash"`
+	ServiceEndpoints  []_MirrorRestServiceEndpoint `json:"service_endpoints"`
+	GrpcProxyEndpoint *_MirrorRestServiceEndpoint  `json:"grpc_proxy_endpoint"`
+	Stake             int64                        `json:"stake"`
+	StakeRewarded     int64                        `json:"stake_rewarded"`
+	StakeNotRewarded  int64                        `json:"stake_not_rewarded"`
+	MinStake          int64                        `json:"min_stake"`
+	MaxStake          int64                        `json:"max_stake"`
+	DeclineReward     bool                         `json:"decline_reward"`
+}
+
+type _MirrorRestExchangeRate struct {
+	CentEquivalent int32 `json:"cent_equivalent"`
+	HbarEquivalent int32 `json:"hbar_equivalent"`
+	ExpirationTime int64 `json:"expiration_time"`
+}
+
+type _MirrorRestExchangeRates struct {
+	CurrentRate _MirrorRestExchangeRate `json:"current_rate"`
+	NextRate    _MirrorRestExchangeRate `json:"next_rate"`
+	Timestamp   string                  `json:"timestamp"`
+}
+
+type _MirrorRestNetworkSupply struct {
+	ReleasedSupply json.Number `json:"released_supply"`
+	TotalSupply    json.Number `json:"total_supply"`
+	Timestamp      string      `json:"timestamp"`
+}
+
+// ---------- conversions ----------
+
+// _MirrorTimestampFromString parses a mirror node `seconds.nanoseconds` timestamp.
+func _MirrorTimestampFromString(timestamp string) (time.Time, error) {
+	if timestamp == "" {
+		return time.Time{}, nil
+	}
+
+	secondsStr, nanosStr, _ := strings.Cut(timestamp, ".")
+	seconds, err := strconv.ParseInt(secondsStr, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid mirror node timestamp %q: %w", timestamp, err)
+	}
+
+	var nanos int64
+	if nanosStr != "" {
+		// pad or truncate to 9 digits so "1.5" means 500ms
+		nanosStr = (nanosStr + "000000000")[:9]
+		nanos, err = strconv.ParseInt(nanosStr, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid mirror node timestamp %q: %w", timestamp, err)
+		}
+	}
+
+	return time.Unix(seconds, nanos), nil
+}
+
+func _MirrorTimestampFromPointer(timestamp *string) (time.Time, error) {
+	if timestamp == nil {
+		return time.Time{}, nil
+	}
+
+	return _MirrorTimestampFromString(*timestamp)
+}
+
+func _MirrorOptionalTimestamp(timestamp *string) (*time.Time, error) {
+	if timestamp == nil || *timestamp == "" {
+		return nil, nil
+	}
+
+	parsed, err := _MirrorTimestampFromString(*timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+// _MirrorTimestampToString formats a time as a mirror node `seconds.nanoseconds` timestamp.
+func _MirrorTimestampToString(timestamp time.Time) string {
+	return fmt.Sprintf("%d.%09d", timestamp.Unix(), timestamp.Nanosecond())
+}
+
+// _MirrorTransactionIDFromString parses a mirror node transaction ID of the form `0.0.123-1700000000-123456789`.
+func _MirrorTransactionIDFromString(transactionID string) (TransactionID, error) {
+	parts := strings.Split(transactionID, "-")
+	if len(parts) != 3 {
+		return TransactionID{}, fmt.Errorf("invalid mirror node transaction ID %q", transactionID)
+	}
+
+	return TransactionIdFromString(fmt.Sprintf("%s@%s.%s", parts[0], parts[1], parts[2]))
+}
+
+// _MirrorTransactionIDToString formats a TransactionID the way the mirror node REST API expects it in paths.
+func _MirrorTransactionIDToString(transactionID TransactionID) string {
+	if transactionID.AccountID == nil || transactionID.ValidStart == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s-%d-%09d", transactionID.AccountID.String(), transactionID.ValidStart.Unix(), transactionID.ValidStart.Nanosecond())
+}
+
+func _MirrorOptionalAccountID(accountID *string) (*AccountID, error) {
+	if accountID == nil || *accountID == "" {
+		return nil, nil
+	}
+
+	parsed, err := AccountIDFromString(*accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func _MirrorOptionalContractID(contractID *string) (*ContractID, error) {
+	if contractID == nil || *contractID == "" {
+		return nil, nil
+	}
+
+	parsed, err := ContractIDFromString(*contractID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func _MirrorOptionalFileID(fileID *string) (*FileID, error) {
+	if fileID == nil || *fileID == "" {
+		return nil, nil
+	}
+
+	parsed, err := FileIDFromString(*fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func _MirrorNumberToInt64(number json.Number) (int64, error) {
+	if number == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(number.String(), 10, 64)
+}
+
+func _MirrorNumberToUint64(number json.Number) (uint64, error) {
+	if number == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(number.String(), 10, 64)
+}
+
+func _MirrorDurationFromSeconds(seconds *int64) time.Duration {
+	if seconds == nil {
+		return 0
+	}
+
+	return time.Duration(*seconds) * time.Second
+}
+
+func _MirrorDecodeBase64(value string) ([]byte, error) {
+	if value == "" {
+		return []byte{}, nil
+	}
+
+	return base64.StdEncoding.DecodeString(value)
+}
+
+// _MirrorKeyFromRest converts a mirror node `{"_type": ..., "key": ...}` key into an SDK Key.
+func _MirrorKeyFromRest(key *_MirrorRestKey) (Key, error) {
+	if key == nil || key.Key == "" {
+		return nil, nil
+	}
+
+	keyBytes, err := hex.DecodeString(key.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.Type {
+	case "ED25519":
+		return PublicKeyFromBytesEd25519(keyBytes)
+	case "ECDSA_SECP256K1":
+		return PublicKeyFromBytesECDSA(keyBytes)
+	case "ProtobufEncoded":
+		return KeyFromBytes(keyBytes)
+	default:
+		return nil, fmt.Errorf("unsupported mirror node key type %q", key.Type)
+	}
+}
+
+func _MirrorTokenBalancesFromRest(balances []_MirrorRestTokenBalance) ([]MirrorTokenBalance, error) {
+	result := make([]MirrorTokenBalance, 0, len(balances))
+	for _, balance := range balances {
+		tokenID, err := TokenIDFromString(balance.TokenID)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := _MirrorNumberToUint64(balance.Balance)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, MirrorTokenBalance{TokenID: tokenID, Balance: amount})
+	}
+
+	return result, nil
+}
+
+func _MirrorAccountFromRest(account _MirrorRestAccount) (MirrorAccount, error) {
+	accountID, err := AccountIDFromString(account.Account)
+	if err != nil {
+		return MirrorAccount{}, err
+	}
+
+	result := MirrorAccount{
+		AccountID:                     accountID,
+		Memo:                          account.Memo,
+		DeclineStakingReward:          account.DeclineReward,
+		StakedNodeID:                  account.StakedNodeID,
+		MaxAutomaticTokenAssociations: account.MaxAutomaticTokenAssociations,
+		AutoRenewPeriod:               _MirrorDurationFromSeconds(account.AutoRenewPeriod),
+		TokenBalances:                 []MirrorTokenBalance{},
+	}
+
+	if account.Alias != nil {
+		result.Alias = *account.Alias
+	}
+	if account.EvmAddress != nil {
+		result.EvmAddress = *account.EvmAddress
+	}
+	if account.Deleted != nil {
+		result.Deleted = *account.Deleted
+	}
+	if account.ReceiverSigRequired != nil {
+		result.ReceiverSignatureRequired = *account.ReceiverSigRequired
+	}
+
+	if account.Balance != nil {
+		balance, err := _MirrorNumberToInt64(account.Balance.Balance)
+		if err != nil {
+			return MirrorAccount{}, err
+		}
+		result.Balance = HbarFromTinybar(balance)
+
+		if result.BalanceTimestamp, err = _MirrorTimestampFromString(account.Balance.Timestamp); err != nil {
+			return MirrorAccount{}, err
+		}
+
+		if result.TokenBalances, err = _MirrorTokenBalancesFromRest(account.Balance.Tokens); err != nil {
+			return MirrorAccount{}, err
+		}
+	}
+
+	if result.Key, err = _MirrorKeyFromRest(account.Key); err != nil {
+		return MirrorAccount{}, err
+	}
+	if result.StakedAccountID, err = _MirrorOptionalAccountID(account.StakedAccountID); err != nil {
+		return MirrorAccount{}, err
+	}
+
+	pendingReward, err := _MirrorNumberToInt64(account.PendingReward)
+	if err != nil {
+		return MirrorAccount{}, err
+	}
+	result.PendingReward = HbarFromTinybar(pendingReward)
+
+	if result.EthereumNonce, err = _MirrorNumberToInt64(account.EthereumNonce); err != nil {
+		return MirrorAccount{}, err
+	}
+	if result.CreatedTimestamp, err = _MirrorTimestampFromPointer(account.CreatedTimestamp); err != nil {
+		return MirrorAccount{}, err
+	}
+	if result.ExpiryTimestamp, err = _MirrorTimestampFromPointer(account.ExpiryTimestamp); err != nil {
+		return MirrorAccount{}, err
+	}
+
+	return result, nil
+}
+
+func _MirrorAccountBalanceFromRest(balance _MirrorRestAccountBalance) (MirrorAccountBalance, error) {
+	accountID, err := AccountIDFromString(balance.Account)
+	if err != nil {
+		return MirrorAccountBalance{}, err
+	}
+
+	amount, err := _MirrorNumberToInt64(balance.Balance)
+	if err != nil {
+		return MirrorAccountBalance{}, err
+	}
+
+	tokens, err := _MirrorTokenBalancesFromRest(balance.Tokens)
+	if err != nil {
+		return MirrorAccountBalance{}, err
+	}
+
+	return MirrorAccountBalance{
+		AccountID:     accountID,
+		Balance:       HbarFromTinybar(amount),
+		TokenBalances: tokens,
+	}, nil
+}
+
+func _MirrorTokenTypeFromRest(tokenType string) TokenType {
+	if tokenType == "NON_FUNGIBLE_UNIQUE" {
+		return TokenTypeNonFungibleUnique
+	}
+
+	return TokenTypeFungibleCommon
+}
+
+func _MirrorTokenFromRest(token _MirrorRestToken) (MirrorToken, error) {
+	tokenID, err := TokenIDFromString(token.TokenID)
+	if err != nil {
+		return MirrorToken{}, err
+	}
+
+	result := MirrorToken{
+		TokenID:         tokenID,
+		Name:            token.Name,
+		Symbol:          token.Symbol,
+		Memo:            token.Memo,
+		Type:            _MirrorTokenTypeFromRest(token.Type),
+		AutoRenewPeriod: _MirrorDurationFromSeconds(token.AutoRenewPeriod),
+		FreezeDefault:   token.FreezeDefault,
+		Paused:          token.PauseStatus == "PAUSED",
+		Deleted:         token.Deleted,
+	}
+
+	if token.SupplyType == "FINITE" {
+		result.SupplyType = TokenSupplyTypeFinite
+	}
+
+	decimals, err := _MirrorNumberToUint64(token.Decimals)
+	if err != nil {
+		return MirrorToken{}, err
+	}
+	result.Decimals = uint32(decimals)
+
+	if result.TotalSupply, err = _MirrorNumberToUint64(token.TotalSupply); err != nil {
+		return MirrorToken{}, err
+	}
+	if result.MaxSupply, err = _MirrorNumberToUint64(token.MaxSupply); err != nil {
+		return MirrorToken{}, err
+	}
+	if result.TreasuryAccountID, err = _MirrorOptionalAccountID(token.TreasuryAccountID); err != nil {
+		return MirrorToken{}, err
+	}
+	if result.AutoRenewAccount, err = _MirrorOptionalAccountID(token.AutoRenewAccount); err != nil {
+		return MirrorToken{}, err
+	}
+
+	keys := []struct {
+		raw *_MirrorRestKey
+		key *Key
+	}{
+		{token.AdminKey, &result.AdminKey},
+		{token.KycKey, &result.KycKey},
+		{token.FreezeKey, &result.FreezeKey},
+		{token.WipeKey, &result.WipeKey},
+		{token.SupplyKey, &result.SupplyKey},
+		{token.FeeScheduleKey, &result.FeeScheduleKey},
+		{token.PauseKey, &result.PauseKey},
+		{token.MetadataKey, &result.MetadataKey},
+	}
+	for _, k := range keys {
+		if *k.key, err = _MirrorKeyFromRest(k.raw); err != nil {
+			return MirrorToken{}, err
+		}
+	}
+
+	if result.Metadata, err = _MirrorDecodeBase64(token.Metadata); err != nil {
+		return MirrorToken{}, err
+	}
+	if result.CreatedTimestamp, err = _MirrorTimestampFromPointer(token.CreatedTimestamp); err != nil {
+		return MirrorToken{}, err
+	}
+
+	// the mirror node reports the token expiry in nanoseconds since the epoch
+	expiry, err := _MirrorNumberToInt64(token.ExpiryTimestamp)
+	if err != nil {
+		return MirrorToken{}, err
+	}
+	if expiry != 0 {
+		result.ExpiryTimestamp = time.Unix(0, expiry)
+	}
+
+	return result, nil
+}
+
+func _MirrorTokenRelationshipFromRest(relationship _MirrorRestTokenRelationship) (MirrorTokenRelationship, error) {
+	tokenID, err := TokenIDFromString(relationship.TokenID)
+	if err != nil {
+		return MirrorTokenRelationship{}, err
+	}
+
+	result := MirrorTokenRelationship{
+		TokenID:              tokenID,
+		AutomaticAssociation: relationship.AutomaticAssociation,
+		FreezeStatus:         relationship.FreezeStatus,
+		KycStatus:            relationship.KycStatus,
+	}
+
+	if result.Balance, err = _MirrorNumberToUint64(relationship.Balance); err != nil {
+		return MirrorTokenRelationship{}, err
+	}
+
+	decimals, err := _MirrorNumberToUint64(relationship.Decimals)
+	if err != nil {
+		return MirrorTokenRelationship{}, err
+	}
+	result.Decimals = uint32(decimals)
+
+	if result.CreatedTimestamp, err = _MirrorTimestampFromPointer(relationship.CreatedTimestamp); err != nil {
+		return MirrorTokenRelationship{}, err
+	}
+
+	return result, nil
+}
+
+func _MirrorNftFromRest(nft _MirrorRestNft) (MirrorNft, error) {
+	tokenID, err := TokenIDFromString(nft.TokenID)
+	if err != nil {
+		return MirrorNft{}, err
+	}
+
+	result := MirrorNft{
+		NftID:   NftID{TokenID: tokenID, SerialNumber: nft.SerialNumber},
+		Deleted: nft.Deleted,
+	}
+
+	if result.AccountID, err = _MirrorOptionalAccountID(nft.AccountID); err != nil {
+		return MirrorNft{}, err
+	}
+	if result.SpenderID, err = _MirrorOptionalAccountID(nft.Spender); err != nil {
+		return MirrorNft{}, err
+	}
+	if result.DelegatingSpender, err = _MirrorOptionalAccountID(nft.DelegatingSpender); err != nil {
+		return MirrorNft{}, err
+	}
+	if result.Metadata, err = _MirrorDecodeBase64(nft.Metadata); err != nil {
+		return MirrorNft{}, err
+	}
+	if result.CreatedTimestamp, err = _MirrorTimestampFromPointer(nft.CreatedTimestamp); err != nil {
+		return MirrorNft{}, err
+	}
+	if result.ModifiedTimestamp, err = _MirrorTimestampFromPointer(nft.ModifiedTimestamp); err != nil {
+		return MirrorNft{}, err
+	}
+
+	return result, nil
+}
+
+func _MirrorAirdropFromRest(airdrop _MirrorRestAirdrop) (MirrorAirdrop, error) {
+	senderID, err := AccountIDFromString(airdrop.SenderID)
+	if err != nil {
+		return MirrorAirdrop{}, err
+	}
+	receiverID, err := AccountIDFromString(airdrop.ReceiverID)
+	if err != nil {
+		return MirrorAirdrop{}, err
+	}
+	tokenID, err := TokenIDFromString(airdrop.TokenID)
+	if err != nil {
+		return MirrorAirdrop{}, err
+	}
+
+	result := MirrorAirdrop{
+		SenderID:     senderID,
+		ReceiverID:   receiverID,
+		TokenID:      tokenID,
+		SerialNumber: airdrop.SerialNumber,
+	}
+
+	if result.Amount, err = _MirrorNumberToUint64(airdrop.Amount); err != nil {
+		return MirrorAirdrop{}, err
+	}
+	if airdrop.Timestamp.From != "" {
+		if result.Timestamp, err = _MirrorTimestampFromString(airdrop.Timestamp.From); err != nil {
+			return MirrorAirdrop{}, err
+		}
+	}
+
+	return result, nil
+}
+
+func _MirrorTransactionFromRest(transaction _MirrorRestTransaction) (MirrorTransaction, error) {
+	transactionID, err := _MirrorTransactionIDFromString(transaction.TransactionID)
+	if err != nil {
+		return MirrorTransaction{}, err
+	}
+	if transaction.Nonce != 0 {
+		nonce := transaction.Nonce
+		transactionID.Nonce = &nonce
+	}
+	transactionID.scheduled = transaction.Scheduled
+
+	result := MirrorTransaction{
+		TransactionID:         transactionID,
+		Name:                  transaction.Name,
+		Result:                transaction.Result,
+		ChargedTransactionFee: HbarFromTinybar(transaction.ChargedTxFee),
+		Scheduled:             transaction.Scheduled,
+		Nonce:                 transaction.Nonce,
+		Transfers:             make([]MirrorTransfer, 0, len(transaction.Transfers)),
+		TokenTransfers:        make([]MirrorTokenTransfer, 0, len(transaction.TokenTransfers)),
+		NftTransfers:          make([]MirrorNftTransfer, 0, len(transaction.NftTransfers)),
+	}
+
+	if transaction.EntityID != nil {
+		result.EntityID = *transaction.EntityID
+	}
+	if result.ConsensusTimestamp, err = _MirrorTimestampFromString(transaction.ConsensusTimestamp); err != nil {
+		return MirrorTransaction{}, err
+	}
+	if result.ParentConsensusTimestamp, err = _MirrorOptionalTimestamp(transaction.ParentConsensusTimestamp); err != nil {
+		return MirrorTransaction{}, err
+	}
+	if result.NodeID, err = _MirrorOptionalAccountID(transaction.Node); err != nil {
+		return MirrorTransaction{}, err
+	}
+
+	maxFee, err := _MirrorNumberToInt64(transaction.MaxFee)
+	if err != nil {
+		return MirrorTransaction{}, err
+	}
+	result.MaxFee = HbarFromTinybar(maxFee)
+
+	validDuration, err := _MirrorNumberToInt64(transaction.ValidDurationSeconds)
+	if err != nil {
+		return MirrorTransaction{}, err
+	}
+	result.ValidDuration = time.Duration(validDuration) * time.Second
+
+	if result.Memo, err = _MirrorDecodeBase64(transaction.MemoBase64); err != nil {
+		return MirrorTransaction{}, err
+	}
+	if result.TransactionHash, err = _MirrorDecodeBase64(transaction.TransactionHash); err != nil {
+		return MirrorTransaction{}, err
+	}
+	if transaction.Bytes != nil {
+		if result.Bytes, err = _MirrorDecodeBase64(*transaction.Bytes); err != nil {
+			return MirrorTransaction{}, err
+		}
+	}
+
+	for _, transfer := range transaction.Transfers {
+		accountID, err := AccountIDFromString(transfer.Account)
+		if err != nil {
+			return MirrorTransaction{}, err
+		}
+		result.Transfers = append(result.Transfers, MirrorTransfer{
+			AccountID:  accountID,
+			Amount:     HbarFromTinybar(transfer.Amount),
+			IsApproval: transfer.IsApproval,
+		})
+	}
+
+	for _, transfer := range transaction.TokenTransfers {
+		tokenID, err := TokenIDFromString(transfer.TokenID)
+		if err != nil {
+			return MirrorTransaction{}, err
+		}
+		accountID, err := AccountIDFromString(transfer.Account)
+		if err != nil {
+			return MirrorTransaction{}, err
+		}
+		result.TokenTransfers = append(result.TokenTransfers, MirrorTokenTransfer{
+			TokenID:    tokenID,
+			AccountID:  accountID,
+			Amount:     transfer.Amount,
+			IsApproval: transfer.IsApproval,
+		})
+	}
+
+	for _, transfer := range transaction.NftTransfers {
+		tokenID, err := TokenIDFromString(transfer.TokenID)
+		if err != nil {
+			return MirrorTransaction{}, err
+		}
+		sender, err := _MirrorOptionalAccountID(transfer.SenderAccountID)
+		if err != nil {
+			return MirrorTransaction{}, err
+		}
+		receiver, err := _MirrorOptionalAccountID(transfer.ReceiverAccountID)
+		if err != nil {
+			return MirrorTransaction{}, err
+		}
+		result.NftTransfers = append(result.NftTransfers, MirrorNftTransfer{
+			NftID:      NftID{TokenID: tokenID, SerialNumber: transfer.SerialNumber},
+			SenderID:   sender,
+			ReceiverID: receiver,
+			IsApproval: transfer.IsApproval,
+		})
+	}
+
+	return result, nil
+}
+
+func _MirrorTopicFromRest(topic _MirrorRestTopic) (MirrorTopic, error) {
+	topicID, err := TopicIDFromString(topic.TopicID)
+	if err != nil {
+		return MirrorTopic{}, err
+	}
+
+	result := MirrorTopic{
+		TopicID:         topicID,
+		Memo:            topic.Memo,
+		AutoRenewPeriod: _MirrorDurationFromSeconds(topic.AutoRenewPeriod),
+	}
+
+	if topic.Deleted != nil {
+		result.Deleted = *topic.Deleted
+	}
+	if result.AdminKey, err = _MirrorKeyFromRest(topic.AdminKey); err != nil {
+		return MirrorTopic{}, err
+	}
+	if result.SubmitKey, err = _MirrorKeyFromRest(topic.SubmitKey); err != nil {
+		return MirrorTopic{}, err
+	}
+	if result.AutoRenewAccount, err = _MirrorOptionalAccountID(topic.AutoRenewAccount); err != nil {
+		return MirrorTopic{}, err
+	}
+	if result.CreatedTimestamp, err = _MirrorTimestampFromPointer(topic.CreatedTimestamp); err != nil {
+		return MirrorTopic{}, err
+	}
+
+	return result, nil
+}
+
+func _MirrorTopicMessageFromRest(message _MirrorRestTopicMessage) (MirrorTopicMessage, error) {
+	topicID, err := TopicIDFromString(message.TopicID)
+	if err != nil {
+		return MirrorTopicMessage{}, err
+	}
+
+	result := MirrorTopicMessage{
+		TopicID:            topicID,
+		SequenceNumber:     message.SequenceNumber,
+		RunningHashVersion: message.RunningHashVersion,
+	}
+
+	if result.ConsensusTimestamp, err = _MirrorTimestampFromString(message.ConsensusTimestamp); err != nil {
+		return MirrorTopicMessage{}, err
+	}
+	if result.Message, err = _MirrorDecodeBase64(message.Message); err != nil {
+		return MirrorTopicMessage{}, err
+	}
+	if result.RunningHash, err = _MirrorDecodeBase64(message.RunningHash); err != nil {
+		return MirrorTopicMessage{}, err
+	}
+	if result.PayerAccountID, err = _MirrorOptionalAccountID(message.PayerAccountID); err != nil {
+		return MirrorTopicMessage{}, err
+	}
+
+	if message.ChunkInfo != nil {
+		result.ChunkNumber = message.ChunkInfo.Number
+		result.ChunkTotal = message.ChunkInfo.Total
+
+		if initial := message.ChunkInfo.InitialTransactionID; initial != nil {
+			accountID, err := AccountIDFromString(initial.AccountID)
+			if err != nil {
+				return MirrorTopicMessage{}, err
+			}
+			validStart, err := _MirrorTimestampFromString(initial.TransactionValidStart)
+			if err != nil {
+				return MirrorTopicMessage{}, err
+			}
+
+			transactionID := NewTransactionIDWithValidStart(accountID, validStart)
+			transactionID.scheduled = initial.Scheduled
+			if initial.Nonce != 0 {
+				nonce := initial.Nonce
+				transactionID.Nonce = &nonce
+			}
+			result.InitialTransactionID = &transactionID
+		}
+	}
+
+	return result, nil
+}
+
+func _MirrorContractFromRest(contract _MirrorRestContract) (MirrorContract, error) {
+	contractID, err := ContractIDFromString(contract.ContractID)
+	if err != nil {
+		return MirrorContract{}, err
+	}
+
+	result := MirrorContract{
+		ContractID:                    contractID,
+		EvmAddress:                    contract.EvmAddress,
+		AutoRenewPeriod:               _MirrorDurationFromSeconds(contract.AutoRenewPeriod),
+		MaxAutomaticTokenAssociations: contract.MaxAutomaticTokenAssociations,
+		Memo:                          contract.Memo,
+		Deleted:                       contract.Deleted,
+		Bytecode:                      contract.Bytecode,
+		RuntimeBytecode:               contract.RuntimeBytecode,
+	}
+
+	if result.FileID, err = _MirrorOptionalFileID(contract.FileID); err != nil {
+		return MirrorContract{}, err
+	}
+	if result.AdminKey, err = _MirrorKeyFromRest(contract.AdminKey); err != nil {
+		return MirrorContract{}, err
+	}
+	if result.AutoRenewAccount, err = _MirrorOptionalAccountID(contract.AutoRenewAccount); err != nil {
+		return MirrorContract{}, err
+	}
+	if result.CreatedTimestamp, err = _MirrorTimestampFromPointer(contract.CreatedTimestamp); err != nil {
+		return MirrorContract{}, err
+	}
+	if result.ExpirationTimestamp, err = _MirrorTimestampFromPointer(contract.ExpirationTimestamp); err != nil {
+		return MirrorContract{}, err
+	}
+
+	return result, nil
+}
+
+func _MirrorContractResultFromRest(contractResult _MirrorRestContractResult) (MirrorContractResult, error) {
+	result := MirrorContractResult{
+		From:               contractResult.From,
+		To:                 contractResult.To,
+		Amount:             HbarFromTinybar(contractResult.Amount),
+		GasLimit:           contractResult.GasLimit,
+		GasUsed:            contractResult.GasUsed,
+		CallResult:         contractResult.CallResult,
+		FunctionParameters: contractResult.FunctionParameters,
+		Hash:               contractResult.Hash,
+		Result:             contractResult.Result,
+		Status:             contractResult.Status,
+	}
+
+	if contractResult.ErrorMessage != nil {
+		result.ErrorMessage = *contractResult.ErrorMessage
+	}
+
+	var err error
+	if result.ContractID, err = _MirrorOptionalContractID(contractResult.ContractID); err != nil {
+		return MirrorContractResult{}, err
+	}
+	if result.Timestamp, err = _MirrorTimestampFromString(contractResult.Timestamp); err != nil {
+		return MirrorContractResult{}, err
+	}
+
+	return result, nil
+}
+
+func _MirrorContractLogFromRest(log _MirrorRestContractLog) (MirrorContractLog, error) {
+	result := MirrorContractLog{
+		Address:         log.Address,
+		Data:            log.Data,
+		Topics:          log.Topics,
+		Index:           log.Index,
+		TransactionHash: log.TransactionHash,
+		BlockHash:       log.BlockHash,
+		BlockNumber:     log.BlockNumber,
+	}
+
+	var err error
+	if result.ContractID, err = _MirrorOptionalContractID(log.ContractID); err != nil {
+		return MirrorContractLog{}, err
+	}
+	if result.RootContractID, err = _MirrorOptionalContractID(log.RootContractID); err != nil {
+		return MirrorContractLog{}, err
+	}
+	if result.Timestamp, err = _MirrorTimestampFromString(log.Timestamp); err != nil {
+		return MirrorContractLog{}, err
+	}
+
+	return result, nil
+}
+
+func _MirrorScheduleFromRest(schedule _MirrorRestSchedule) (MirrorSchedule, error) {
+	scheduleID, err := ScheduleIDFromString(schedule.ScheduleID)
+	if err != nil {
+		return MirrorSchedule{}, err
+	}
+
+	result := MirrorSchedule{
+		ScheduleID:    scheduleID,
+		Memo:          schedule.Memo,
+		Deleted:       schedule.Deleted,
+		WaitForExpiry: schedule.WaitForExpiry,
+	}
+
+	if result.CreatorAccountID, err = _MirrorOptionalAccountID(schedule.CreatorAccountID); err != nil {
+		return MirrorSchedule{}, err
+	}
+	if result.PayerAccountID, err = _MirrorOptionalAccountID(schedule.PayerAccountID); err != nil {
+		return MirrorSchedule{}, err
+	}
+	if result.AdminKey, err = _MirrorKeyFromRest(schedule.AdminKey); err != nil {
+		return MirrorSchedule{}, err
+	}
+	if result.TransactionBody, err = _MirrorDecodeBase64(schedule.TransactionBody); err != nil {
+		return MirrorSchedule{}, err
+	}
+	if result.ConsensusTimestamp, err = _MirrorTimestampFromString(schedule.ConsensusTimestamp); err != nil {
+		return MirrorSchedule{}, err
+	}
+	if result.ExecutedTimestamp, err = _MirrorOptionalTimestamp(schedule.ExecutedTimestamp); err != nil {
+		return MirrorSchedule{}, err
+	}
+	if result.ExpirationTime, err = _MirrorOptionalTimestamp(schedule.ExpirationTime); err != nil {
+		return MirrorSchedule{}, err
+	}
+
+	return result, nil
+}
+
+func _MirrorServiceEndpointFromRest(endpoint _MirrorRestServiceEndpoint) MirrorServiceEndpoint {
+	return MirrorServiceEndpoint{
+		DomainName:  endpoint.DomainName,
+		IPAddressV4: endpoint.IPAddressV4,
+		Port:        endpoint.Port,
+	}
+}
+
+func _MirrorNetworkNodeFromRest(node _MirrorRestNetworkNode) (MirrorNetworkNode, error) {
+	accountID, err := AccountIDFromString(node.NodeAccountID)
+	if err != nil {
+		return MirrorNetworkNode{}, err
+	}
+
+	result := MirrorNetworkNode{
+		NodeID:           node.NodeID,
+		AccountID:        accountID,
+		Description:      node.Description,
+		Memo:             node.Memo,
+		PublicKey:        node.PublicKey,
+		NodeCertHash:     node.NodeCertHash,
+		ServiceEndpoints: make([]MirrorServiceEndpoint, 0, len(node.ServiceEndpoints)),
+		Stake:            HbarFromTinybar(node.Stake),
+		StakeRewarded:    HbarFromTinybar(node.StakeRewarded),
+		StakeNotRewarded: HbarFromTinybar(node.StakeNotRewarded),
+		MinStake:         HbarFromTinybar(node.MinStake),
+		MaxStake:         HbarFromTinybar(node.MaxStake),
+		DeclineReward:    node.DeclineReward,
+	}
+
+	if result.AdminKey, err = _MirrorKeyFromRest(node.AdminKey); err != nil {
+		return MirrorNetworkNode{}, err
+	}
+
+	for _, endpoint := range node.ServiceEndpoints {
+		result.ServiceEndpoints = append(result.ServiceEndpoints, _MirrorServiceEndpointFromRest(endpoint))
+	}
+
+	if node.GrpcProxyEndpoint != nil {
+		endpoint := _MirrorServiceEndpointFromRest(*node.GrpcProxyEndpoint)
+		result.GrpcProxyEndpoint = &endpoint
+	}
+
+	if result.FileID, err = _MirrorOptionalFileID(node.FileID); err != nil {
+		return MirrorNetworkNode{}, err
+	}
+
+	return result, nil
+}
+
+func _MirrorExchangeRateFromRest(rate _MirrorRestExchangeRate) ExchangeRate {
+	return _ExchangeRateFromProtobuf(&services.ExchangeRate{
+		HbarEquiv:      rate.HbarEquivalent,
+		CentEquiv:      rate.CentEquivalent,
+		ExpirationTime: &services.TimestampSeconds{Seconds: rate.ExpirationTime},
+	})
+}
@@ -6,6 +6,7 @@ package hiero
 
 import (
 	"context"
+	"encoding/hex"
 	"net"
 	"testing"
 	"time"
@@ -436,6 +437,9 @@ func NewMockClientAndServer(allNodeResponses [][]interface{}) (*Client, *MockSer
 		networkUpdateContext:            ctx,
 		cancelNetworkUpdate:             cancel,
 		logger:                          logger,
+		systemFiles:                     &_SystemFileCache{},
+		interceptors:                    &_Interceptors{},
+		nodeAddresses:                   &_NodeAddressState{},
 	}
 
 	for i, responses := range allNodeResponses {
@@ -839,6 +843,132 @@ func TestUnitMockExecutionRequestTimeout(t *testing.T) {
 	require.ErrorContains(t, err, "request timed out")
 }
 
+func TestUnitMockExecuteWithContextCanceled(t *testing.T) {
+	t.Parallel()
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+	key, err := PrivateKeyFromStringEd25519(mockPrivateKey)
+	require.NoError(t, err)
+	client.SetOperator(AccountID{Account: 2}, key)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = NewFileCreateTransaction().
+		SetContents([]byte("hello")).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUnitMockFlowsExecuteWithContextCanceled(t *testing.T) {
+	t.Parallel()
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+	key, err := PrivateKeyFromStringEd25519(mockPrivateKey)
+	require.NoError(t, err)
+	client.SetOperator(AccountID{Account: 2}, key)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = NewContractCreateFlow().
+		SetBytecode([]byte{1, 2, 3}).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.Canceled)
+
+	ethereumData, err := hex.DecodeString("02f87082012a022f2f83018000947e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181880de0b6b3a764000083123456c001a0df48f2efd10421811de2bfb125ab75b2d3c44139c4642837fb1fccce911fd479a01aaf7ae92bee896651dfc9d99ae422a296bf5d9f1ca49b2d96d82b79eb112d66")
+	require.NoError(t, err)
+	_, err = NewEthereumFlow().
+		SetEthereumDataBytes(ethereumData).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = NewTokenRejectFlow().
+		SetOwnerID(AccountID{Account: 2}).
+		AddTokenID(TokenID{Token: 5005}).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUnitMockAddressBookQueryWithContextCanceled(t *testing.T) {
+	t.Parallel()
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+	client.SetMirrorNetwork([]string{"127.0.0.1:50212"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewAddressBookQuery().
+		SetFileID(FileID{0, 0, 101, nil}).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUnitMockExecuteWithContextDeadlineDuringBackoff(t *testing.T) {
+	t.Parallel()
+	busy := &services.TransactionResponse{
+		NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+	}
+	responses := [][]interface{}{{
+		busy, busy, busy, busy,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := NewFileCreateTransaction().
+		SetContents([]byte("hello")).
+		SetMinBackoff(time.Second).
+		SetMaxBackoff(8*time.Second).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestUnitMockGetReceiptWithContextCanceledWhilePolling(t *testing.T) {
+	t.Parallel()
+	unknownReceipt := func(request *services.Query) *services.Response {
+		return &services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{
+						NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+						ResponseType:                services.ResponseType_ANSWER_ONLY,
+					},
+					Receipt: &services.TransactionReceipt{
+						Status: services.ResponseCodeEnum_UNKNOWN,
+					},
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+		unknownReceipt, unknownReceipt, unknownReceipt, unknownReceipt,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	resp, err := NewFileCreateTransaction().
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	_, err = resp.GetReceiptWithContext(ctx, client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
 // Define new handler types that accept context
 type MockTransactionHandlerFunc func(ctx context.Context, request *services.Transaction) *services.TransactionResponse
 type MockQueryHandlerFunc func(ctx context.Context, request *services.Query) *services.Response
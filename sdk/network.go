@@ -3,13 +3,14 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
-	"math/rand"
 	"time"
 )
 
 type _Network struct {
 	_ManagedNetwork
 	addressBook map[AccountID]NodeAddress
+	selector    NodeSelector
+	transport   Transport
 }
 
 func _NewNetwork() _Network {
@@ -28,6 +29,7 @@ func (network *_Network) SetNetwork(net map[string]AccountID) (err error) {
 		if err != nil {
 			return err
 		}
+		node.transport = network.transport
 		newNetwork[url] = node
 	}
 
@@ -117,24 +119,60 @@ func (network *_Network) _SetLedgerID(id LedgerID) {
 }
 
 func (network *_Network) _GetNodeAccountIDsForExecute() []AccountID { //nolint
-	nodes := make([]AccountID, 0)
+	return network._GetNodeAccountIDsForRequest(RequestTypeNone)
+}
+
+// _GetNodeAccountIDsForRequest returns the account IDs of the nodes a transaction is sent to, in the order
+// chosen by the node selector
+func (network *_Network) _GetNodeAccountIDsForRequest(requestType RequestType) []AccountID {
 	nodesForTransaction := network._GetNumberOfNodesForTransaction()
+	selected := network._SelectNodes(requestType)
 
-	network.healthyNodesMutex.RLock()
-	defer network.healthyNodesMutex.RUnlock()
+	nodes := make([]AccountID, 0, nodesForTransaction)
+	for i := 0; i < len(selected) && len(nodes) < nodesForTransaction; i++ {
+		nodes = append(nodes, selected[i].AccountID)
+	}
+	return nodes
+}
 
-	healthyNodes := make([]_IManagedNode, len(network.healthyNodes))
-	copy(healthyNodes, network.healthyNodes)
+// _SelectNode returns the node a query is sent to, panicking like _GetNode if there is no healthy node
+func (network *_Network) _SelectNode(requestType RequestType) *_Node {
+	network._ReadmitNodes()
 
-	// shuffle the nodes, so that the first node is not always the same
-	for i := range healthyNodes {
-		j := rand.Intn(i + 1) // #nosec
-		healthyNodes[i], healthyNodes[j] = healthyNodes[j], healthyNodes[i]
+	selected := network._SelectNodes(requestType)
+	if len(selected) == 0 {
+		return network._GetNode()
 	}
-	for i := 0; i < nodesForTransaction; i++ {
-		nodes = append(nodes, healthyNodes[i].(*_Node).accountID)
+
+	node, ok := network._GetNodeForAccountID(selected[0].AccountID)
+	if !ok {
+		return network._GetNode()
 	}
-	return nodes
+	return node
+}
+
+// _SelectNodes orders the healthy nodes with the node selector, falling back to a random order if the selector
+// returns no nodes
+func (network *_Network) _SelectNodes(requestType RequestType) []NodeHealth {
+	network.healthyNodesMutex.RLock()
+	healthyNodes := make([]NodeHealth, 0, len(network.healthyNodes))
+	for _, node := range network.healthyNodes {
+		if n, ok := node.(*_Node); ok {
+			nodeHealth := n._GetHealth()
+			nodeHealth.Stake = network.addressBook[nodeHealth.AccountID].Stake
+			healthyNodes = append(healthyNodes, nodeHealth)
+		}
+	}
+	network.healthyNodesMutex.RUnlock()
+
+	var selected []NodeHealth
+	if network.selector != nil {
+		selected = network.selector.SelectNodes(requestType, healthyNodes)
+	}
+	if len(selected) == 0 {
+		selected = NewRandomNodeSelector().SelectNodes(requestType, healthyNodes)
+	}
+	return selected
 }
 
 func (network *_Network) _SetMaxNodesPerTransaction(max int) {
@@ -170,6 +208,26 @@ func (network *_Network) _SetTransportSecurity(transportSecurity bool) *_Network
 	return network
 }
 
+// _SetTransport sets the transport of every node. If the network was set from an address book with endpoints for the
+// transport, the nodes are replaced with the ones at these endpoints.
+func (network *_Network) _SetTransport(transport Transport) error {
+	network.transport = transport
+
+	for _, node := range network._ManagedNetwork.nodes {
+		if node, ok := node.(*_Node); ok {
+			if err := node._SetTransport(transport); err != nil {
+				return err
+			}
+		}
+	}
+
+	if net := network._ToNet(); len(net) > 0 {
+		return network.SetNetwork(net)
+	}
+
+	return nil
+}
+
 func (network *_Network) _SetVerifyCertificate(verify bool) *_ManagedNetwork {
 	return network._ManagedNetwork._SetVerifyCertificate(verify)
 }
@@ -229,13 +287,37 @@ func _NetworkForPreviewnet(nodeAddresses map[AccountID]NodeAddress) *_Network {
 }
 
 func (network *_Network) _SetNetworkFromAddressBook(addressBook NodeAddressBook) {
+	network.healthyNodesMutex.Lock()
 	network.addressBook = addressBook._ToMap()
+	network.healthyNodesMutex.Unlock()
+
 	_ = network.SetNetwork(network._ToNet())
 }
 
+// _GetAddressBook returns the address book the network was last set from, ordered by node ID
+func (network *_Network) _GetAddressBook() NodeAddressBook {
+	network.healthyNodesMutex.RLock()
+	defer network.healthyNodesMutex.RUnlock()
+
+	addresses := make([]NodeAddress, 0, len(network.addressBook))
+	for _, address := range network.addressBook {
+		addresses = append(addresses, address)
+	}
+	_SortNodeAddresses(addresses)
+
+	return NodeAddressBook{NodeAddresses: addresses}
+}
+
 func (network *_Network) _ToNet() map[string]AccountID {
 	newNetwork := make(map[string]AccountID)
 	for accountID, node := range network.addressBook {
+		if network.transport == TransportGrpcWeb {
+			if node.GrpcWebProxyEndpoint != nil {
+				newNetwork[node.GrpcWebProxyEndpoint.String()] = accountID
+			}
+			continue
+		}
+
 		for _, address := range node.Addresses {
 			newNetwork[address.String()] = accountID
 		}
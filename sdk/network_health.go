@@ -0,0 +1,88 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"time"
+)
+
+// NodeHealth is a snapshot of the statistics the client keeps about a consensus node address.
+type NodeHealth struct {
+	// Address is the address of the node, a node with several addresses is reported once per address.
+	Address string
+	// AccountID is the account ID of the node.
+	AccountID AccountID
+	// Healthy is false while the node is excluded from requests until its readmit time.
+	Healthy bool
+	// CurrentBackoff is how long the node is excluded after its next failure.
+	CurrentBackoff time.Duration
+	// Attempts is the number of failed requests which increased the backoff of the node.
+	Attempts int64
+	// UseCount is the number of times the node was selected for a request.
+	UseCount int64
+	// LastUsed is when the node was last selected for a request.
+	LastUsed time.Time
+	// ReadmitTime is when an unhealthy node is readmitted, or nil if it never failed.
+	ReadmitTime *time.Time
+	// AverageLatency is the moving average of the response times of successful requests, or zero if none succeeded.
+	AverageLatency time.Duration
+	// LastError is the last gRPC error, connection error or node status such as BUSY, or nil.
+	LastError error
+	// LastErrorTime is when LastError occurred, or nil.
+	LastErrorTime *time.Time
+	// Stake is the amount of tinybars staked to the node according to the address book, or zero if unknown.
+	Stake int64
+}
+
+// PingResult is the result of pinging a single node.
+type PingResult struct {
+	AccountID AccountID
+	// Latency is the time the ping took, including retries.
+	Latency time.Duration
+	// Err is the error returned by the ping, or nil if the node responded.
+	Err error
+}
+
+func (node *_Node) _GetHealth() NodeHealth {
+	node.mutex.RLock()
+	defer node.mutex.RUnlock()
+
+	var readmitTime *time.Time
+	if node.readmitTime != nil {
+		readmit := *node.readmitTime
+		readmitTime = &readmit
+	}
+
+	return NodeHealth{
+		Address:        node._GetAddress(),
+		AccountID:      node.accountID,
+		Healthy:        readmitTime == nil || readmitTime.Before(time.Now()),
+		CurrentBackoff: node.currentBackoff,
+		Attempts:       node.badGrpcStatusCount,
+		UseCount:       node.useCount,
+		LastUsed:       node.lastUsed,
+		ReadmitTime:    readmitTime,
+		AverageLatency: node.averageLatency,
+		LastError:      node.lastError,
+		LastErrorTime:  node.lastErrorTime,
+	}
+}
+
+// _GetHealth returns the health of every node address, ordered by account ID and address
+func (network *_Network) _GetHealth() []NodeHealth {
+	network.healthyNodesMutex.RLock()
+	nodes := network.nodes
+	addressBook := network.addressBook
+	network.healthyNodesMutex.RUnlock()
+
+	health := make([]NodeHealth, 0, len(nodes))
+	for _, node := range nodes {
+		if n, ok := node.(*_Node); ok {
+			nodeHealth := n._GetHealth()
+			nodeHealth.Stake = addressBook[nodeHealth.AccountID].Stake
+			health = append(health, nodeHealth)
+		}
+	}
+
+	return _SortNodeHealth(health)
+}
@@ -0,0 +1,111 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func _NetworkHealthBalance(precheckCode services.ResponseCodeEnum) *services.Response {
+	return &services.Response{
+		Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+				Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: precheckCode},
+				AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1800}},
+				Balance:   1,
+			},
+		},
+	}
+}
+
+func TestUnitMockGetNetworkHealth(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		status.Error(codes.Unavailable, "unavailable"),
+	}, {
+		_NetworkHealthBalance(services.ResponseCodeEnum_BUSY),
+		_NetworkHealthBalance(services.ResponseCodeEnum_OK),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+	client.SetNodeMinBackoff(time.Minute)
+	client.SetNodeMaxBackoff(time.Hour)
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetAccountID(AccountID{Account: 1800}).
+		SetMinBackoff(0).
+		Execute(client)
+	require.NoError(t, err)
+
+	health := client.GetNetworkHealth()
+	require.Len(t, health, 2)
+
+	failed := health[0]
+	assert.Equal(t, AccountID{Account: 3}, failed.AccountID)
+	assert.NotEmpty(t, failed.Address)
+	assert.False(t, failed.Healthy)
+	assert.Equal(t, int64(1), failed.Attempts)
+	assert.Equal(t, int64(1), failed.UseCount)
+	require.NotNil(t, failed.ReadmitTime)
+	assert.True(t, failed.ReadmitTime.After(time.Now()))
+	assert.Zero(t, failed.AverageLatency)
+	assert.Equal(t, codes.Unavailable, status.Code(failed.LastError))
+	assert.NotNil(t, failed.LastErrorTime)
+
+	busy := health[1]
+	assert.Equal(t, AccountID{Account: 4}, busy.AccountID)
+	assert.True(t, busy.Healthy)
+	assert.Equal(t, int64(0), busy.Attempts)
+	assert.Equal(t, int64(2), busy.UseCount)
+	assert.Nil(t, busy.ReadmitTime)
+	assert.Positive(t, busy.AverageLatency)
+	assert.Equal(t, ErrHederaPreCheckStatus{Status: StatusBusy}, busy.LastError)
+}
+
+func TestUnitMockPingAllWithResults(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		_NetworkHealthBalance(services.ResponseCodeEnum_OK),
+	}, {
+		_NetworkHealthBalance(services.ResponseCodeEnum_INVALID_ACCOUNT_ID),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	results := client.PingAllWithResults()
+	require.Len(t, results, 2)
+
+	assert.Equal(t, AccountID{Account: 3}, results[0].AccountID)
+	assert.NoError(t, results[0].Err)
+	assert.Positive(t, results[0].Latency)
+
+	assert.Equal(t, AccountID{Account: 4}, results[1].AccountID)
+	assert.ErrorIs(t, results[1].Err, ErrHederaPreCheckStatus{Status: StatusInvalidAccountID})
+}
+
+func TestUnitManagedNodeRecordLatency(t *testing.T) {
+	t.Parallel()
+
+	node, err := _NewManagedNode("127.0.0.1:50211", time.Second)
+	require.NoError(t, err)
+
+	node._RecordLatency(100 * time.Millisecond)
+	require.Equal(t, 100*time.Millisecond, node._GetAverageLatency())
+
+	node._RecordLatency(200 * time.Millisecond)
+	require.Equal(t, 120*time.Millisecond, node._GetAverageLatency())
+}
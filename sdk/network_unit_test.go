@@ -145,7 +145,7 @@ func TestUnitConcurrentNodeGetChannel(t *testing.T) {
 	logger := NewLogger("", LoggerLevelError)
 	for i := 0; i < numThreads; i++ {
 		go func() {
-			node._GetChannel(logger)
+			node._GetChannel(logger, nil)
 			wg.Done()
 		}()
 	}
@@ -0,0 +1,681 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+)
+
+const (
+	nftMintDefaultConcurrency = 4
+	// nftTransferMaxBatch is the maximum number of NFT transfers of a single TransferTransaction
+	nftTransferMaxBatch = 10
+)
+
+// NftMintCheckpointBatch is the progress of a single mint or transfer transaction of an NftCollectionMinter.
+type NftMintCheckpointBatch struct {
+	TransactionID TransactionID
+	// Transaction is the frozen and signed transaction, stored before it is submitted so it is submitted again,
+	// instead of minting the batch twice, after a restart.
+	Transaction []byte
+	// SerialNumbers are the serial numbers of the NFTs minted or transferred by the batch.
+	SerialNumbers []int64
+	Completed     bool
+}
+
+// NftMintCheckpoint is the progress of an NftCollectionMinter, by batch index. The batch i mints the metadata, or
+// transfers the serial numbers, i*BatchSize to (i+1)*BatchSize-1.
+type NftMintCheckpoint struct {
+	TokenID   TokenID
+	BatchSize int
+	Mints     map[int]NftMintCheckpointBatch
+	Transfers map[int]NftMintCheckpointBatch
+}
+
+// ErrNftMintBatchUnresolved is returned for a batch of an NftCollectionMinter whose stored transaction expired before
+// its receipt was known, when the mirror node could not be asked whether it reached consensus. The batch keeps the
+// stored transaction, so the next Mint or Distribute asks the mirror node again instead of creating a new transaction.
+// Without a mirror node the transaction has to be looked up manually and the checkpoint of the batch completed or
+// cleared.
+type ErrNftMintBatchUnresolved struct {
+	TransactionID TransactionID
+	Err           error
+}
+
+func (e ErrNftMintBatchUnresolved) Error() string {
+	return fmt.Sprintf("transaction %s expired and whether it reached consensus is unknown: %s", e.TransactionID.String(), e.Err.Error())
+}
+
+func (e ErrNftMintBatchUnresolved) Unwrap() error {
+	return e.Err
+}
+
+// NftMintCheckpointStore persists the checkpoint of an NftCollectionMinter.
+type NftMintCheckpointStore interface {
+	// Load returns the saved checkpoint, and nil if none was saved.
+	Load() (*NftMintCheckpoint, error)
+	Save(checkpoint NftMintCheckpoint) error
+}
+
+// FileNftMintCheckpointStore stores the checkpoint in a single file, which is replaced atomically.
+type FileNftMintCheckpointStore struct {
+	path string
+}
+
+// NewFileNftMintCheckpointStore returns a store which keeps the checkpoint in the file at path.
+func NewFileNftMintCheckpointStore(path string) *FileNftMintCheckpointStore {
+	return &FileNftMintCheckpointStore{path: path}
+}
+
+// GetPath returns the path of the checkpoint file.
+func (store *FileNftMintCheckpointStore) GetPath() string {
+	return store.path
+}
+
+func (store *FileNftMintCheckpointStore) Load() (*NftMintCheckpoint, error) {
+	data, err := os.ReadFile(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, err := NftMintCheckpointFromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
+func (store *FileNftMintCheckpointStore) Save(checkpoint NftMintCheckpoint) error {
+	data, err := checkpoint.ToBytes()
+	if err != nil {
+		return err
+	}
+
+	return _WriteFileAtomic(filepath.Dir(store.path), "."+filepath.Base(store.path)+"-*", store.path, data)
+}
+
+// MemoryNftMintCheckpointStore keeps the checkpoint in memory, so it does not survive a restart. It is meant for tests.
+type MemoryNftMintCheckpointStore struct {
+	data []byte
+}
+
+// NewMemoryNftMintCheckpointStore returns an empty in-memory store.
+func NewMemoryNftMintCheckpointStore() *MemoryNftMintCheckpointStore {
+	return &MemoryNftMintCheckpointStore{}
+}
+
+func (store *MemoryNftMintCheckpointStore) Load() (*NftMintCheckpoint, error) {
+	if store.data == nil {
+		return nil, nil
+	}
+
+	checkpoint, err := NftMintCheckpointFromBytes(store.data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
+func (store *MemoryNftMintCheckpointStore) Save(checkpoint NftMintCheckpoint) error {
+	data, err := checkpoint.ToBytes()
+	if err != nil {
+		return err
+	}
+	store.data = data
+
+	return nil
+}
+
+type _NftMintCheckpointJSON struct {
+	TokenID   string                              `json:"tokenId"`
+	BatchSize int                                 `json:"batchSize"`
+	Mints     map[int]_NftMintCheckpointBatchJSON `json:"mints,omitempty"`
+	Transfers map[int]_NftMintCheckpointBatchJSON `json:"transfers,omitempty"`
+}
+
+type _NftMintCheckpointBatchJSON struct {
+	TransactionID []byte  `json:"transactionId"`
+	Transaction   []byte  `json:"transaction,omitempty"`
+	SerialNumbers []int64 `json:"serialNumbers,omitempty"`
+	Completed     bool    `json:"completed"`
+}
+
+// ToBytes returns the JSON representation of the checkpoint, which NftMintCheckpointFromBytes reads.
+func (checkpoint NftMintCheckpoint) ToBytes() ([]byte, error) {
+	convert := func(batches map[int]NftMintCheckpointBatch) map[int]_NftMintCheckpointBatchJSON {
+		raw := make(map[int]_NftMintCheckpointBatchJSON, len(batches))
+		for index, batch := range batches {
+			raw[index] = _NftMintCheckpointBatchJSON{
+				TransactionID: batch.TransactionID.ToBytes(),
+				Transaction:   batch.Transaction,
+				SerialNumbers: batch.SerialNumbers,
+				Completed:     batch.Completed,
+			}
+		}
+		return raw
+	}
+
+	return json.Marshal(_NftMintCheckpointJSON{
+		TokenID:   checkpoint.TokenID.String(),
+		BatchSize: checkpoint.BatchSize,
+		Mints:     convert(checkpoint.Mints),
+		Transfers: convert(checkpoint.Transfers),
+	})
+}
+
+// NftMintCheckpointFromBytes returns the checkpoint from its JSON representation, as returned by
+// NftMintCheckpoint.ToBytes.
+func NftMintCheckpointFromBytes(data []byte) (NftMintCheckpoint, error) {
+	var raw _NftMintCheckpointJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return NftMintCheckpoint{}, err
+	}
+
+	tokenID, err := TokenIDFromString(raw.TokenID)
+	if err != nil {
+		return NftMintCheckpoint{}, err
+	}
+
+	convert := func(raw map[int]_NftMintCheckpointBatchJSON) (map[int]NftMintCheckpointBatch, error) {
+		batches := make(map[int]NftMintCheckpointBatch, len(raw))
+		for index, batch := range raw {
+			transactionID, err := TransactionIDFromBytes(batch.TransactionID)
+			if err != nil {
+				return nil, err
+			}
+			batches[index] = NftMintCheckpointBatch{
+				TransactionID: transactionID,
+				Transaction:   batch.Transaction,
+				SerialNumbers: batch.SerialNumbers,
+				Completed:     batch.Completed,
+			}
+		}
+		return batches, nil
+	}
+
+	checkpoint := NftMintCheckpoint{TokenID: tokenID, BatchSize: raw.BatchSize}
+	if checkpoint.Mints, err = convert(raw.Mints); err != nil {
+		return NftMintCheckpoint{}, err
+	}
+	if checkpoint.Transfers, err = convert(raw.Transfers); err != nil {
+		return NftMintCheckpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+// NftCollectionMinter mints the NFTs of a collection in batches of TokenMintTransactions, executed with bounded
+// concurrency, and optionally transfers them from the treasury to their recipients.
+//
+// With a checkpoint store, every batch is saved before it is submitted and once its receipt is known. Calling Mint or
+// Distribute again with the same metadata or recipients after a crash skips the completed batches and submits the
+// stored transactions of the unfinished ones again, like an Outbox, so no NFT is minted or transferred twice. A stored
+// transaction which expired before its receipt was known is looked up on the mirror node: the batch is completed if
+// it succeeded and a new transaction is only created if it did not reach consensus. The batch is left unfinished with
+// an ErrNftMintBatchUnresolved while the mirror node cannot tell.
+type NftCollectionMinter struct {
+	client            *Client
+	tokenID           TokenID
+	treasuryAccountID *AccountID
+	batchSize         int
+	maxConcurrency    int
+	maxTransactionFee *Hbar
+	store             NftMintCheckpointStore
+	signers           []Signer
+
+	mutex      sync.Mutex
+	checkpoint NftMintCheckpoint
+}
+
+// NewNftCollectionMinter creates a minter of the NFTs of the token.
+func NewNftCollectionMinter(client *Client, tokenID TokenID) *NftCollectionMinter {
+	return &NftCollectionMinter{
+		client:         client,
+		tokenID:        tokenID,
+		batchSize:      tokenMintMaxMetadata,
+		maxConcurrency: nftMintDefaultConcurrency,
+	}
+}
+
+// GetTokenID returns the token whose NFTs are minted.
+func (minter *NftCollectionMinter) GetTokenID() TokenID {
+	return minter.tokenID
+}
+
+// SetBatchSize sets the number of NFTs minted or transferred by a single transaction, at most 10.
+func (minter *NftCollectionMinter) SetBatchSize(batchSize int) *NftCollectionMinter {
+	minter.batchSize = batchSize
+	return minter
+}
+
+// GetBatchSize returns the number of NFTs minted or transferred by a single transaction.
+func (minter *NftCollectionMinter) GetBatchSize() int {
+	return minter.batchSize
+}
+
+// SetMaxConcurrency sets the maximum number of transactions executed at the same time.
+func (minter *NftCollectionMinter) SetMaxConcurrency(maxConcurrency int) *NftCollectionMinter {
+	minter.maxConcurrency = maxConcurrency
+	return minter
+}
+
+// GetMaxConcurrency returns the maximum number of transactions executed at the same time.
+func (minter *NftCollectionMinter) GetMaxConcurrency() int {
+	return minter.maxConcurrency
+}
+
+// SetTreasuryAccountID sets the treasury of the token, which holds the minted NFTs until they are distributed.
+// The client operator is used if it is not set.
+func (minter *NftCollectionMinter) SetTreasuryAccountID(accountID AccountID) *NftCollectionMinter {
+	minter.treasuryAccountID = &accountID
+	return minter
+}
+
+// GetTreasuryAccountID returns the treasury of the token.
+func (minter *NftCollectionMinter) GetTreasuryAccountID() AccountID {
+	if minter.treasuryAccountID != nil {
+		return *minter.treasuryAccountID
+	}
+
+	return minter.client.GetOperatorAccountID()
+}
+
+// SetMaxTransactionFee sets the maximum fee of every transaction.
+func (minter *NftCollectionMinter) SetMaxTransactionFee(fee Hbar) *NftCollectionMinter {
+	minter.maxTransactionFee = &fee
+	return minter
+}
+
+// SetCheckpointStore sets the store of the progress, which makes Mint and Distribute resumable.
+func (minter *NftCollectionMinter) SetCheckpointStore(store NftMintCheckpointStore) *NftCollectionMinter {
+	minter.store = store
+	return minter
+}
+
+// GetCheckpointStore returns the store of the progress.
+func (minter *NftCollectionMinter) GetCheckpointStore() NftMintCheckpointStore {
+	return minter.store
+}
+
+// Sign signs every transaction with the private key, e.g. the supply key of the token or the treasury key.
+func (minter *NftCollectionMinter) Sign(privateKey PrivateKey) *NftCollectionMinter {
+	return minter.SignWithSigner(NewPrivateKeySigner(privateKey))
+}
+
+// SignWithSigner signs every transaction with the Signer.
+func (minter *NftCollectionMinter) SignWithSigner(signer Signer) *NftCollectionMinter {
+	minter.signers = append(minter.signers, signer)
+	return minter
+}
+
+// Mint mints an NFT for every metadata of the sequence and returns their serial numbers in the order of the metadata.
+//
+// The batches which fail are left unfinished in the checkpoint and the returned error joins their errors. When a
+// checkpoint store is set, Mint can be called again with the same metadata to mint them.
+func (minter *NftCollectionMinter) Mint(ctx context.Context, metadata iter.Seq[[]byte]) ([]int64, error) {
+	if err := minter._Load(); err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	batches := func(yield func(int, _NftMintJob) bool) {
+		batch := make([][]byte, 0, minter.batchSize)
+		index, position := 0, 0
+		flush := func() bool {
+			metadata := batch
+			batch = make([][]byte, 0, minter.batchSize)
+			index++
+			return yield(index-1, _NftMintJob{
+				build: func() TransactionInterface {
+					return NewTokenMintTransaction().SetTokenID(minter.tokenID).SetMetadatas(metadata)
+				},
+				serials: func(receipt TransactionReceipt) []int64 {
+					return receipt.SerialNumbers
+				},
+			})
+		}
+
+		for entry := range metadata {
+			if err := ValidateNftMetadataSize(entry); err != nil {
+				errs = append(errs, fmt.Errorf("metadata %d: %w", position, err))
+				return
+			}
+			position++
+			batch = append(batch, entry)
+			if len(batch) == minter.batchSize && !flush() {
+				return
+			}
+		}
+		if len(batch) > 0 {
+			flush()
+		}
+	}
+
+	count, runErrs := minter._Run(ctx, minter.checkpoint.Mints, batches)
+	errs = append(errs, runErrs...)
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	serials := make([]int64, 0, count*minter.batchSize)
+	for index := 0; index < count; index++ {
+		serials = append(serials, minter.checkpoint.Mints[index].SerialNumbers...)
+	}
+
+	return serials, nil
+}
+
+// Distribute transfers the NFT of every serial number from the treasury to the recipient with the same index, in
+// TransferTransactions of at most 10 NFTs. Serial numbers whose recipient is the treasury are kept by it.
+//
+// The batches which fail are left unfinished in the checkpoint and the returned error joins their errors. When a
+// checkpoint store is set, Distribute can be called again with the same serial numbers and recipients to transfer
+// them.
+func (minter *NftCollectionMinter) Distribute(ctx context.Context, serials []int64, recipients []AccountID) error {
+	if len(serials) != len(recipients) {
+		return fmt.Errorf("%d serial numbers cannot be distributed to %d recipients", len(serials), len(recipients))
+	}
+	if err := minter._Load(); err != nil {
+		return err
+	}
+
+	treasury := minter.GetTreasuryAccountID()
+	nftIDs := make([]NftID, 0, len(serials))
+	receivers := make([]AccountID, 0, len(serials))
+	for i, serial := range serials {
+		if recipients[i].Compare(treasury) != 0 {
+			nftIDs = append(nftIDs, NftID{TokenID: minter.tokenID, SerialNumber: serial})
+			receivers = append(receivers, recipients[i])
+		}
+	}
+
+	batchSize := min(minter.batchSize, nftTransferMaxBatch)
+	batches := func(yield func(int, _NftMintJob) bool) {
+		for start := 0; start < len(nftIDs); start += batchSize {
+			end := min(start+batchSize, len(nftIDs))
+			job := _NftMintJob{
+				build: func() TransactionInterface {
+					tx := NewTransferTransaction()
+					for i := start; i < end; i++ {
+						tx.AddNftTransfer(nftIDs[i], treasury, receivers[i])
+					}
+					return tx
+				},
+				serials: func(TransactionReceipt) []int64 {
+					batchSerials := make([]int64, 0, end-start)
+					for _, nftID := range nftIDs[start:end] {
+						batchSerials = append(batchSerials, nftID.SerialNumber)
+					}
+					return batchSerials
+				},
+			}
+			if !yield(start/batchSize, job) {
+				return
+			}
+		}
+	}
+
+	_, errs := minter._Run(ctx, minter.checkpoint.Transfers, batches)
+
+	return errors.Join(errs...)
+}
+
+// _Load reads the checkpoint from the store, or starts a new one
+func (minter *NftCollectionMinter) _Load() error {
+	if minter.batchSize < 1 || minter.batchSize > tokenMintMaxMetadata {
+		return fmt.Errorf("batch size must be between 1 and %d", tokenMintMaxMetadata)
+	}
+
+	minter.checkpoint = NftMintCheckpoint{
+		TokenID:   minter.tokenID,
+		BatchSize: minter.batchSize,
+		Mints:     make(map[int]NftMintCheckpointBatch),
+		Transfers: make(map[int]NftMintCheckpointBatch),
+	}
+	if minter.store == nil {
+		return nil
+	}
+
+	checkpoint, err := minter.store.Load()
+	if err != nil || checkpoint == nil {
+		return err
+	}
+	if checkpoint.TokenID.Compare(minter.tokenID) != 0 || checkpoint.BatchSize != minter.batchSize {
+		return fmt.Errorf("the checkpoint of token %s with batch size %d cannot be resumed for token %s with batch size %d",
+			checkpoint.TokenID.String(), checkpoint.BatchSize, minter.tokenID.String(), minter.batchSize)
+	}
+	if checkpoint.Mints != nil {
+		minter.checkpoint.Mints = checkpoint.Mints
+	}
+	if checkpoint.Transfers != nil {
+		minter.checkpoint.Transfers = checkpoint.Transfers
+	}
+
+	return nil
+}
+
+// _NftMintJob builds the transaction of a batch and returns the serial numbers it minted or transferred
+type _NftMintJob struct {
+	build   func() TransactionInterface
+	serials func(receipt TransactionReceipt) []int64
+}
+
+// _Run executes the batches which are not completed in the checkpoint with at most maxConcurrency workers and
+// returns the number of batches and the errors of the failed ones
+func (minter *NftCollectionMinter) _Run(
+	ctx context.Context,
+	progress map[int]NftMintCheckpointBatch,
+	batches iter.Seq2[int, _NftMintJob],
+) (int, []error) {
+	type indexedJob struct {
+		index int
+		job   _NftMintJob
+	}
+
+	jobs := make(chan indexedJob)
+	failures := make(map[int]error)
+	var wg sync.WaitGroup
+	for worker := 0; worker < max(minter.maxConcurrency, 1); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				if err := minter._Execute(ctx, progress, job.index, job.job); err != nil {
+					minter.mutex.Lock()
+					failures[job.index] = err
+					minter.mutex.Unlock()
+				}
+			}
+		}()
+	}
+
+	count := 0
+	for index, job := range batches {
+		count = index + 1
+		minter.mutex.Lock()
+		completed := progress[index].Completed
+		minter.mutex.Unlock()
+		if !completed {
+			jobs <- indexedJob{index: index, job: job}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	indices := make([]int, 0, len(failures))
+	for index := range failures {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	errs := make([]error, 0, len(indices))
+	for _, index := range indices {
+		errs = append(errs, fmt.Errorf("batch %d: %w", index, failures[index]))
+	}
+
+	return count, errs
+}
+
+// _Execute submits the stored transaction of the batch, or a new one which is saved first, and saves the outcome
+func (minter *NftCollectionMinter) _Execute(
+	ctx context.Context,
+	progress map[int]NftMintCheckpointBatch,
+	index int,
+	job _NftMintJob,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	minter.mutex.Lock()
+	batch := progress[index]
+	minter.mutex.Unlock()
+
+	var tx TransactionInterface
+	var err error
+	if batch.Transaction != nil {
+		if tx, err = TransactionFromBytes(batch.Transaction); err != nil {
+			return err
+		}
+	} else {
+		if tx, err = minter._Freeze(job.build()); err != nil {
+			return err
+		}
+		if batch.Transaction, err = TransactionToBytes(tx); err != nil {
+			return err
+		}
+		if batch.TransactionID, err = TransactionGetTransactionID(tx); err != nil {
+			return err
+		}
+		if err = minter._Save(progress, index, batch); err != nil {
+			return err
+		}
+	}
+
+	entry, err := NewOutbox(minter.client, NewMemoryOutboxStore()).Submit(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	receipt := entry.Receipt
+	if entry.State == OutboxStateFailed {
+		receipt = nil
+		if entry.Status == StatusTransactionExpired || entry.Status == StatusReceiptNotFound {
+			// the receipt is no longer kept, so only the mirror node knows whether the transaction reached consensus
+			if receipt, err = minter._Recover(ctx, tx, batch.TransactionID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if receipt == nil {
+		// the transaction can never succeed, the next attempt creates a new one
+		batch.Transaction = nil
+		if err := minter._Save(progress, index, batch); err != nil {
+			return err
+		}
+		if entry.LastError != "" {
+			return errors.New(entry.LastError)
+		}
+		return fmt.Errorf("transaction %s failed with %s", entry.TransactionID.String(), entry.Status.String())
+	}
+
+	batch.Transaction = nil
+	batch.SerialNumbers = job.serials(*receipt)
+	batch.Completed = true
+
+	return minter._Save(progress, index, batch)
+}
+
+// _Recover looks up an expired transaction on the mirror node. It returns a receipt with the serial numbers minted by
+// the transaction if it succeeded, and nil if it did not reach consensus or failed.
+func (minter *NftCollectionMinter) _Recover(ctx context.Context, tx TransactionInterface, transactionID TransactionID) (*TransactionReceipt, error) {
+	mirror, err := minter.client.GetMirrorRestClient()
+	if err != nil {
+		return nil, ErrNftMintBatchUnresolved{TransactionID: transactionID, Err: err}
+	}
+
+	transactions, err := mirror.GetTransaction(ctx, transactionID)
+	var statusErr ErrMirrorRestStatus
+	if errors.As(err, &statusErr) && statusErr.IsNotFound() {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ErrNftMintBatchUnresolved{TransactionID: transactionID, Err: err}
+	}
+
+	hashes, err := TransactionGetTransactionHashPerNode(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transaction := range transactions {
+		if transaction.ParentConsensusTimestamp != nil || transaction.Result != StatusSuccess.String() {
+			continue
+		}
+
+		for _, hash := range hashes {
+			if !bytes.Equal(hash, transaction.TransactionHash) {
+				continue
+			}
+
+			// minted NFTs are transferred to the treasury without a sender
+			receipt := TransactionReceipt{Status: StatusSuccess, SerialNumbers: make([]int64, 0)}
+			for _, transfer := range transaction.NftTransfers {
+				if transfer.SenderID == nil && transfer.NftID.TokenID.Compare(minter.tokenID) == 0 {
+					receipt.SerialNumbers = append(receipt.SerialNumbers, transfer.NftID.SerialNumber)
+				}
+			}
+			slices.Sort(receipt.SerialNumbers)
+
+			return &receipt, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (minter *NftCollectionMinter) _Freeze(tx TransactionInterface) (TransactionInterface, error) {
+	var err error
+	if minter.maxTransactionFee != nil {
+		if _, err = TransactionSetMaxTransactionFee(tx, *minter.maxTransactionFee); err != nil {
+			return nil, err
+		}
+	}
+	if _, err = TransactionFreezeWith(tx, minter.client); err != nil {
+		return nil, err
+	}
+	for _, signer := range minter.signers {
+		if _, err = TransactionSignWithSigner(tx, signer); err != nil {
+			return nil, err
+		}
+	}
+
+	return tx, nil
+}
+
+func (minter *NftCollectionMinter) _Save(progress map[int]NftMintCheckpointBatch, index int, batch NftMintCheckpointBatch) error {
+	minter.mutex.Lock()
+	defer minter.mutex.Unlock()
+
+	progress[index] = batch
+	if minter.store == nil {
+		return nil
+	}
+
+	return minter.store.Save(minter.checkpoint)
+}
@@ -0,0 +1,402 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func _NftMintReceipt(serials ...int64) *services.Response {
+	return &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+				Receipt: &services.TransactionReceipt{Status: services.ResponseCodeEnum_SUCCESS, SerialNumbers: serials},
+			},
+		},
+	}
+}
+
+// _NftMintBody answers a transaction with OK and passes its body to bodies
+func _NftMintBody(t *testing.T, bodies *[]*services.TransactionBody) func(request *services.Transaction) *services.TransactionResponse {
+	return func(request *services.Transaction) *services.TransactionResponse {
+		var signed services.SignedTransaction
+		require.NoError(t, protobuf.Unmarshal(request.GetSignedTransactionBytes(), &signed))
+		var body services.TransactionBody
+		require.NoError(t, protobuf.Unmarshal(signed.GetBodyBytes(), &body))
+		*bodies = append(*bodies, &body)
+
+		return &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK}
+	}
+}
+
+func _NftMintMetadata(count int) [][]byte {
+	metadata := make([][]byte, count)
+	for i := range metadata {
+		metadata[i] = []byte{byte(i)}
+	}
+
+	return metadata
+}
+
+func TestUnitNftCollectionMinterMint(t *testing.T) {
+	t.Parallel()
+
+	var bodies []*services.TransactionBody
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_NftMintBody(t, &bodies),
+		_NftMintReceipt(1, 2, 3, 4, 5, 6, 7, 8, 9, 10),
+		_NftMintBody(t, &bodies),
+		_NftMintReceipt(11, 12, 13, 14, 15, 16, 17, 18, 19, 20),
+		_NftMintBody(t, &bodies),
+		_NftMintReceipt(21, 22, 23),
+	}})
+	defer server.Close()
+
+	store := NewFileNftMintCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	minter := NewNftCollectionMinter(client, TokenID{Token: 500}).
+		SetMaxConcurrency(1).
+		SetMaxTransactionFee(NewHbar(20)).
+		SetCheckpointStore(store)
+	assert.Equal(t, TokenID{Token: 500}, minter.GetTokenID())
+	assert.Equal(t, 10, minter.GetBatchSize())
+	assert.Equal(t, 1, minter.GetMaxConcurrency())
+	assert.Equal(t, AccountID{Account: 1800}, minter.GetTreasuryAccountID())
+	assert.Equal(t, store, minter.GetCheckpointStore())
+
+	metadata := _NftMintMetadata(23)
+	serials, err := minter.Mint(context.Background(), slices.Values(metadata))
+	require.NoError(t, err)
+	require.Len(t, serials, 23)
+	assert.Equal(t, int64(1), serials[0])
+	assert.Equal(t, int64(23), serials[22])
+
+	require.Len(t, bodies, 3)
+	assert.Equal(t, metadata[10:20], bodies[1].GetTokenMint().GetMetadata())
+	assert.Equal(t, uint64(NewHbar(20).AsTinybar()), bodies[0].GetTransactionFee())
+
+	checkpoint, err := store.Load()
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	assert.Len(t, checkpoint.Mints, 3)
+	for _, batch := range checkpoint.Mints {
+		assert.True(t, batch.Completed)
+		assert.Nil(t, batch.Transaction)
+	}
+
+	// minting again with the checkpoint returns the serial numbers without submitting anything
+	serials, err = minter.Mint(context.Background(), slices.Values(metadata))
+	require.NoError(t, err)
+	assert.Len(t, serials, 23)
+	assert.Len(t, bodies, 3)
+}
+
+func TestUnitNftCollectionMinterResume(t *testing.T) {
+	t.Parallel()
+
+	var bodies []*services.TransactionBody
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_NftMintBody(t, &bodies),
+		_NftMintReceipt(11, 12),
+	}})
+	defer server.Close()
+
+	// the process stopped after storing the second batch, before its receipt was known
+	store := NewMemoryNftMintCheckpointStore()
+	minter := NewNftCollectionMinter(client, TokenID{Token: 500}).SetBatchSize(2).SetCheckpointStore(store)
+	metadata := _NftMintMetadata(4)
+
+	stored, err := minter._Freeze(NewTokenMintTransaction().SetTokenID(TokenID{Token: 500}).SetMetadatas(metadata[2:]))
+	require.NoError(t, err)
+	storedBytes, err := TransactionToBytes(stored)
+	require.NoError(t, err)
+	storedID, err := TransactionGetTransactionID(stored)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Save(NftMintCheckpoint{
+		TokenID:   TokenID{Token: 500},
+		BatchSize: 2,
+		Mints: map[int]NftMintCheckpointBatch{
+			0: {TransactionID: TransactionIDGenerate(AccountID{Account: 1800}), SerialNumbers: []int64{1, 2}, Completed: true},
+			1: {TransactionID: storedID, Transaction: storedBytes},
+		},
+	}))
+
+	serials, err := minter.Mint(context.Background(), slices.Values(metadata))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 11, 12}, serials)
+
+	// the stored transaction was submitted instead of a new one
+	require.Len(t, bodies, 1)
+	assert.Equal(t, storedID.String(), _TransactionIDFromProtobuf(bodies[0].GetTransactionID()).String())
+
+	// a checkpoint of another collection is not resumed
+	_, err = NewNftCollectionMinter(client, TokenID{Token: 600}).SetBatchSize(2).SetCheckpointStore(store).
+		Mint(context.Background(), slices.Values(metadata))
+	require.ErrorContains(t, err, "cannot be resumed")
+}
+
+func TestUnitNftCollectionMinterFailed(t *testing.T) {
+	t.Parallel()
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_INVALID_SIGNATURE),
+	}})
+	defer server.Close()
+
+	store := NewMemoryNftMintCheckpointStore()
+	minter := NewNftCollectionMinter(client, TokenID{Token: 500}).SetCheckpointStore(store)
+
+	serials, err := minter.Mint(context.Background(), slices.Values(_NftMintMetadata(3)))
+	require.ErrorContains(t, err, "batch 0")
+	require.ErrorContains(t, err, StatusInvalidSignature.String())
+	assert.Nil(t, serials)
+
+	// the failed transaction is not submitted again
+	checkpoint, err := store.Load()
+	require.NoError(t, err)
+	assert.False(t, checkpoint.Mints[0].Completed)
+	assert.Nil(t, checkpoint.Mints[0].Transaction)
+
+	_, err = minter.Mint(context.Background(), slices.Values([][]byte{make([]byte, 101)}))
+	require.ErrorAs(t, err, &ErrNftMetadataTooLarge{})
+
+	_, err = minter.SetBatchSize(11).Mint(context.Background(), slices.Values(_NftMintMetadata(1)))
+	require.ErrorContains(t, err, "batch size")
+
+	err = minter.SetBatchSize(10).Distribute(context.Background(), []int64{1}, nil)
+	require.ErrorContains(t, err, "1 serial numbers cannot be distributed to 0 recipients")
+}
+
+func TestUnitNftCollectionMinterBusy(t *testing.T) {
+	t.Parallel()
+
+	var bodies []*services.TransactionBody
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_OutboxPrecheck(services.ResponseCodeEnum_BUSY),
+		_NftMintBody(t, &bodies),
+		_NftMintReceipt(1, 2, 3),
+	}})
+	defer server.Close()
+	client.SetMaxAttempts(1)
+
+	store := NewMemoryNftMintCheckpointStore()
+	minter := NewNftCollectionMinter(client, TokenID{Token: 500}).SetCheckpointStore(store)
+	metadata := _NftMintMetadata(3)
+
+	_, err := minter.Mint(context.Background(), slices.Values(metadata))
+	require.ErrorContains(t, err, StatusBusy.String())
+
+	// the transaction rejected by a busy node is kept and submitted again
+	checkpoint, err := store.Load()
+	require.NoError(t, err)
+	assert.False(t, checkpoint.Mints[0].Completed)
+	require.NotNil(t, checkpoint.Mints[0].Transaction)
+	storedID := checkpoint.Mints[0].TransactionID
+
+	serials, err := minter.Mint(context.Background(), slices.Values(metadata))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, serials)
+	require.Len(t, bodies, 1)
+	assert.Equal(t, storedID.String(), _TransactionIDFromProtobuf(bodies[0].GetTransactionID()).String())
+}
+
+// _NftMintReceiptNotFound answers a receipt query with RECEIPT_NOT_FOUND, as the nodes do once the receipt of an
+// expired transaction is no longer kept
+func _NftMintReceiptNotFound() *services.Response {
+	return &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_RECEIPT_NOT_FOUND},
+			},
+		},
+	}
+}
+
+func TestUnitNftCollectionMinterExpired(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		mirror func(t *testing.T, stored TransactionInterface, w http.ResponseWriter)
+		check  func(t *testing.T, minter *NftCollectionMinter, store NftMintCheckpointStore, storedID TransactionID, bodies *[]*services.TransactionBody)
+	}{
+		{
+			// the stored transaction succeeded before it expired, so its serial numbers are taken from the mirror node
+			name: "succeeded",
+			mirror: func(t *testing.T, stored TransactionInterface, w http.ResponseWriter) {
+				hashes, err := TransactionGetTransactionHashPerNode(stored)
+				require.NoError(t, err)
+				storedID, err := TransactionGetTransactionID(stored)
+				require.NoError(t, err)
+
+				for _, hash := range hashes {
+					fmt.Fprintf(w, `{"transactions": [{
+						"transaction_id": "%s", "consensus_timestamp": "1700000000.000000001", "name": "TOKENMINT",
+						"result": "SUCCESS", "transaction_hash": "%s",
+						"nft_transfers": [
+							{"token_id": "0.0.500", "serial_number": 12, "receiver_account_id": "0.0.1800"},
+							{"token_id": "0.0.500", "serial_number": 11, "receiver_account_id": "0.0.1800"}
+						]}]}`, _MirrorTransactionIDToString(storedID), base64.StdEncoding.EncodeToString(hash))
+					return
+				}
+			},
+			check: func(t *testing.T, minter *NftCollectionMinter, store NftMintCheckpointStore, _ TransactionID, bodies *[]*services.TransactionBody) {
+				serials, err := minter.Mint(context.Background(), slices.Values(_NftMintMetadata(4)))
+				require.NoError(t, err)
+				assert.Equal(t, []int64{1, 2, 11, 12}, serials)
+				assert.Empty(t, *bodies)
+			},
+		},
+		{
+			// the stored transaction never reached consensus, so a new one is created
+			name: "not found",
+			mirror: func(_ *testing.T, _ TransactionInterface, w http.ResponseWriter) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+			check: func(t *testing.T, minter *NftCollectionMinter, store NftMintCheckpointStore, storedID TransactionID, bodies *[]*services.TransactionBody) {
+				_, err := minter.Mint(context.Background(), slices.Values(_NftMintMetadata(4)))
+				require.ErrorContains(t, err, "batch 1")
+
+				checkpoint, err := store.Load()
+				require.NoError(t, err)
+				assert.Nil(t, checkpoint.Mints[1].Transaction)
+
+				serials, err := minter.Mint(context.Background(), slices.Values(_NftMintMetadata(4)))
+				require.NoError(t, err)
+				assert.Equal(t, []int64{1, 2, 11, 12}, serials)
+				require.Len(t, *bodies, 1)
+				assert.NotEqual(t, storedID.String(), _TransactionIDFromProtobuf((*bodies)[0].GetTransactionID()).String())
+			},
+		},
+		{
+			// the mirror node cannot tell, so the stored transaction is kept
+			name: "unresolved",
+			mirror: func(_ *testing.T, _ TransactionInterface, w http.ResponseWriter) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			check: func(t *testing.T, minter *NftCollectionMinter, store NftMintCheckpointStore, storedID TransactionID, bodies *[]*services.TransactionBody) {
+				_, err := minter.Mint(context.Background(), slices.Values(_NftMintMetadata(4)))
+				var unresolved ErrNftMintBatchUnresolved
+				require.ErrorAs(t, err, &unresolved)
+				assert.Equal(t, storedID.String(), unresolved.TransactionID.String())
+
+				checkpoint, err := store.Load()
+				require.NoError(t, err)
+				assert.False(t, checkpoint.Mints[1].Completed)
+				assert.NotNil(t, checkpoint.Mints[1].Transaction)
+				assert.Empty(t, *bodies)
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var bodies []*services.TransactionBody
+			client, server := NewMockClientAndServer([][]interface{}{{
+				_OutboxPrecheck(services.ResponseCodeEnum_TRANSACTION_EXPIRED),
+				_NftMintReceiptNotFound(),
+				_NftMintBody(t, &bodies),
+				_NftMintReceipt(11, 12),
+			}})
+			defer server.Close()
+			client.SetMaxAttempts(1)
+
+			// the process stopped after storing the second batch and was restarted long after it expired
+			store := NewMemoryNftMintCheckpointStore()
+			minter := NewNftCollectionMinter(client, TokenID{Token: 500}).SetBatchSize(2).SetCheckpointStore(store)
+			storedID := NewTransactionIDWithValidStart(AccountID{Account: 1800}, time.Now().Add(-10*time.Minute))
+			stored, err := minter._Freeze(NewTokenMintTransaction().
+				SetTransactionID(storedID).
+				SetTokenID(TokenID{Token: 500}).
+				SetMetadatas(_NftMintMetadata(4)[2:]))
+			require.NoError(t, err)
+			storedBytes, err := TransactionToBytes(stored)
+			require.NoError(t, err)
+
+			require.NoError(t, store.Save(NftMintCheckpoint{
+				TokenID:   TokenID{Token: 500},
+				BatchSize: 2,
+				Mints: map[int]NftMintCheckpointBatch{
+					0: {TransactionID: TransactionIDGenerate(AccountID{Account: 1800}), SerialNumbers: []int64{1, 2}, Completed: true},
+					1: {TransactionID: storedID, Transaction: storedBytes},
+				},
+			}))
+
+			mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/transactions/"+_MirrorTransactionIDToString(storedID), r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				test.mirror(t, stored, w)
+			}))
+			defer mirror.Close()
+			client.SetMirrorRestApiBaseUrl(mirror.URL + "/api/v1")
+
+			test.check(t, minter, store, storedID, &bodies)
+		})
+	}
+}
+
+func TestUnitNftCollectionMinterDistribute(t *testing.T) {
+	t.Parallel()
+
+	var bodies []*services.TransactionBody
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_NftMintBody(t, &bodies),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+		_NftMintBody(t, &bodies),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+	}})
+	defer server.Close()
+
+	treasury := AccountID{Account: 900}
+	serials := make([]int64, 12)
+	recipients := make([]AccountID, 12)
+	for i := range serials {
+		serials[i] = int64(i + 1)
+		recipients[i] = AccountID{Account: uint64(2000 + i)}
+	}
+	// the treasury keeps the fourth NFT
+	recipients[3] = treasury
+
+	store := NewMemoryNftMintCheckpointStore()
+	minter := NewNftCollectionMinter(client, TokenID{Token: 500}).
+		SetTreasuryAccountID(treasury).
+		SetMaxConcurrency(1).
+		SetCheckpointStore(store)
+	require.NoError(t, minter.Distribute(context.Background(), serials, recipients))
+
+	require.Len(t, bodies, 2)
+	transfers := bodies[0].GetCryptoTransfer().GetTokenTransfers()
+	require.Len(t, transfers, 1)
+	require.Len(t, transfers[0].GetNftTransfers(), 10)
+	assert.Equal(t, int64(5), transfers[0].GetNftTransfers()[3].GetSerialNumber())
+	assert.Equal(t, int64(2004), transfers[0].GetNftTransfers()[3].GetReceiverAccountID().GetAccountNum())
+	assert.Equal(t, int64(900), transfers[0].GetNftTransfers()[3].GetSenderAccountID().GetAccountNum())
+	assert.Len(t, bodies[1].GetCryptoTransfer().GetTokenTransfers()[0].GetNftTransfers(), 1)
+
+	checkpoint, err := store.Load()
+	require.NoError(t, err)
+	require.Len(t, checkpoint.Transfers, 2)
+	assert.Equal(t, []int64{12}, checkpoint.Transfers[1].SerialNumbers)
+	assert.True(t, checkpoint.Transfers[1].Completed)
+
+	// the distributed NFTs are not transferred again
+	require.NoError(t, minter.Distribute(context.Background(), serials, recipients))
+	assert.Len(t, bodies, 2)
+}
@@ -0,0 +1,369 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"strings"
+)
+
+const (
+	// NftMetadataFormat is the format of the HIP-412 metadata JSON schema
+	NftMetadataFormat = "HIP412@2.0.0"
+	// nftMetadataMaxSize is the maximum size of the metadata of a single NFT kept on the ledger
+	nftMetadataMaxSize = 100
+	// tokenMintMaxMetadata is the maximum number of NFTs a single TokenMintTransaction creates
+	tokenMintMaxMetadata = 10
+)
+
+// NftMetadata is the HIP-412 metadata of an NFT, see https://hips.hedera.com/hip/hip-412.
+//
+// The JSON document is usually stored off the ledger, e.g. on IPFS, and its URI is minted as the metadata of the NFT,
+// because the ledger keeps at most 100 bytes of metadata for every NFT.
+type NftMetadata struct {
+	Name        string `json:"name"`
+	Creator     string `json:"creator,omitempty"`
+	CreatorDID  string `json:"creatorDID,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Image is the URI of the preview image of the NFT
+	Image string `json:"image,omitempty"`
+	// Checksum is the SHA-256 digest of the image, formatted as a hex string
+	Checksum string `json:"checksum,omitempty"`
+	// Type is the MIME type of the image, required when the image is set
+	Type         string                   `json:"type,omitempty"`
+	Format       string                   `json:"format,omitempty"`
+	Properties   map[string]interface{}   `json:"properties,omitempty"`
+	Files        []NftMetadataFile        `json:"files,omitempty"`
+	Attributes   []NftMetadataAttribute   `json:"attributes,omitempty"`
+	Localization *NftMetadataLocalization `json:"localization,omitempty"`
+}
+
+// NftMetadataFile is a file of an NFT, either a URI or nested metadata.
+type NftMetadataFile struct {
+	URI           string       `json:"uri"`
+	Checksum      string       `json:"checksum,omitempty"`
+	IsDefaultFile bool         `json:"is_default_file,omitempty"`
+	Type          string       `json:"type"`
+	Metadata      *NftMetadata `json:"metadata,omitempty"`
+	MetadataURI   string       `json:"metadata_uri,omitempty"`
+}
+
+// NftMetadataAttribute is a trait of an NFT. Value is a string, number or boolean.
+type NftMetadataAttribute struct {
+	TraitType   string      `json:"trait_type"`
+	DisplayType string      `json:"display_type,omitempty"`
+	Value       interface{} `json:"value"`
+	MaxValue    interface{} `json:"max_value,omitempty"`
+}
+
+// NftMetadataLocalization points to the metadata of an NFT in other languages. URI contains a `{locale}` placeholder
+// which is replaced with every locale.
+type NftMetadataLocalization struct {
+	URI     string   `json:"uri"`
+	Default string   `json:"default"`
+	Locales []string `json:"locales"`
+}
+
+// ErrNftMetadataInvalid is returned when a field of NftMetadata does not follow the HIP-412 schema.
+type ErrNftMetadataInvalid struct {
+	Field  string
+	Reason string
+}
+
+func (e ErrNftMetadataInvalid) Error() string {
+	return fmt.Sprintf("invalid NFT metadata: %s %s", e.Field, e.Reason)
+}
+
+// ErrNftMetadataTooLarge is returned when metadata does not fit in the 100 bytes the ledger keeps for an NFT.
+type ErrNftMetadataTooLarge struct {
+	Size  int
+	Limit int
+}
+
+func (e ErrNftMetadataTooLarge) Error() string {
+	return fmt.Sprintf("NFT metadata is %d bytes, which exceeds the limit of %d bytes; "+
+		"store the metadata off the ledger, e.g. on IPFS, and mint its CID or URI instead", e.Size, e.Limit)
+}
+
+// NewNftMetadata creates the metadata of an NFT with the name, the URI of its image and the MIME type of the image.
+func NewNftMetadata(name string, image string, imageType string) *NftMetadata {
+	return &NftMetadata{
+		Name:   name,
+		Image:  image,
+		Type:   imageType,
+		Format: NftMetadataFormat,
+	}
+}
+
+// NftMetadataFromJSON decodes and validates a HIP-412 metadata JSON document.
+func NftMetadataFromJSON(data []byte) (*NftMetadata, error) {
+	var metadata NftMetadata
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&metadata); err != nil {
+		return nil, err
+	}
+	if err := metadata.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
+
+// SetCreator sets the artist or entity which created the NFT.
+func (metadata *NftMetadata) SetCreator(creator string) *NftMetadata {
+	metadata.Creator = creator
+	return metadata
+}
+
+// SetCreatorDID sets the decentralized identifier of the creator.
+func (metadata *NftMetadata) SetCreatorDID(creatorDID string) *NftMetadata {
+	metadata.CreatorDID = creatorDID
+	return metadata
+}
+
+// SetDescription sets the human readable description of the NFT.
+func (metadata *NftMetadata) SetDescription(description string) *NftMetadata {
+	metadata.Description = description
+	return metadata
+}
+
+// SetChecksum sets the SHA-256 digest of the image.
+func (metadata *NftMetadata) SetChecksum(checksum string) *NftMetadata {
+	metadata.Checksum = checksum
+	return metadata
+}
+
+// SetProperty sets an arbitrary property of the NFT.
+func (metadata *NftMetadata) SetProperty(key string, value interface{}) *NftMetadata {
+	if metadata.Properties == nil {
+		metadata.Properties = make(map[string]interface{})
+	}
+	metadata.Properties[key] = value
+	return metadata
+}
+
+// AddFile adds a file with its URI and MIME type.
+func (metadata *NftMetadata) AddFile(file NftMetadataFile) *NftMetadata {
+	metadata.Files = append(metadata.Files, file)
+	return metadata
+}
+
+// AddAttribute adds a trait with a string, number or boolean value.
+func (metadata *NftMetadata) AddAttribute(traitType string, value interface{}) *NftMetadata {
+	metadata.Attributes = append(metadata.Attributes, NftMetadataAttribute{TraitType: traitType, Value: value})
+	return metadata
+}
+
+// SetLocalization sets the URI template, default locale and other locales of the metadata.
+func (metadata *NftMetadata) SetLocalization(uri string, defaultLocale string, locales ...string) *NftMetadata {
+	metadata.Localization = &NftMetadataLocalization{URI: uri, Default: defaultLocale, Locales: locales}
+	return metadata
+}
+
+// Validate checks the metadata against the HIP-412 schema and returns every violation, joined, as
+// ErrNftMetadataInvalid.
+func (metadata *NftMetadata) Validate() error {
+	return errors.Join(metadata._Validate("")...)
+}
+
+func (metadata *NftMetadata) _Validate(prefix string) []error {
+	var errs []error
+	invalid := func(field string, reason string, args ...interface{}) {
+		errs = append(errs, ErrNftMetadataInvalid{Field: prefix + field, Reason: fmt.Sprintf(reason, args...)})
+	}
+
+	if strings.TrimSpace(metadata.Name) == "" {
+		invalid("name", "is required")
+	}
+	if metadata.Format != "" && metadata.Format != NftMetadataFormat {
+		invalid("format", "must be %q, got %q", NftMetadataFormat, metadata.Format)
+	}
+	if metadata.Image != "" {
+		if !_NftMetadataIsURI(metadata.Image) {
+			invalid("image", "must be a URI, got %q", metadata.Image)
+		}
+		if metadata.Type == "" {
+			invalid("type", "is required when the image is set")
+		}
+	}
+	if metadata.Type != "" && !_NftMetadataIsMimeType(metadata.Type) {
+		invalid("type", "must be a MIME type, got %q", metadata.Type)
+	}
+	if metadata.Checksum != "" && !_NftMetadataIsChecksum(metadata.Checksum) {
+		invalid("checksum", "must be a hex encoded SHA-256 digest")
+	}
+
+	defaults := 0
+	for i, file := range metadata.Files {
+		field := fmt.Sprintf("files[%d].", i)
+		if !_NftMetadataIsURI(file.URI) {
+			invalid(field+"uri", "must be a URI, got %q", file.URI)
+		}
+		if !_NftMetadataIsMimeType(file.Type) {
+			invalid(field+"type", "must be a MIME type, got %q", file.Type)
+		}
+		if file.Checksum != "" && !_NftMetadataIsChecksum(file.Checksum) {
+			invalid(field+"checksum", "must be a hex encoded SHA-256 digest")
+		}
+		if file.MetadataURI != "" && !_NftMetadataIsURI(file.MetadataURI) {
+			invalid(field+"metadata_uri", "must be a URI, got %q", file.MetadataURI)
+		}
+		if file.Metadata != nil {
+			errs = append(errs, file.Metadata._Validate(prefix+field+"metadata.")...)
+		}
+		if file.IsDefaultFile {
+			defaults++
+		}
+	}
+	if defaults > 1 {
+		invalid("files", "must have at most one default file")
+	}
+
+	for i, attribute := range metadata.Attributes {
+		field := fmt.Sprintf("attributes[%d].", i)
+		if attribute.TraitType == "" {
+			invalid(field+"trait_type", "is required")
+		}
+		switch attribute.Value.(type) {
+		case string, bool, json.Number, float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		default:
+			invalid(field+"value", "must be a string, number or boolean")
+		}
+	}
+
+	if localization := metadata.Localization; localization != nil {
+		if !strings.Contains(localization.URI, "{locale}") {
+			invalid("localization.uri", "must contain the {locale} placeholder")
+		}
+		if localization.Default == "" {
+			invalid("localization.default", "is required")
+		}
+		if len(localization.Locales) == 0 {
+			invalid("localization.locales", "must not be empty")
+		}
+	}
+
+	return errs
+}
+
+// ToJSON validates the metadata and encodes it as a HIP-412 JSON document.
+func (metadata *NftMetadata) ToJSON() ([]byte, error) {
+	if err := metadata.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(metadata)
+}
+
+// ToOnChainBytes returns the JSON document when it fits in the 100 bytes the ledger keeps for an NFT. Larger metadata
+// fails with ErrNftMetadataTooLarge and has to be minted with NftMetadataPointer.
+func (metadata *NftMetadata) ToOnChainBytes() ([]byte, error) {
+	data, err := metadata.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateNftMetadataSize(data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// NftMetadataPointer returns the metadata of an NFT which points to its HIP-412 JSON document, e.g.
+// "ipfs://bafkreibwci24bt2xtqi23g35gfx63wj555u77lwl2t55ajbfjqomgefxce".
+func NftMetadataPointer(uri string) ([]byte, error) {
+	if !_NftMetadataIsURI(uri) {
+		return nil, ErrNftMetadataInvalid{Field: "uri", Reason: fmt.Sprintf("must be a URI, got %q", uri)}
+	}
+	if err := ValidateNftMetadataSize([]byte(uri)); err != nil {
+		return nil, err
+	}
+
+	return []byte(uri), nil
+}
+
+// ValidateNftMetadataSize returns ErrNftMetadataTooLarge when the metadata exceeds the 100 bytes the ledger keeps
+// for an NFT.
+func ValidateNftMetadataSize(metadata []byte) error {
+	if len(metadata) > nftMetadataMaxSize {
+		return ErrNftMetadataTooLarge{Size: len(metadata), Limit: nftMetadataMaxSize}
+	}
+
+	return nil
+}
+
+// NewTokenMintTransactions creates the TokenMintTransactions which mint an NFT for every metadata of the slice, in
+// order, each with at most 10 NFTs.
+func NewTokenMintTransactions(tokenID TokenID, metadata [][]byte) ([]*TokenMintTransaction, error) {
+	for i, data := range metadata {
+		if err := ValidateNftMetadataSize(data); err != nil {
+			return nil, fmt.Errorf("metadata %d: %w", i, err)
+		}
+	}
+
+	transactions := make([]*TokenMintTransaction, 0, (len(metadata)+tokenMintMaxMetadata-1)/tokenMintMaxMetadata)
+	for start := 0; start < len(metadata); start += tokenMintMaxMetadata {
+		chunk := make([][]byte, min(tokenMintMaxMetadata, len(metadata)-start))
+		copy(chunk, metadata[start:])
+		transactions = append(transactions, NewTokenMintTransaction().SetTokenID(tokenID).SetMetadatas(chunk))
+	}
+
+	return transactions, nil
+}
+
+// MintNftCollection mints an NFT for every metadata of the slice with the client operator, which must sign with the
+// supply key of the token, and returns their serial numbers in the order of the metadata.
+//
+// The transactions are executed one after the other; when one fails, the serial numbers minted so far are returned
+// with the error.
+func MintNftCollection(ctx context.Context, client *Client, tokenID TokenID, metadata [][]byte) ([]int64, error) {
+	transactions, err := NewTokenMintTransactions(tokenID, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	serials := make([]int64, 0, len(metadata))
+	for _, tx := range transactions {
+		response, err := tx.ExecuteWithContext(ctx, client)
+		if err != nil {
+			return serials, err
+		}
+		receipt, err := response.GetReceiptWithContext(ctx, client)
+		if err != nil {
+			return serials, err
+		}
+		serials = append(serials, receipt.SerialNumbers...)
+	}
+
+	return serials, nil
+}
+
+func _NftMetadataIsURI(value string) bool {
+	uri, err := url.Parse(value)
+	return err == nil && uri.Scheme != "" && (uri.Host != "" || uri.Opaque != "" || uri.Path != "")
+}
+
+func _NftMetadataIsMimeType(value string) bool {
+	mediaType, _, err := mime.ParseMediaType(value)
+	return err == nil && strings.Contains(mediaType, "/")
+}
+
+func _NftMetadataIsChecksum(value string) bool {
+	if len(value) != 64 {
+		return false
+	}
+	for _, c := range value {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+
+	return true
+}
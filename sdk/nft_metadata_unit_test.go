@@ -0,0 +1,166 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitNftMetadataJSON(t *testing.T) {
+	t.Parallel()
+
+	metadata := NewNftMetadata("Example NFT 001", "ipfs://bafkreibwci24bt2xtqi23g35gfx63wj555u77lwl2t55ajbfjqomgefxce", "image/png").
+		SetCreator("Jane Doe, John Doe").
+		SetDescription("This describes the image").
+		SetChecksum(strings.Repeat("ab", 32)).
+		SetProperty("external_url", "https://nft.com/mycollection/001").
+		AddFile(NftMetadataFile{URI: "ipfs://bafkreidrqy67amvygjnvgr2mgdgqg2alaowoy34ljubot6qwf6bcf4yma4", Type: "video/mp4", IsDefaultFile: true}).
+		AddAttribute("color", "red").
+		AddAttribute("level", 3).
+		SetLocalization("ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi/{locale}.json", "en", "es", "fr")
+
+	data, err := metadata.ToJSON()
+	require.NoError(t, err)
+
+	var raw map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &raw))
+	assert.Equal(t, NftMetadataFormat, raw["format"])
+	assert.Equal(t, "image/png", raw["type"])
+	assert.NotContains(t, raw, "creatorDID")
+
+	decoded, err := NftMetadataFromJSON(data)
+	require.NoError(t, err)
+	assert.Equal(t, metadata.Name, decoded.Name)
+	assert.Equal(t, metadata.Files, decoded.Files)
+	assert.Equal(t, json.Number("3"), decoded.Attributes[1].Value)
+	assert.Equal(t, []string{"es", "fr"}, decoded.Localization.Locales)
+	assert.Equal(t, "https://nft.com/mycollection/001", decoded.Properties["external_url"])
+
+	// the document does not fit on the ledger, its URI does
+	_, err = metadata.ToOnChainBytes()
+	var tooLarge ErrNftMetadataTooLarge
+	require.ErrorAs(t, err, &tooLarge)
+	assert.Equal(t, len(data), tooLarge.Size)
+	assert.Contains(t, err.Error(), "CID or URI")
+
+	pointer, err := NftMetadataPointer(metadata.Image)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(metadata.Image), pointer)
+
+	small, err := (&NftMetadata{Name: "A"}).ToOnChainBytes()
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"A"}`, string(small))
+}
+
+func TestUnitNftMetadataValidate(t *testing.T) {
+	t.Parallel()
+
+	metadata := NewNftMetadata("", "not a uri", "").
+		SetChecksum("abc").
+		AddFile(NftMetadataFile{URI: "ipfs://a", Type: "video/mp4", IsDefaultFile: true}).
+		AddFile(NftMetadataFile{URI: "ipfs://b", Type: "mp4", IsDefaultFile: true, Metadata: &NftMetadata{Format: "HIP412@1.0.0"}}).
+		AddAttribute("", []string{"red"}).
+		SetLocalization("ipfs://a/en.json", "en")
+
+	err := metadata.Validate()
+	require.Error(t, err)
+
+	fields := make([]string, 0)
+	for _, joined := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var invalid ErrNftMetadataInvalid
+		require.True(t, errors.As(joined, &invalid))
+		fields = append(fields, invalid.Field)
+	}
+	assert.Equal(t, []string{
+		"name", "image", "type", "checksum",
+		"files[1].type", "files[1].metadata.name", "files[1].metadata.format", "files",
+		"attributes[0].trait_type", "attributes[0].value",
+		"localization.uri", "localization.locales",
+	}, fields)
+	assert.Contains(t, err.Error(), "invalid NFT metadata: type is required when the image is set")
+
+	_, err = metadata.ToJSON()
+	require.Error(t, err)
+	_, err = NftMetadataFromJSON([]byte(`{"image": "ipfs://a"}`))
+	require.ErrorContains(t, err, "name is required")
+	_, err = NftMetadataFromJSON([]byte(`{`))
+	require.Error(t, err)
+
+	_, err = NftMetadataPointer("ipfs://" + strings.Repeat("a", 100))
+	require.ErrorAs(t, err, &ErrNftMetadataTooLarge{})
+	_, err = NftMetadataPointer("QmHash")
+	require.ErrorAs(t, err, &ErrNftMetadataInvalid{})
+}
+
+func TestUnitNewTokenMintTransactions(t *testing.T) {
+	t.Parallel()
+
+	metadata := make([][]byte, 23)
+	for i := range metadata {
+		metadata[i] = []byte{byte(i)}
+	}
+
+	transactions, err := NewTokenMintTransactions(TokenID{Token: 500}, metadata)
+	require.NoError(t, err)
+	require.Len(t, transactions, 3)
+	assert.Len(t, transactions[0].GetMetadatas(), 10)
+	assert.Len(t, transactions[2].GetMetadatas(), 3)
+	assert.Equal(t, []byte{10}, transactions[1].GetMetadatas()[0])
+	assert.Equal(t, TokenID{Token: 500}, transactions[2].GetTokenID())
+
+	_, err = NewTokenMintTransactions(TokenID{Token: 500}, [][]byte{{1}, make([]byte, 101)})
+	require.ErrorContains(t, err, "metadata 1")
+	require.ErrorAs(t, err, &ErrNftMetadataTooLarge{})
+
+	transactions, err = NewTokenMintTransactions(TokenID{Token: 500}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, transactions)
+}
+
+func TestUnitMintNftCollection(t *testing.T) {
+	t.Parallel()
+
+	receipt := func(serials ...int64) *services.Response {
+		return &services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+					Receipt: &services.TransactionReceipt{Status: services.ResponseCodeEnum_SUCCESS, SerialNumbers: serials},
+				},
+			},
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		receipt(1, 2, 3, 4, 5, 6, 7, 8, 9, 10),
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		receipt(11, 12),
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_INVALID_SIGNATURE),
+	}})
+	defer server.Close()
+
+	metadata := make([][]byte, 12)
+	for i := range metadata {
+		metadata[i] = []byte("ipfs://metadata")
+	}
+
+	serials, err := MintNftCollection(context.Background(), client, TokenID{Token: 500}, metadata)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, serials)
+
+	serials, err = MintNftCollection(context.Background(), client, TokenID{Token: 500}, metadata[:1])
+	require.ErrorContains(t, err, StatusInvalidSignature.String())
+	assert.Empty(t, serials)
+}
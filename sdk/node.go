@@ -11,6 +11,7 @@ import (
 	"encoding/hex"
 	"encoding/pem"
 	"fmt"
+	"net/http"
 	"runtime/debug"
 	"sync"
 	"time"
@@ -31,6 +32,7 @@ type _Node struct {
 	channel           *_Channel
 	addressBook       *NodeAddress
 	verifyCertificate bool
+	transport         Transport
 	channelMutex      sync.Mutex
 }
 
@@ -107,7 +109,7 @@ func (node *_Node) _GetReadmitTime() *time.Time {
 	return node._ManagedNode._GetReadmitTime()
 }
 
-func (node *_Node) _GetChannel(logger Logger) (*_Channel, error) {
+func (node *_Node) _GetChannel(logger Logger, interceptors *_Interceptors) (*_Channel, error) {
 	node.channelMutex.Lock()
 	defer node.channelMutex.Unlock()
 
@@ -115,6 +117,28 @@ func (node *_Node) _GetChannel(logger Logger) (*_Channel, error) {
 		return node.channel, nil
 	}
 
+	const userAgent = "x-user-agent"
+	// Add the user agent to the outgoing context.
+	// This information is used to gather usage metrics.
+	userAgentInterceptor := unaryInterceptor(metadata.Pairs(userAgent, getUserAgent()))
+
+	if node.transport == TransportGrpcWeb {
+		unary := []grpc.UnaryClientInterceptor{userAgentInterceptor}
+		if interceptors != nil {
+			unary = append(unary, interceptors._UnaryInterceptor)
+		}
+
+		scheme := "http://"
+		if node._ManagedNode.address._IsTransportSecurity() {
+			scheme = "https://"
+		}
+
+		ch := _NewChannel(_NewGrpcWebConn(scheme+node._ManagedNode.address._String(), &http.Client{}, unary...))
+		node.channel = &ch
+
+		return node.channel, nil
+	}
+
 	var kacp = keepalive.ClientParameters{
 		Time:                10 * time.Second,
 		Timeout:             2 * time.Second,
@@ -173,12 +197,11 @@ func (node *_Node) _GetChannel(logger Logger) (*_Channel, error) {
 		}))
 	}
 
-	const userAgent = "x-user-agent"
-	// Add the user agent to the outgoing context.
-	// This information is used to gather usage metrics.
-	metadataOption := grpc.WithUnaryInterceptor(unaryInterceptor(metadata.Pairs(userAgent, getUserAgent())))
+	metadataOption := grpc.WithUnaryInterceptor(userAgentInterceptor)
 
-	conn, err = grpc.NewClient(node._ManagedNode.address._String(), security, grpc.WithKeepaliveParams(kacp), metadataOption)
+	options := append([]grpc.DialOption{security, grpc.WithKeepaliveParams(kacp), metadataOption}, interceptors._DialOptions()...)
+
+	conn, err = grpc.NewClient(node._ManagedNode.address._String(), options...)
 	if err != nil {
 		return nil, status.Error(codes.ResourceExhausted, "dial timeout of 10sec exceeded")
 	}
@@ -211,6 +234,11 @@ func (node *_Node) _ToSecure() _IManagedNode {
 		useCount:           node.useCount,
 		minBackoff:         node.minBackoff,
 		badGrpcStatusCount: node.badGrpcStatusCount,
+		unhealthy:          node.unhealthy,
+		averageLatency:     node.averageLatency,
+		lastError:          node.lastError,
+		lastErrorTime:      node.lastErrorTime,
+		telemetry:          node.telemetry,
 	}
 
 	return &_Node{
@@ -219,6 +247,7 @@ func (node *_Node) _ToSecure() _IManagedNode {
 		channel:           node.channel,
 		addressBook:       node.addressBook,
 		verifyCertificate: node.verifyCertificate,
+		transport:         node.transport,
 	}
 }
 
@@ -231,6 +260,11 @@ func (node *_Node) _ToInsecure() _IManagedNode {
 		useCount:           node.useCount,
 		minBackoff:         node.minBackoff,
 		badGrpcStatusCount: node.badGrpcStatusCount,
+		unhealthy:          node.unhealthy,
+		averageLatency:     node.averageLatency,
+		lastError:          node.lastError,
+		lastErrorTime:      node.lastErrorTime,
+		telemetry:          node.telemetry,
 	}
 
 	return &_Node{
@@ -239,6 +273,7 @@ func (node *_Node) _ToInsecure() _IManagedNode {
 		channel:           node.channel,
 		addressBook:       node.addressBook,
 		verifyCertificate: node.verifyCertificate,
+		transport:         node.transport,
 	}
 }
 
@@ -250,6 +285,25 @@ func (node *_Node) _GetVerifyCertificate() bool {
 	return node.verifyCertificate
 }
 
+// _SetTransport sets the transport of the node, closing its channel if the transport changed
+func (node *_Node) _SetTransport(transport Transport) error {
+	node.channelMutex.Lock()
+	defer node.channelMutex.Unlock()
+
+	if node.transport == transport {
+		return nil
+	}
+
+	node.transport = transport
+	if node.channel != nil {
+		err := node.channel.client.Close()
+		node.channel = nil
+		return err
+	}
+
+	return nil
+}
+
 // unaryInterceptor adds the user agent to the outgoing context.
 // This information is used to gather usage metrics.
 func unaryInterceptor(md metadata.MD) grpc.UnaryClientInterceptor {
@@ -261,7 +315,8 @@ func unaryInterceptor(md metadata.MD) grpc.UnaryClientInterceptor {
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		ctx = metadata.NewOutgoingContext(ctx, metadata.Join(metadata.MD{}, md))
+		existing, _ := metadata.FromOutgoingContext(ctx)
+		ctx = metadata.NewOutgoingContext(ctx, metadata.Join(existing, md))
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
@@ -17,6 +17,11 @@ type NodeAddress struct {
 	CertHash    []byte
 	Addresses   []Endpoint
 	Description string
+	// Stake is the amount of tinybars staked to the node, as reported in the address book.
+	Stake int64
+	// GrpcWebProxyEndpoint is the endpoint of the gRPC-web proxy of the node, used by TransportGrpcWeb. It is only
+	// known for address books from the mirror node REST API, see NodeAddressBookFromMirrorNetworkNodes.
+	GrpcWebProxyEndpoint *Endpoint
 }
 
 func _NodeAddressFromProtobuf(nodeAd *services.NodeAddress) NodeAddress {
@@ -33,6 +38,7 @@ func _NodeAddressFromProtobuf(nodeAd *services.NodeAddress) NodeAddress {
 		CertHash:    nodeAd.GetNodeCertHash(),
 		Addresses:   address,
 		Description: nodeAd.GetDescription(),
+		Stake:       nodeAd.GetStake(),
 	}
 }
 
@@ -44,6 +50,7 @@ func (nodeAdd *NodeAddress) _ToProtobuf() *services.NodeAddress {
 		NodeCertHash:    nodeAdd.CertHash,
 		ServiceEndpoint: nil,
 		Description:     nodeAdd.Description,
+		Stake:           nodeAdd.Stake,
 	}
 
 	if nodeAdd.AccountID != nil {
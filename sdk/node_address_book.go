@@ -3,6 +3,10 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"encoding/hex"
+	"net"
+	"strings"
+
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
 	protobuf "google.golang.org/protobuf/proto"
 )
@@ -60,6 +64,59 @@ func (book NodeAddressBook) _ToMap() (result map[AccountID]NodeAddress) {
 	return result
 }
 
+// NodeAddressBookFromMirrorNetworkNodes returns the address book of the consensus nodes reported by the mirror node
+// REST API with MirrorRestClient.NetworkNodes. Unlike the address book from AddressBookQuery, it has the gRPC-web
+// proxy endpoints of the nodes.
+func NodeAddressBookFromMirrorNetworkNodes(nodes []MirrorNetworkNode) NodeAddressBook {
+	addresses := make([]NodeAddress, 0, len(nodes))
+
+	for _, node := range nodes {
+		accountID := node.AccountID
+		address := NodeAddress{
+			PublicKey:   strings.TrimPrefix(node.PublicKey, "0x"),
+			AccountID:   &accountID,
+			NodeID:      node.NodeID,
+			CertHash:    []byte(node.NodeCertHash),
+			Addresses:   make([]Endpoint, 0, len(node.ServiceEndpoints)),
+			Description: node.Description,
+			Stake:       node.Stake.AsTinybar(),
+		}
+
+		// The mirror node reports the hex encoded bytes of the certificate hash, which is itself hex encoded
+		if certHash, err := hex.DecodeString(strings.TrimPrefix(node.NodeCertHash, "0x")); err == nil {
+			address.CertHash = certHash
+		}
+
+		for _, endpoint := range node.ServiceEndpoints {
+			address.Addresses = append(address.Addresses, _EndpointFromMirrorServiceEndpoint(endpoint))
+		}
+
+		if node.GrpcProxyEndpoint != nil {
+			endpoint := _EndpointFromMirrorServiceEndpoint(*node.GrpcProxyEndpoint)
+			address.GrpcWebProxyEndpoint = &endpoint
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return NodeAddressBook{
+		NodeAddresses: addresses,
+	}
+}
+
+func _EndpointFromMirrorServiceEndpoint(endpoint MirrorServiceEndpoint) Endpoint {
+	result := Endpoint{
+		port:       endpoint.Port,
+		domainName: endpoint.DomainName,
+	}
+
+	if ip := net.ParseIP(endpoint.IPAddressV4).To4(); ip != nil {
+		result.address = ip
+	}
+
+	return result
+}
+
 // NodeAddressBookFromBytes returns the NodeAddressBook from a raw byte array
 func NodeAddressBookFromBytes(data []byte) (NodeAddressBook, error) {
 	if data == nil {
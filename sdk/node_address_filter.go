@@ -0,0 +1,179 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+// NodeAddressFilter decides which nodes of an address book the client uses. It returns the node address to use,
+// optionally with fewer endpoints, and false to leave the node out. Filters are set with Client.SetNodeAddressFilters.
+type NodeAddressFilter func(address NodeAddress) (NodeAddress, bool)
+
+// NodeAddressFilterExcludeNodeIDs leaves out the nodes with the given node IDs.
+func NodeAddressFilterExcludeNodeIDs(nodeIDs ...int64) NodeAddressFilter {
+	excluded := make(map[int64]struct{}, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		excluded[nodeID] = struct{}{}
+	}
+
+	return func(address NodeAddress) (NodeAddress, bool) {
+		_, ok := excluded[address.NodeID]
+		return address, !ok
+	}
+}
+
+// NodeAddressFilterExcludeAccountIDs leaves out the nodes with the given node account IDs.
+func NodeAddressFilterExcludeAccountIDs(accountIDs ...AccountID) NodeAddressFilter {
+	excluded := make(map[AccountID]struct{}, len(accountIDs))
+	for _, accountID := range accountIDs {
+		excluded[accountID] = struct{}{}
+	}
+
+	return func(address NodeAddress) (NodeAddress, bool) {
+		if address.AccountID == nil {
+			return address, true
+		}
+
+		_, ok := excluded[*address.AccountID]
+		return address, !ok
+	}
+}
+
+// NodeAddressFilterPreferPorts keeps only the endpoints on the given ports, for example the ports of gRPC-web
+// proxies or of TLS endpoints, for the nodes which have any. Nodes without such endpoints are used as they are.
+func NodeAddressFilterPreferPorts(ports ...int32) NodeAddressFilter {
+	return func(address NodeAddress) (NodeAddress, bool) {
+		endpoints := make([]Endpoint, 0, len(address.Addresses))
+		for _, endpoint := range address.Addresses {
+			for _, port := range ports {
+				if endpoint.GetPort() == port {
+					endpoints = append(endpoints, endpoint)
+					break
+				}
+			}
+		}
+
+		if len(endpoints) > 0 {
+			address.Addresses = endpoints
+		}
+
+		return address, true
+	}
+}
+
+// NodeAddressFilterRequirePorts keeps only the endpoints on the given ports and leaves out the nodes which have none.
+func NodeAddressFilterRequirePorts(ports ...int32) NodeAddressFilter {
+	prefer := NodeAddressFilterPreferPorts(ports...)
+
+	return func(address NodeAddress) (NodeAddress, bool) {
+		filtered, _ := prefer(address)
+		for _, endpoint := range filtered.Addresses {
+			for _, port := range ports {
+				if endpoint.GetPort() == port {
+					return filtered, true
+				}
+			}
+		}
+
+		return address, false
+	}
+}
+
+// NetworkChange describes how the nodes the client uses changed when its network was set from an address book.
+type NetworkChange struct {
+	// Added are the nodes which were not used before.
+	Added []NodeAddress
+	// Removed are the nodes which are no longer used.
+	Removed []NodeAddress
+	// Updated are the nodes which are still used, but with different endpoints, certificate hash or stake.
+	Updated []NodeAddress
+}
+
+// IsEmpty returns true if no nodes were added, removed or updated.
+func (change NetworkChange) IsEmpty() bool {
+	return len(change.Added) == 0 && len(change.Removed) == 0 && len(change.Updated) == 0
+}
+
+// _NodeAddressState is the address book the network of a client was last set from, with the filters and change
+// handler applied to it. It is guarded by a mutex because the scheduled network update sets it on its own goroutine.
+type _NodeAddressState struct {
+	mutex         sync.RWMutex
+	book          *NodeAddressBook
+	filters       []NodeAddressFilter
+	changeHandler func(NetworkChange)
+}
+
+func _FilterNodeAddressBook(book NodeAddressBook, filters []NodeAddressFilter) NodeAddressBook {
+	addresses := make([]NodeAddress, 0, len(book.NodeAddresses))
+
+outer:
+	for _, address := range book.NodeAddresses {
+		address.Addresses = append([]Endpoint(nil), address.Addresses...)
+		for _, filter := range filters {
+			var ok bool
+			if address, ok = filter(address); !ok {
+				continue outer
+			}
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return NodeAddressBook{NodeAddresses: addresses}
+}
+
+// _NetworkChangeFromAddressBooks compares the address books the network was set from, by node account ID
+func _NetworkChangeFromAddressBooks(previous map[AccountID]NodeAddress, current map[AccountID]NodeAddress) NetworkChange {
+	change := NetworkChange{}
+
+	for accountID, address := range current {
+		previousAddress, ok := previous[accountID]
+		switch {
+		case !ok:
+			change.Added = append(change.Added, address)
+		case !_NodeAddressEqual(previousAddress, address):
+			change.Updated = append(change.Updated, address)
+		}
+	}
+
+	for accountID, address := range previous {
+		if _, ok := current[accountID]; !ok {
+			change.Removed = append(change.Removed, address)
+		}
+	}
+
+	_SortNodeAddresses(change.Added)
+	_SortNodeAddresses(change.Removed)
+	_SortNodeAddresses(change.Updated)
+
+	return change
+}
+
+func _NodeAddressEqual(a NodeAddress, b NodeAddress) bool {
+	if a.NodeID != b.NodeID || a.Stake != b.Stake || a.PublicKey != b.PublicKey ||
+		!bytes.Equal(a.CertHash, b.CertHash) || len(a.Addresses) != len(b.Addresses) {
+		return false
+	}
+
+	for i := range a.Addresses {
+		if a.Addresses[i].String() != b.Addresses[i].String() {
+			return false
+		}
+	}
+
+	if a.GrpcWebProxyEndpoint == nil || b.GrpcWebProxyEndpoint == nil {
+		return a.GrpcWebProxyEndpoint == b.GrpcWebProxyEndpoint
+	}
+
+	return a.GrpcWebProxyEndpoint.String() == b.GrpcWebProxyEndpoint.String()
+}
+
+// _SortNodeAddresses orders node addresses by node ID
+func _SortNodeAddresses(addresses []NodeAddress) {
+	sort.SliceStable(addresses, func(i, j int) bool {
+		return addresses[i].NodeID < addresses[j].NodeID
+	})
+}
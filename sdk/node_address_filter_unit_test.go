@@ -0,0 +1,220 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _NodeAddressFilterAddress(nodeID int64, stake int64, ports ...int32) NodeAddress {
+	endpoints := make([]Endpoint, len(ports))
+	for i, port := range ports {
+		endpoints[i] = Endpoint{address: []byte{127, 0, 0, byte(nodeID + 1)}, port: port}
+	}
+
+	return NodeAddress{
+		AccountID: &AccountID{Account: uint64(nodeID + 3)},
+		NodeID:    nodeID,
+		CertHash:  []byte("hash"),
+		Addresses: endpoints,
+		Stake:     stake,
+	}
+}
+
+func TestUnitNodeAddressStakeProtobuf(t *testing.T) {
+	t.Parallel()
+
+	address := _NodeAddressFromProtobuf(&services.NodeAddress{
+		NodeId:      1,
+		Stake:       1_000,
+		Description: "node 1",
+	})
+	require.Equal(t, int64(1_000), address.Stake)
+	require.Equal(t, "node 1", address.Description)
+	require.Equal(t, int64(1_000), address._ToProtobuf().GetStake())
+}
+
+func TestUnitNodeAddressFilters(t *testing.T) {
+	t.Parallel()
+
+	book := NodeAddressBook{NodeAddresses: []NodeAddress{
+		_NodeAddressFilterAddress(0, 0, 50211, 50212),
+		_NodeAddressFilterAddress(1, 0, 50211),
+		_NodeAddressFilterAddress(2, 0, 50212),
+	}}
+
+	filtered := _FilterNodeAddressBook(book, []NodeAddressFilter{NodeAddressFilterExcludeNodeIDs(2)})
+	require.Len(t, filtered.NodeAddresses, 2)
+	assert.Equal(t, int64(0), filtered.NodeAddresses[0].NodeID)
+	assert.Equal(t, int64(1), filtered.NodeAddresses[1].NodeID)
+
+	filtered = _FilterNodeAddressBook(book, []NodeAddressFilter{NodeAddressFilterExcludeAccountIDs(AccountID{Account: 3})})
+	require.Len(t, filtered.NodeAddresses, 2)
+	assert.Equal(t, int64(1), filtered.NodeAddresses[0].NodeID)
+
+	filtered = _FilterNodeAddressBook(book, []NodeAddressFilter{NodeAddressFilterPreferPorts(50212)})
+	require.Len(t, filtered.NodeAddresses, 3)
+	require.Len(t, filtered.NodeAddresses[0].Addresses, 1)
+	assert.Equal(t, int32(50212), filtered.NodeAddresses[0].Addresses[0].GetPort())
+	assert.Equal(t, int32(50211), filtered.NodeAddresses[1].Addresses[0].GetPort())
+
+	filtered = _FilterNodeAddressBook(book, []NodeAddressFilter{NodeAddressFilterRequirePorts(50212)})
+	require.Len(t, filtered.NodeAddresses, 2)
+	assert.Equal(t, int64(0), filtered.NodeAddresses[0].NodeID)
+	require.Len(t, filtered.NodeAddresses[0].Addresses, 1)
+	assert.Equal(t, int64(2), filtered.NodeAddresses[1].NodeID)
+
+	// Filtering does not modify the address book
+	require.Len(t, book.NodeAddresses[0].Addresses, 2)
+}
+
+func TestUnitClientSetNetworkFromAddressBook(t *testing.T) {
+	t.Parallel()
+
+	client := _NewClient(_NewNetwork(), nil, nil, false, 0, 0)
+
+	var changes []NetworkChange
+	client.SetNetworkChangeHandler(func(change NetworkChange) {
+		changes = append(changes, change)
+	})
+
+	client.SetNetworkFromAddressBook(NodeAddressBook{NodeAddresses: []NodeAddress{
+		_NodeAddressFilterAddress(0, 100, 50211),
+		_NodeAddressFilterAddress(1, 200, 50211),
+	}})
+	require.Len(t, changes, 1)
+	require.Len(t, changes[0].Added, 2)
+	assert.Empty(t, changes[0].Removed)
+	assert.Len(t, client.GetNetwork(), 2)
+
+	// Setting the same address book again is not a change
+	client.SetNetworkFromAddressBook(client.GetNodeAddressBook())
+	require.Len(t, changes, 1)
+
+	client.SetNodeAddressFilters(NodeAddressFilterExcludeNodeIDs(0))
+	require.Len(t, changes, 2)
+	assert.Empty(t, changes[1].Added)
+	require.Len(t, changes[1].Removed, 1)
+	assert.Equal(t, int64(0), changes[1].Removed[0].NodeID)
+	assert.Equal(t, map[string]AccountID{"127.0.0.2:50211": {Account: 4}}, client.GetNetwork())
+
+	// The address book keeps the nodes left out by the filters
+	book := client.GetNodeAddressBook()
+	require.Len(t, book.NodeAddresses, 2)
+	assert.Equal(t, int64(100), book.NodeAddresses[0].Stake)
+
+	client.SetNetworkFromAddressBook(NodeAddressBook{NodeAddresses: []NodeAddress{
+		_NodeAddressFilterAddress(0, 100, 50211),
+		_NodeAddressFilterAddress(1, 300, 50211),
+		_NodeAddressFilterAddress(2, 300, 50211),
+	}})
+	require.Len(t, changes, 3)
+	require.Len(t, changes[2].Added, 1)
+	assert.Equal(t, int64(2), changes[2].Added[0].NodeID)
+	require.Len(t, changes[2].Updated, 1)
+	assert.Equal(t, int64(300), changes[2].Updated[0].Stake)
+
+	health := client.GetNetworkHealth()
+	require.Len(t, health, 2)
+	assert.Equal(t, int64(300), health[0].Stake)
+
+	// Filters which leave out every node keep the current network
+	client.SetNodeAddressFilters(NodeAddressFilterRequirePorts(443))
+	require.Len(t, changes, 3)
+	assert.Len(t, client.GetNetwork(), 2)
+}
+
+func TestUnitStakeWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	nodes := _NodeSelectorNodes()
+	nodes[0].Stake = 1_000_000_000
+	nodes[1].Stake = 1
+
+	first := map[AccountID]int{}
+	for i := 0; i < 200; i++ {
+		selected := NewStakeWeightedNodeSelector().SelectNodes(RequestTypeNone, nodes)
+		require.Len(t, selected, 4)
+		require.ElementsMatch(t, []AccountID{{Account: 4}, {Account: 6}}, _NodeSelectorAccountIDs(selected[2:]))
+		first[selected[0].AccountID]++
+	}
+
+	assert.Greater(t, first[AccountID{Account: 5}], 190)
+}
+
+type _StakeRecordingNodeSelector struct {
+	stakes map[AccountID]int64
+}
+
+func (selector *_StakeRecordingNodeSelector) SelectNodes(_ RequestType, nodes []NodeHealth) []NodeHealth {
+	for _, node := range nodes {
+		selector.stakes[node.AccountID] = node.Stake
+	}
+
+	return nodes
+}
+
+func TestUnitNodeSelectorReceivesStake(t *testing.T) {
+	t.Parallel()
+
+	client := _NewClient(_NewNetwork(), nil, nil, false, 0, 0)
+	client.SetNetworkFromAddressBook(NodeAddressBook{NodeAddresses: []NodeAddress{
+		_NodeAddressFilterAddress(0, 100, 50211),
+		_NodeAddressFilterAddress(1, 200, 50211),
+	}})
+
+	selector := &_StakeRecordingNodeSelector{stakes: map[AccountID]int64{}}
+	client.SetNodeSelector(selector)
+
+	_, err := NewTransferTransaction().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	assert.Equal(t, map[AccountID]int64{{Account: 3}: 100, {Account: 4}: 200}, selector.stakes)
+}
+
+func TestUnitClientNetworkUpdateConcurrentWithAddressBook(t *testing.T) {
+	t.Parallel()
+
+	client := _NewClient(_NewNetwork(), nil, nil, false, 0, 0)
+	books := []NodeAddressBook{
+		{NodeAddresses: []NodeAddress{_NodeAddressFilterAddress(0, 100, 50211), _NodeAddressFilterAddress(1, 200, 50211)}},
+		{NodeAddresses: []NodeAddress{_NodeAddressFilterAddress(1, 300, 50211), _NodeAddressFilterAddress(2, 300, 50211)}},
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			client.SetNetworkFromAddressBook(books[i%len(books)])
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			client.SetNodeAddressFilters(NodeAddressFilterExcludeNodeIDs(int64(i % 3)))
+			client.SetNetworkChangeHandler(func(NetworkChange) {})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			book := client.GetNodeAddressBook()
+			assert.LessOrEqual(t, len(book.NodeAddresses), 2)
+		}
+	}()
+	wg.Wait()
+
+	client.SetNetworkFromAddressBook(books[1])
+	assert.Equal(t, books[1], client.GetNodeAddressBook())
+}
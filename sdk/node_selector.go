@@ -0,0 +1,221 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+)
+
+// NodeSelector chooses the consensus nodes a transaction or query is sent to. It is set with
+// Client.SetNodeSelector and is only consulted when no node account IDs were set on the transaction or query.
+type NodeSelector interface {
+	// SelectNodes orders the healthy nodes by preference for a request of the given type. The client tries the
+	// returned nodes in order and sends queries to the first one, so a selector may drop nodes it never wants to use.
+	// Returning no nodes makes the client fall back to a random order.
+	SelectNodes(requestType RequestType, nodes []NodeHealth) []NodeHealth
+}
+
+type _RandomNodeSelector struct{}
+
+// NewRandomNodeSelector returns a selector which orders the nodes randomly. This is the default.
+func NewRandomNodeSelector() NodeSelector {
+	return _RandomNodeSelector{}
+}
+
+func (_RandomNodeSelector) SelectNodes(_ RequestType, nodes []NodeHealth) []NodeHealth {
+	selected := append([]NodeHealth(nil), nodes...)
+	rand.Shuffle(len(selected), func(i, j int) { // #nosec
+		selected[i], selected[j] = selected[j], selected[i]
+	})
+
+	return selected
+}
+
+type _RoundRobinNodeSelector struct {
+	mutex sync.Mutex
+	next  int
+}
+
+// NewRoundRobinNodeSelector returns a selector which starts every request at the node after the one the previous
+// request started at, ordered by account ID and address.
+func NewRoundRobinNodeSelector() NodeSelector {
+	return &_RoundRobinNodeSelector{}
+}
+
+func (selector *_RoundRobinNodeSelector) SelectNodes(_ RequestType, nodes []NodeHealth) []NodeHealth {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	sorted := _SortNodeHealth(nodes)
+
+	selector.mutex.Lock()
+	start := selector.next % len(sorted)
+	selector.next = start + 1
+	selector.mutex.Unlock()
+
+	return append(sorted[start:], sorted[:start]...)
+}
+
+type _LeastLatencyNodeSelector struct{}
+
+// NewLeastLatencyNodeSelector returns a selector which prefers the nodes with the lowest moving average of observed
+// response times. Nodes without a measured latency are tried first, so that every node gets measured.
+func NewLeastLatencyNodeSelector() NodeSelector {
+	return _LeastLatencyNodeSelector{}
+}
+
+func (_LeastLatencyNodeSelector) SelectNodes(requestType RequestType, nodes []NodeHealth) []NodeHealth {
+	// Shuffle first so that nodes with the same latency are not always tried in the same order
+	selected := _RandomNodeSelector{}.SelectNodes(requestType, nodes)
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].AverageLatency < selected[j].AverageLatency
+	})
+
+	return selected
+}
+
+type _WeightedNodeSelector struct {
+	weights map[AccountID]int64
+}
+
+// NewWeightedNodeSelector returns a selector which orders the nodes randomly, with the chance of a node coming first
+// proportional to its weight, such as the stake of the node. Nodes without a positive weight are tried last.
+func NewWeightedNodeSelector(weights map[AccountID]int64) NodeSelector {
+	copied := make(map[AccountID]int64, len(weights))
+	for accountID, weight := range weights {
+		copied[accountID] = weight
+	}
+
+	return _WeightedNodeSelector{weights: copied}
+}
+
+func (selector _WeightedNodeSelector) SelectNodes(_ RequestType, nodes []NodeHealth) []NodeHealth {
+	type keyed struct {
+		node NodeHealth
+		key  float64
+	}
+
+	// Weighted random sampling without replacement: the node with the smallest -ln(u)/weight comes first
+	keyedNodes := make([]keyed, len(nodes))
+	for i, node := range nodes {
+		key := math.Inf(1)
+		if weight := selector.weights[node.AccountID]; weight > 0 {
+			key = -math.Log(1-rand.Float64()) / float64(weight) // #nosec
+		}
+		keyedNodes[i] = keyed{node: node, key: key}
+	}
+
+	rand.Shuffle(len(keyedNodes), func(i, j int) { // #nosec
+		keyedNodes[i], keyedNodes[j] = keyedNodes[j], keyedNodes[i]
+	})
+	sort.SliceStable(keyedNodes, func(i, j int) bool {
+		return keyedNodes[i].key < keyedNodes[j].key
+	})
+
+	selected := make([]NodeHealth, len(keyedNodes))
+	for i, node := range keyedNodes {
+		selected[i] = node.node
+	}
+
+	return selected
+}
+
+type _StakeWeightedNodeSelector struct{}
+
+// NewStakeWeightedNodeSelector returns a selector like NewWeightedNodeSelector which weighs every node by its stake
+// in the current address book of the client, so that the weights follow the network updates.
+func NewStakeWeightedNodeSelector() NodeSelector {
+	return _StakeWeightedNodeSelector{}
+}
+
+func (_StakeWeightedNodeSelector) SelectNodes(requestType RequestType, nodes []NodeHealth) []NodeHealth {
+	weights := make(map[AccountID]int64, len(nodes))
+	for _, node := range nodes {
+		weights[node.AccountID] = node.Stake
+	}
+
+	return _WeightedNodeSelector{weights: weights}.SelectNodes(requestType, nodes)
+}
+
+// PinningNodeSelector sends requests of pinned request types to specific nodes and delegates all other requests to
+// another selector.
+type PinningNodeSelector struct {
+	mutex    sync.RWMutex
+	selector NodeSelector
+	pinned   map[RequestType][]AccountID
+}
+
+// NewPinningNodeSelector returns a selector which delegates the requests of request types which are not pinned to
+// selector, or to NewRandomNodeSelector if selector is nil.
+func NewPinningNodeSelector(selector NodeSelector) *PinningNodeSelector {
+	if selector == nil {
+		selector = NewRandomNodeSelector()
+	}
+
+	return &PinningNodeSelector{
+		selector: selector,
+		pinned:   map[RequestType][]AccountID{},
+	}
+}
+
+// Pin sends the requests of requestType only to the given nodes, in the given order. If none of them is healthy,
+// the requests are sent to the nodes chosen by the delegate selector instead. Pinning no nodes removes the pin.
+func (selector *PinningNodeSelector) Pin(requestType RequestType, nodeAccountIDs ...AccountID) *PinningNodeSelector {
+	selector.mutex.Lock()
+	defer selector.mutex.Unlock()
+
+	if len(nodeAccountIDs) == 0 {
+		delete(selector.pinned, requestType)
+	} else {
+		selector.pinned[requestType] = append([]AccountID(nil), nodeAccountIDs...)
+	}
+
+	return selector
+}
+
+// GetPinned returns the nodes requestType is pinned to, or nil.
+func (selector *PinningNodeSelector) GetPinned(requestType RequestType) []AccountID {
+	selector.mutex.RLock()
+	defer selector.mutex.RUnlock()
+
+	return append([]AccountID(nil), selector.pinned[requestType]...)
+}
+
+func (selector *PinningNodeSelector) SelectNodes(requestType RequestType, nodes []NodeHealth) []NodeHealth {
+	selector.mutex.RLock()
+	pinned := selector.pinned[requestType]
+	selector.mutex.RUnlock()
+
+	selected := make([]NodeHealth, 0, len(pinned))
+	for _, accountID := range pinned {
+		for _, node := range nodes {
+			if node.AccountID == accountID {
+				selected = append(selected, node)
+			}
+		}
+	}
+
+	if len(selected) == 0 {
+		return selector.selector.SelectNodes(requestType, nodes)
+	}
+
+	return selected
+}
+
+// _SortNodeHealth returns a copy of nodes ordered by account ID and address
+func _SortNodeHealth(nodes []NodeHealth) []NodeHealth {
+	sorted := append([]NodeHealth(nil), nodes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].AccountID.Compare(sorted[j].AccountID) != 0 {
+			return sorted[i].AccountID.Compare(sorted[j].AccountID) < 0
+		}
+
+		return sorted[i].Address < sorted[j].Address
+	})
+
+	return sorted
+}
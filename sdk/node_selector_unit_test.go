@@ -0,0 +1,147 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _NodeSelectorAccountIDs(nodes []NodeHealth) []AccountID {
+	accountIDs := make([]AccountID, len(nodes))
+	for i, node := range nodes {
+		accountIDs[i] = node.AccountID
+	}
+
+	return accountIDs
+}
+
+func _NodeSelectorNodes() []NodeHealth {
+	return []NodeHealth{
+		{AccountID: AccountID{Account: 5}, Address: "127.0.0.1:50215", AverageLatency: 30 * time.Millisecond},
+		{AccountID: AccountID{Account: 3}, Address: "127.0.0.1:50213", AverageLatency: 20 * time.Millisecond},
+		{AccountID: AccountID{Account: 4}, Address: "127.0.0.1:50214", AverageLatency: 10 * time.Millisecond},
+		{AccountID: AccountID{Account: 6}, Address: "127.0.0.1:50216"},
+	}
+}
+
+func TestUnitRandomNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	selected := NewRandomNodeSelector().SelectNodes(RequestTypeNone, _NodeSelectorNodes())
+	require.ElementsMatch(t, _NodeSelectorAccountIDs(_NodeSelectorNodes()), _NodeSelectorAccountIDs(selected))
+}
+
+func TestUnitRoundRobinNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	selector := NewRoundRobinNodeSelector()
+	nodes := _NodeSelectorNodes()
+
+	require.Equal(t, []AccountID{{Account: 3}, {Account: 4}, {Account: 5}, {Account: 6}},
+		_NodeSelectorAccountIDs(selector.SelectNodes(RequestTypeNone, nodes)))
+	require.Equal(t, []AccountID{{Account: 4}, {Account: 5}, {Account: 6}, {Account: 3}},
+		_NodeSelectorAccountIDs(selector.SelectNodes(RequestTypeNone, nodes)))
+	require.Equal(t, []AccountID{{Account: 5}, {Account: 6}, {Account: 3}, {Account: 4}},
+		_NodeSelectorAccountIDs(selector.SelectNodes(RequestTypeNone, nodes)))
+	require.Empty(t, selector.SelectNodes(RequestTypeNone, nil))
+}
+
+func TestUnitLeastLatencyNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	selected := NewLeastLatencyNodeSelector().SelectNodes(RequestTypeNone, _NodeSelectorNodes())
+	require.Equal(t, []AccountID{{Account: 6}, {Account: 4}, {Account: 3}, {Account: 5}}, _NodeSelectorAccountIDs(selected))
+}
+
+func TestUnitWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	weights := map[AccountID]int64{
+		{Account: 3}: 1_000_000,
+		{Account: 4}: 1,
+		{Account: 5}: 0,
+	}
+	selector := NewWeightedNodeSelector(weights)
+	weights[AccountID{Account: 6}] = 1_000_000_000
+
+	first := map[AccountID]int{}
+	for i := 0; i < 200; i++ {
+		selected := selector.SelectNodes(RequestTypeNone, _NodeSelectorNodes())
+		require.Len(t, selected, 4)
+		require.ElementsMatch(t, []AccountID{{Account: 5}, {Account: 6}}, _NodeSelectorAccountIDs(selected[2:]))
+		first[selected[0].AccountID]++
+	}
+
+	assert.Greater(t, first[AccountID{Account: 3}], 190)
+}
+
+func TestUnitPinningNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	selector := NewPinningNodeSelector(NewRoundRobinNodeSelector()).
+		Pin(RequestTypeCryptoTransfer, AccountID{Account: 5}, AccountID{Account: 4}).
+		Pin(RequestTypeTokenMint, AccountID{Account: 7})
+
+	require.Equal(t, []AccountID{{Account: 5}, {Account: 4}}, selector.GetPinned(RequestTypeCryptoTransfer))
+	require.Equal(t, []AccountID{{Account: 5}, {Account: 4}},
+		_NodeSelectorAccountIDs(selector.SelectNodes(RequestTypeCryptoTransfer, _NodeSelectorNodes())))
+
+	// Not pinned, and pinned to a node which is not healthy, use the round robin selector
+	require.Equal(t, []AccountID{{Account: 3}, {Account: 4}, {Account: 5}, {Account: 6}},
+		_NodeSelectorAccountIDs(selector.SelectNodes(RequestTypeCryptoGetAccountBalance, _NodeSelectorNodes())))
+	require.Equal(t, []AccountID{{Account: 4}, {Account: 5}, {Account: 6}, {Account: 3}},
+		_NodeSelectorAccountIDs(selector.SelectNodes(RequestTypeTokenMint, _NodeSelectorNodes())))
+
+	selector.Pin(RequestTypeCryptoTransfer)
+	require.Nil(t, selector.GetPinned(RequestTypeCryptoTransfer))
+}
+
+func TestUnitMockNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	balance := &services.Response{
+		Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+				Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+				AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1800}},
+				Balance:   1,
+			},
+		},
+	}
+	responses := [][]interface{}{{}, {}, {
+		balance,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	client.SetNodeSelector(NewPinningNodeSelector(nil).
+		Pin(RequestTypeCryptoTransfer, AccountID{Account: 4}).
+		Pin(RequestTypeCryptoGetAccountBalance, AccountID{Account: 5}))
+	require.IsType(t, &PinningNodeSelector{}, client.GetNodeSelector())
+
+	transfer, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	require.Equal(t, []AccountID{{Account: 4}}, transfer.GetNodeAccountIDs())
+
+	update, err := NewAccountUpdateTransaction().
+		SetAccountID(AccountID{Account: 1800}).
+		FreezeWith(client)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []AccountID{{Account: 3}, {Account: 4}, {Account: 5}}, update.GetNodeAccountIDs())
+
+	query := NewAccountBalanceQuery().SetAccountID(AccountID{Account: 1800})
+	_, err = query.Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, []AccountID{{Account: 5}}, query.GetNodeAccountIDs())
+}
@@ -0,0 +1,217 @@
+// Package opentelemetry provides a hiero.Telemetry recording the executions of a client as OpenTelemetry spans and
+// metrics. It is kept out of the hiero package so that only applications using it depend on OpenTelemetry:
+//
+//	client.SetTelemetry(opentelemetry.New(tracerProvider, meterProvider))
+//
+// Every Execute call records a span with a child span for each attempt, carrying the node account ID and address,
+// the precheck status or gRPC code and the backoff before the next attempt, and GetReceipt records a span around the
+// receipt polling. The metrics are the hiero.client.attempts counter and hiero.client.attempt.duration histogram per
+// node, the hiero.client.node.health_transitions counter and the hiero.client.receipt.duration histogram.
+package opentelemetry
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+const (
+	attributeRequest       = attribute.Key("hiero.request")
+	attributeTransactionID = attribute.Key("hiero.transaction_id")
+	attributeNodeAccountID = attribute.Key("hiero.node.account_id")
+	attributeNodeAddress   = attribute.Key("hiero.node.address")
+	attributeNodeHealthy   = attribute.Key("hiero.node.healthy")
+	attributeAttempt       = attribute.Key("hiero.attempt")
+	attributeAttempts      = attribute.Key("hiero.attempts")
+	attributeStatus        = attribute.Key("hiero.status")
+	attributeBackoff       = attribute.Key("hiero.backoff_ms")
+	attributeGrpcCode      = attribute.Key("rpc.grpc.status_code")
+)
+
+type _Telemetry struct {
+	tracer            trace.Tracer
+	attempts          metric.Int64Counter
+	attemptDuration   metric.Float64Histogram
+	healthTransitions metric.Int64Counter
+	receiptDuration   metric.Float64Histogram
+}
+
+// New returns a hiero.Telemetry recording spans with tracerProvider and metrics with meterProvider, either of which
+// may be nil to record no spans or no metrics. Errors creating the instruments are reported to the OpenTelemetry
+// error handler, like other instrumentation libraries do.
+func New(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) hiero.Telemetry {
+	var telemetry _Telemetry
+
+	if tracerProvider != nil {
+		telemetry.tracer = tracerProvider.Tracer(instrumentationName)
+	}
+
+	if meterProvider == nil {
+		return &telemetry
+	}
+
+	meter := meterProvider.Meter(instrumentationName)
+
+	var err error
+	if telemetry.attempts, err = meter.Int64Counter(
+		"hiero.client.attempts",
+		metric.WithDescription("Number of attempts to send a transaction or query to a node"),
+		metric.WithUnit("{attempt}"),
+	); err != nil {
+		otel.Handle(err)
+	}
+
+	if telemetry.attemptDuration, err = meter.Float64Histogram(
+		"hiero.client.attempt.duration",
+		metric.WithDescription("Duration of the attempts to send a transaction or query to a node"),
+		metric.WithUnit("s"),
+	); err != nil {
+		otel.Handle(err)
+	}
+
+	if telemetry.healthTransitions, err = meter.Int64Counter(
+		"hiero.client.node.health_transitions",
+		metric.WithDescription("Number of times a node was marked unhealthy or recovered"),
+		metric.WithUnit("{transition}"),
+	); err != nil {
+		otel.Handle(err)
+	}
+
+	if telemetry.receiptDuration, err = meter.Float64Histogram(
+		"hiero.client.receipt.duration",
+		metric.WithDescription("Duration of polling for transaction receipts"),
+		metric.WithUnit("s"),
+	); err != nil {
+		otel.Handle(err)
+	}
+
+	return &telemetry
+}
+
+func (telemetry *_Telemetry) StartExecution(ctx context.Context, request string, transactionID string) (context.Context, func(int64, error)) {
+	if telemetry.tracer == nil {
+		return ctx, func(int64, error) {}
+	}
+
+	attributes := []attribute.KeyValue{attributeRequest.String(request)}
+	if transactionID != "" {
+		attributes = append(attributes, attributeTransactionID.String(transactionID))
+	}
+
+	ctx, span := telemetry.tracer.Start(ctx, request+".Execute",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+
+	return ctx, func(attempts int64, err error) {
+		span.SetAttributes(attributeAttempts.Int64(attempts))
+		_SetSpanError(span, err)
+		span.End()
+	}
+}
+
+func (telemetry *_Telemetry) StartAttempt(ctx context.Context, request string, attempt int64) (context.Context, func(hiero.TelemetryAttempt)) {
+	startTime := time.Now()
+
+	var span trace.Span
+	if telemetry.tracer != nil {
+		ctx, span = telemetry.tracer.Start(ctx, request+".Attempt",
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attributeAttempt.Int64(attempt)),
+		)
+	}
+
+	return ctx, func(result hiero.TelemetryAttempt) {
+		if telemetry.attempts != nil {
+			nodeAttributes := []attribute.KeyValue{attributeRequest.String(request)}
+			if result.NodeAccountID != nil {
+				nodeAttributes = append(nodeAttributes, attributeNodeAccountID.String(result.NodeAccountID.String()))
+			}
+
+			ctx := context.Background()
+			telemetry.attempts.Add(ctx, 1, metric.WithAttributes(nodeAttributes...), metric.WithAttributes(attributeStatus.String(result.Status)))
+			telemetry.attemptDuration.Record(ctx, time.Since(startTime).Seconds(), metric.WithAttributes(nodeAttributes...))
+		}
+
+		if span == nil {
+			return
+		}
+
+		if result.NodeAccountID != nil {
+			span.SetAttributes(
+				attributeNodeAccountID.String(result.NodeAccountID.String()),
+				attributeNodeAddress.String(result.NodeAddress),
+			)
+		}
+		if result.GrpcCode != nil {
+			span.SetAttributes(attributeGrpcCode.Int64(int64(*result.GrpcCode)))
+		}
+		if result.Backoff != nil {
+			span.SetAttributes(attributeBackoff.Int64(result.Backoff.Milliseconds()))
+		}
+		if result.Status != "" {
+			span.SetAttributes(attributeStatus.String(result.Status))
+		}
+		_SetSpanError(span, result.Err)
+		span.End()
+	}
+}
+
+func (telemetry *_Telemetry) StartReceipt(ctx context.Context, transactionID hiero.TransactionID, nodeAccountID hiero.AccountID) (context.Context, func(hiero.Status, error)) {
+	startTime := time.Now()
+
+	var span trace.Span
+	if telemetry.tracer != nil {
+		ctx, span = telemetry.tracer.Start(ctx, "TransactionResponse.GetReceipt",
+			trace.WithAttributes(
+				attributeTransactionID.String(transactionID.String()),
+				attributeNodeAccountID.String(nodeAccountID.String()),
+			),
+		)
+	}
+
+	return ctx, func(status hiero.Status, err error) {
+		statusAttribute := attributeStatus.String(status.String())
+
+		if telemetry.receiptDuration != nil {
+			telemetry.receiptDuration.Record(context.Background(), time.Since(startTime).Seconds(),
+				metric.WithAttributes(statusAttribute))
+		}
+
+		if span != nil {
+			span.SetAttributes(statusAttribute)
+			_SetSpanError(span, err)
+			span.End()
+		}
+	}
+}
+
+func (telemetry *_Telemetry) RecordHealthTransition(address string, healthy bool) {
+	if telemetry.healthTransitions == nil {
+		return
+	}
+
+	telemetry.healthTransitions.Add(context.Background(), 1, metric.WithAttributes(
+		attributeNodeAddress.String(address),
+		attributeNodeHealthy.Bool(healthy),
+	))
+}
+
+func _SetSpanError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(otelcodes.Error, err.Error())
+}
@@ -0,0 +1,145 @@
+//go:build all || unit
+
+package opentelemetry
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"testing"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/hieromock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/codes"
+)
+
+func _SpanAttribute(span tracetest.SpanStub, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+
+	return attribute.Value{}
+}
+
+func _Metric(t *testing.T, metrics metricdata.ResourceMetrics, name string) metricdata.Aggregation {
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name == name {
+				return m.Data
+			}
+		}
+	}
+
+	require.Failf(t, "metric not found", "%s", name)
+	return nil
+}
+
+func TestUnitTelemetry(t *testing.T) {
+	t.Parallel()
+
+	network, err := hieromock.NewNetwork(1)
+	require.NoError(t, err)
+	defer network.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+	client := network.NewClient()
+	client.SetTelemetry(New(
+		sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+	))
+	require.NotNil(t, client.GetTelemetry())
+
+	network.FailNext(hieromock.FailureUnavailable, 1).FailNext(hieromock.FailureBusy, 1)
+	operatorID, _ := network.GetOperator()
+	response, err := hiero.NewTransferTransaction().
+		SetNodeAccountIDs([]hiero.AccountID{{Account: 3}}).
+		AddHbarTransfer(operatorID, hiero.NewHbar(-1)).
+		AddHbarTransfer(hiero.AccountID{Account: 3}, hiero.NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 7)
+
+	byName := map[string][]tracetest.SpanStub{}
+	for _, span := range spans {
+		byName[span.Name] = append(byName[span.Name], span)
+	}
+
+	require.Len(t, byName["TransferTransaction.Execute"], 1)
+	execution := byName["TransferTransaction.Execute"][0]
+	assert.Equal(t, int64(3), _SpanAttribute(execution, attributeAttempts).AsInt64())
+
+	attempts := byName["TransferTransaction.Attempt"]
+	require.Len(t, attempts, 3)
+	for i, attempt := range attempts {
+		assert.Equal(t, execution.SpanContext.SpanID(), attempt.Parent.SpanID())
+		assert.Equal(t, int64(i), _SpanAttribute(attempt, attributeAttempt).AsInt64())
+		assert.Equal(t, "0.0.3", _SpanAttribute(attempt, attributeNodeAccountID).AsString())
+		assert.NotEmpty(t, _SpanAttribute(attempt, attributeNodeAddress).AsString())
+	}
+	assert.Equal(t, int64(codes.Unavailable), _SpanAttribute(attempts[0], attributeGrpcCode).AsInt64())
+	assert.Equal(t, "Unavailable", _SpanAttribute(attempts[0], attributeStatus).AsString())
+	assert.Equal(t, "BUSY", _SpanAttribute(attempts[1], attributeStatus).AsString())
+	assert.Equal(t, attribute.INT64, _SpanAttribute(attempts[1], attributeBackoff).Type())
+	assert.Equal(t, "OK", _SpanAttribute(attempts[2], attributeStatus).AsString())
+
+	require.Len(t, byName["TransactionResponse.GetReceipt"], 1)
+	receiptSpan := byName["TransactionResponse.GetReceipt"][0]
+	assert.Equal(t, "SUCCESS", _SpanAttribute(receiptSpan, attributeStatus).AsString())
+	require.Len(t, byName["TransactionReceiptQuery.Execute"], 1)
+	assert.Equal(t, receiptSpan.SpanContext.SpanID(), byName["TransactionReceiptQuery.Execute"][0].Parent.SpanID())
+
+	var metrics metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &metrics))
+
+	attemptCounts := map[string]int64{}
+	for _, point := range _Metric(t, metrics, "hiero.client.attempts").(metricdata.Sum[int64]).DataPoints {
+		status, _ := point.Attributes.Value(attributeStatus)
+		attemptCounts[status.AsString()] += point.Value
+	}
+	assert.Equal(t, map[string]int64{"Unavailable": 1, "BUSY": 1, "OK": 1, "SUCCESS": 1}, attemptCounts)
+
+	var attemptDurations uint64
+	for _, point := range _Metric(t, metrics, "hiero.client.attempt.duration").(metricdata.Histogram[float64]).DataPoints {
+		attemptDurations += point.Count
+	}
+	assert.Equal(t, uint64(4), attemptDurations)
+
+	transitions := map[bool]int64{}
+	for _, point := range _Metric(t, metrics, "hiero.client.node.health_transitions").(metricdata.Sum[int64]).DataPoints {
+		healthy, _ := point.Attributes.Value(attributeNodeHealthy)
+		transitions[healthy.AsBool()] += point.Value
+	}
+	assert.Equal(t, map[bool]int64{false: 1, true: 1}, transitions)
+
+	receiptDurations := _Metric(t, metrics, "hiero.client.receipt.duration").(metricdata.Histogram[float64]).DataPoints
+	require.Len(t, receiptDurations, 1)
+	assert.Equal(t, uint64(1), receiptDurations[0].Count)
+}
+
+func TestUnitTelemetryWithoutProviders(t *testing.T) {
+	t.Parallel()
+
+	telemetry := New(nil, nil)
+	ctx, end := telemetry.StartExecution(context.Background(), "TransferTransaction", "")
+	ctx, endAttempt := telemetry.StartAttempt(ctx, "TransferTransaction", 0)
+	endAttempt(hiero.TelemetryAttempt{Status: hiero.StatusBusy.String()})
+	end(1, nil)
+	_, endReceipt := telemetry.StartReceipt(ctx, hiero.TransactionID{}, hiero.AccountID{Account: 3})
+	endReceipt(hiero.StatusSuccess, nil)
+	telemetry.RecordHealthTransition("127.0.0.1:50211", false)
+}
@@ -0,0 +1,427 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// receiptRetentionPeriod is how long consensus nodes keep the receipt of a transaction after it reached consensus
+const receiptRetentionPeriod = 3 * time.Minute
+
+// OutboxState is the state of a transaction in an Outbox.
+type OutboxState uint32
+
+const (
+	// OutboxStatePending is a transaction which was stored but not yet accepted by a node.
+	OutboxStatePending OutboxState = iota
+	// OutboxStateSubmitted is a transaction which a node accepted, or reported as a duplicate, and whose receipt is
+	// not known yet.
+	OutboxStateSubmitted
+	// OutboxStateCompleted is a transaction which reached consensus with a SUCCESS receipt.
+	OutboxStateCompleted
+	// OutboxStateFailed is a transaction which was rejected by a node, reached consensus with a failed receipt, or
+	// whose outcome can no longer be known.
+	OutboxStateFailed
+)
+
+// String returns a string representation of the OutboxState
+func (state OutboxState) String() string {
+	switch state {
+	case OutboxStatePending:
+		return "PENDING"
+	case OutboxStateSubmitted:
+		return "SUBMITTED"
+	case OutboxStateCompleted:
+		return "COMPLETED"
+	case OutboxStateFailed:
+		return "FAILED"
+	}
+
+	return fmt.Sprintf("UNKNOWN(%d)", uint32(state))
+}
+
+func _OutboxStateFromString(state string) (OutboxState, error) {
+	for _, known := range []OutboxState{OutboxStatePending, OutboxStateSubmitted, OutboxStateCompleted, OutboxStateFailed} {
+		if known.String() == state {
+			return known, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown outbox state %q", state)
+}
+
+// OutboxEntry is a frozen and signed transaction stored in an Outbox, with what is known about its outcome.
+type OutboxEntry struct {
+	// TransactionID is the ID of the transaction, which identifies the entry.
+	TransactionID TransactionID
+	// Transaction is the transaction as returned by ToBytes.
+	Transaction []byte
+	State       OutboxState
+	// NodeAccountID is the node which accepted the transaction, if it is known.
+	NodeAccountID *AccountID
+	// Status is the precheck status of the submission, or the receipt status once the receipt is known.
+	Status  Status
+	Receipt *TransactionReceipt
+	// Record is only set if the outbox fetches records.
+	Record *TransactionRecord
+	// Attempts is the number of times the outbox tried to submit the transaction or to get its receipt.
+	Attempts int
+	// LastError is the message of the last error processing the transaction.
+	LastError string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsDone returns true if the transaction is completed or failed, so the outbox no longer processes it.
+func (entry OutboxEntry) IsDone() bool {
+	return entry.State == OutboxStateCompleted || entry.State == OutboxStateFailed
+}
+
+// ErrOutboxDuplicateMismatch is the error of a transaction which a node reported as a duplicate, but whose
+// transaction ID was used by a different transaction.
+type ErrOutboxDuplicateMismatch struct {
+	TransactionID TransactionID
+}
+
+func (err ErrOutboxDuplicateMismatch) Error() string {
+	return fmt.Sprintf("transaction ID %s was used by a different transaction", err.TransactionID.String())
+}
+
+// Outbox guarantees that signed transactions are executed at least once, even if the process stops between freezing
+// a transaction and getting its receipt. A transaction is stored before it is submitted, and its state is stored
+// after every step, so a new Outbox on the same store resumes the unfinished transactions with Process.
+//
+// Transactions are identified by their transaction ID. Submitting a transaction again after a restart is safe: the
+// network rejects the second submission as DUPLICATE_TRANSACTION, which the outbox treats as a success if the
+// recorded transaction is the stored one.
+type Outbox struct {
+	mutex       sync.Mutex
+	client      *Client
+	store       OutboxStore
+	fetchRecord bool
+}
+
+// NewOutbox returns an outbox which executes transactions with client and stores them in store.
+func NewOutbox(client *Client, store OutboxStore) *Outbox {
+	return &Outbox{
+		client: client,
+		store:  store,
+	}
+}
+
+// SetFetchRecord sets whether the record of a completed transaction is fetched and stored with its receipt.
+// Records are paid queries.
+func (outbox *Outbox) SetFetchRecord(fetchRecord bool) *Outbox {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	outbox.fetchRecord = fetchRecord
+	return outbox
+}
+
+// GetFetchRecord returns whether the record of a completed transaction is fetched.
+func (outbox *Outbox) GetFetchRecord() bool {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	return outbox.fetchRecord
+}
+
+// Add stores a frozen and signed transaction without submitting it. If a transaction with the same transaction ID
+// is already stored, its entry is returned unchanged.
+func (outbox *Outbox) Add(tx TransactionInterface) (OutboxEntry, error) {
+	if tx == nil || !tx.getBaseTransaction().IsFrozen() {
+		return OutboxEntry{}, errTransactionIsNotFrozen
+	}
+
+	transactionID, err := TransactionGetTransactionID(tx)
+	if err != nil {
+		return OutboxEntry{}, err
+	}
+
+	data, err := TransactionToBytes(tx)
+	if err != nil {
+		return OutboxEntry{}, err
+	}
+
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	entry, ok, err := outbox.store.Get(transactionID)
+	if err != nil || ok {
+		return entry, err
+	}
+
+	now := time.Now()
+	entry = OutboxEntry{
+		TransactionID: transactionID,
+		Transaction:   data,
+		State:         OutboxStatePending,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	return entry, outbox.store.Save(entry)
+}
+
+// Submit stores a frozen and signed transaction and processes it until it is completed or failed. An error is
+// returned if the outcome of the transaction is not known yet, in which case a later Process continues it.
+// A failed transaction is not an error, its state and status are in the returned entry.
+func (outbox *Outbox) Submit(ctx context.Context, tx TransactionInterface) (OutboxEntry, error) {
+	entry, err := outbox.Add(tx)
+	if err != nil {
+		return entry, err
+	}
+
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	return outbox._Process(ctx, entry)
+}
+
+// Process processes every stored transaction which is not completed or failed, in the order they were added, and
+// returns their entries. It is called after a restart to resume the unfinished transactions. The errors of the
+// transactions whose outcome is not known yet are joined.
+func (outbox *Outbox) Process(ctx context.Context) ([]OutboxEntry, error) {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	entries, err := outbox.store.List()
+	if err != nil {
+		return nil, err
+	}
+	_SortOutboxEntries(entries)
+
+	processed := make([]OutboxEntry, 0, len(entries))
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDone() {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+
+		entry, err = outbox._Process(ctx, entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("transaction %s: %w", entry.TransactionID.String(), err))
+		}
+		processed = append(processed, entry)
+	}
+
+	return processed, errors.Join(errs...)
+}
+
+// Get returns the entry of the transaction ID, and false if it is not stored.
+func (outbox *Outbox) Get(transactionID TransactionID) (OutboxEntry, bool, error) {
+	return outbox.store.Get(transactionID)
+}
+
+// List returns every stored entry, in the order they were added.
+func (outbox *Outbox) List() ([]OutboxEntry, error) {
+	entries, err := outbox.store.List()
+	if err != nil {
+		return nil, err
+	}
+	_SortOutboxEntries(entries)
+
+	return entries, nil
+}
+
+// Remove deletes the entry of the transaction ID, for example once the outcome of a completed transaction was
+// handled. Unfinished transactions removed from the outbox are no longer resumed.
+func (outbox *Outbox) Remove(transactionID TransactionID) error {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	return outbox.store.Delete(transactionID)
+}
+
+func (outbox *Outbox) _Process(ctx context.Context, entry OutboxEntry) (OutboxEntry, error) {
+	var err error
+	if entry.State == OutboxStatePending {
+		if entry, err = outbox._Submit(ctx, entry); err != nil {
+			return entry, err
+		}
+	}
+
+	if entry.State == OutboxStateSubmitted {
+		return outbox._Resolve(ctx, entry)
+	}
+
+	return entry, nil
+}
+
+// _Submit executes a pending transaction. A transaction which the network may have received before, because it is a
+// duplicate or expired while the process was stopped, is moved to submitted with that status so that _Resolve checks
+// whether the network executed this transaction. A transaction rejected because the nodes were busy or throttled stays
+// pending, so that it is submitted again later.
+func (outbox *Outbox) _Submit(ctx context.Context, entry OutboxEntry) (OutboxEntry, error) {
+	tx, err := TransactionFromBytes(entry.Transaction)
+	if err != nil {
+		return outbox._Fail(entry, StatusInvalidTransaction, err)
+	}
+
+	entry.Attempts++
+	response, err := TransactionExecuteWithContext(ctx, tx, outbox.client)
+
+	var precheck ErrHederaPreCheckStatus
+	switch {
+	case err == nil:
+		entry.State = OutboxStateSubmitted
+		entry.Status = StatusOk
+		entry.NodeAccountID = &response.NodeID
+		entry.LastError = ""
+	case errors.As(err, &precheck) && (precheck.Status == StatusDuplicateTransaction || precheck.Status == StatusTransactionExpired):
+		entry.State = OutboxStateSubmitted
+		entry.Status = precheck.Status
+		entry.NodeAccountID = nil
+		entry.LastError = err.Error()
+	case errors.As(err, &precheck) && _IsOutboxRetryablePrecheck(precheck.Status):
+		return outbox._Retry(entry, err)
+	case errors.As(err, &precheck):
+		return outbox._Fail(entry, precheck.Status, err)
+	default:
+		return outbox._Retry(entry, err)
+	}
+
+	return entry, outbox._Save(&entry)
+}
+
+// _Resolve gets the receipt of a submitted transaction, and its record if it is fetched or needed to check that a
+// duplicate is the stored transaction.
+func (outbox *Outbox) _Resolve(ctx context.Context, entry OutboxEntry) (OutboxEntry, error) {
+	duplicate := entry.Status != StatusOk
+
+	query := NewTransactionReceiptQuery().SetTransactionID(entry.TransactionID)
+	if entry.NodeAccountID != nil {
+		query.SetNodeAccountIDs([]AccountID{*entry.NodeAccountID})
+	}
+
+	entry.Attempts++
+	receipt, err := query.ExecuteWithContext(ctx, outbox.client)
+	if err != nil {
+		if ctx.Err() == nil && outbox._IsReceiptExpired(entry) {
+			if entry.Status == StatusTransactionExpired {
+				return outbox._Fail(entry, StatusTransactionExpired, err)
+			}
+			return outbox._Fail(entry, StatusReceiptNotFound, err)
+		}
+
+		return outbox._Retry(entry, err)
+	}
+
+	if receipt.Status != StatusSuccess {
+		entry.Receipt = &receipt
+		return outbox._Fail(entry, receipt.Status, _NewErrHederaReceiptStatus(entry.TransactionID, receipt.Status))
+	}
+
+	if duplicate || outbox.fetchRecord {
+		record, err := NewTransactionRecordQuery().
+			SetTransactionID(entry.TransactionID).
+			ExecuteWithContext(ctx, outbox.client)
+		if err != nil {
+			return outbox._Retry(entry, err)
+		}
+
+		if duplicate {
+			matches, err := outbox._IsStoredTransaction(entry, record)
+			if err != nil {
+				return outbox._Retry(entry, err)
+			}
+			if !matches {
+				return outbox._Fail(entry, StatusDuplicateTransaction, ErrOutboxDuplicateMismatch{TransactionID: entry.TransactionID})
+			}
+		}
+
+		if outbox.fetchRecord {
+			entry.Record = &record
+		}
+	}
+
+	entry.State = OutboxStateCompleted
+	entry.Status = receipt.Status
+	entry.Receipt = &receipt
+	entry.LastError = ""
+
+	return entry, outbox._Save(&entry)
+}
+
+// _IsStoredTransaction returns true if the record is of the stored transaction, which was signed once for every node
+func (outbox *Outbox) _IsStoredTransaction(entry OutboxEntry, record TransactionRecord) (bool, error) {
+	tx, err := TransactionFromBytes(entry.Transaction)
+	if err != nil {
+		return false, err
+	}
+
+	hashes, err := TransactionGetTransactionHashPerNode(tx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, hash := range hashes {
+		if bytes.Equal(hash, record.TransactionHash) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// _IsReceiptExpired returns true if the transaction can no longer reach consensus and its receipt is no longer kept
+func (outbox *Outbox) _IsReceiptExpired(entry OutboxEntry) bool {
+	if entry.TransactionID.ValidStart == nil {
+		return false
+	}
+
+	validDuration := 120 * time.Second
+	if tx, err := TransactionFromBytes(entry.Transaction); err == nil {
+		if duration, err := TransactionGetTransactionValidDuration(tx); err == nil && duration > 0 {
+			validDuration = duration
+		}
+	}
+
+	return time.Now().After(entry.TransactionID.ValidStart.Add(validDuration + receiptRetentionPeriod))
+}
+
+// _IsOutboxRetryablePrecheck reports whether a precheck status rejected a transaction only because of the state of
+// the nodes, so that submitting the same transaction later can succeed
+func _IsOutboxRetryablePrecheck(status Status) bool {
+	switch status {
+	case StatusBusy, StatusPlatformNotActive, StatusPlatformTransactionNotCreated, StatusThrottledAtConsensus:
+		return true
+	default:
+		return false
+	}
+}
+
+func (outbox *Outbox) _Fail(entry OutboxEntry, status Status, err error) (OutboxEntry, error) {
+	entry.State = OutboxStateFailed
+	entry.Status = status
+	entry.LastError = err.Error()
+
+	return entry, outbox._Save(&entry)
+}
+
+// _Retry stores the error of an attempt whose outcome is not known, so the entry is processed again later
+func (outbox *Outbox) _Retry(entry OutboxEntry, err error) (OutboxEntry, error) {
+	entry.LastError = err.Error()
+	if saveErr := outbox._Save(&entry); saveErr != nil {
+		return entry, errors.Join(err, saveErr)
+	}
+
+	return entry, err
+}
+
+func (outbox *Outbox) _Save(entry *OutboxEntry) error {
+	entry.UpdatedAt = time.Now()
+	return outbox.store.Save(*entry)
+}
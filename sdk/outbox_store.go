@@ -0,0 +1,324 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OutboxStore persists the entries of an Outbox. Entries are keyed by their transaction ID. A store is used by a
+// single Outbox at a time; implementations backed by a database can store OutboxEntry.ToBytes next to the transaction
+// ID and state.
+type OutboxStore interface {
+	// Save inserts the entry, or replaces the entry with the same transaction ID. The entry must be durable when Save
+	// returns.
+	Save(entry OutboxEntry) error
+	// Get returns the entry with the transaction ID, and false if there is none.
+	Get(transactionID TransactionID) (OutboxEntry, bool, error)
+	// List returns every entry.
+	List() ([]OutboxEntry, error)
+	// Delete removes the entry with the transaction ID. Deleting a missing entry is not an error.
+	Delete(transactionID TransactionID) error
+}
+
+const outboxFileExtension = ".json"
+
+// FileOutboxStore stores every outbox entry in its own file in a directory. Files are replaced atomically, so an
+// entry is never lost or partially written if the process crashes while saving it.
+type FileOutboxStore struct {
+	mutex     sync.Mutex
+	directory string
+}
+
+// NewFileOutboxStore returns a store which keeps its entries in directory, creating it if needed.
+func NewFileOutboxStore(directory string) (*FileOutboxStore, error) {
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return nil, err
+	}
+
+	return &FileOutboxStore{directory: directory}, nil
+}
+
+// GetDirectory returns the directory the entries are stored in.
+func (store *FileOutboxStore) GetDirectory() string {
+	return store.directory
+}
+
+func (store *FileOutboxStore) Save(entry OutboxEntry) error {
+	data, err := entry.ToBytes()
+	if err != nil {
+		return err
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return _WriteFileAtomic(store.directory, ".outbox-*", store._Path(entry.TransactionID), data)
+}
+
+// _WriteFileAtomic writes data to a temporary file in directory and renames it to path, so path is never partially
+// written
+func _WriteFileAtomic(directory string, tempPattern string, path string, data []byte) error {
+	file, err := os.CreateTemp(directory, tempPattern)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name()) // #nosec
+
+	if _, err = file.Write(data); err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = os.Rename(file.Name(), path); err != nil {
+		return err
+	}
+
+	// Make the rename durable, this is not supported on every platform
+	if directory, err := os.Open(directory); err == nil {
+		_ = directory.Sync()
+		_ = directory.Close()
+	}
+
+	return nil
+}
+
+func (store *FileOutboxStore) Get(transactionID TransactionID) (OutboxEntry, bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	data, err := os.ReadFile(store._Path(transactionID))
+	if errors.Is(err, os.ErrNotExist) {
+		return OutboxEntry{}, false, nil
+	}
+	if err != nil {
+		return OutboxEntry{}, false, err
+	}
+
+	entry, err := OutboxEntryFromBytes(data)
+	return entry, err == nil, err
+}
+
+func (store *FileOutboxStore) List() ([]OutboxEntry, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	files, err := os.ReadDir(store.directory)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]OutboxEntry, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") || !strings.HasSuffix(file.Name(), outboxFileExtension) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(store.directory, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		entry, err := OutboxEntryFromBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read outbox entry %s: %w", file.Name(), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (store *FileOutboxStore) Delete(transactionID TransactionID) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if err := os.Remove(store._Path(transactionID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (store *FileOutboxStore) _Path(transactionID TransactionID) string {
+	return filepath.Join(store.directory, url.PathEscape(transactionID.String())+outboxFileExtension)
+}
+
+// MemoryOutboxStore keeps the outbox entries in memory, so they do not survive a restart. It is meant for tests.
+type MemoryOutboxStore struct {
+	mutex   sync.Mutex
+	entries map[string][]byte
+}
+
+// NewMemoryOutboxStore returns an empty in-memory store.
+func NewMemoryOutboxStore() *MemoryOutboxStore {
+	return &MemoryOutboxStore{entries: map[string][]byte{}}
+}
+
+func (store *MemoryOutboxStore) Save(entry OutboxEntry) error {
+	data, err := entry.ToBytes()
+	if err != nil {
+		return err
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.entries[entry.TransactionID.String()] = data
+	return nil
+}
+
+func (store *MemoryOutboxStore) Get(transactionID TransactionID) (OutboxEntry, bool, error) {
+	store.mutex.Lock()
+	data, ok := store.entries[transactionID.String()]
+	store.mutex.Unlock()
+
+	if !ok {
+		return OutboxEntry{}, false, nil
+	}
+
+	entry, err := OutboxEntryFromBytes(data)
+	return entry, err == nil, err
+}
+
+func (store *MemoryOutboxStore) List() ([]OutboxEntry, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	entries := make([]OutboxEntry, 0, len(store.entries))
+	for _, data := range store.entries {
+		entry, err := OutboxEntryFromBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (store *MemoryOutboxStore) Delete(transactionID TransactionID) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	delete(store.entries, transactionID.String())
+	return nil
+}
+
+type _OutboxEntryJSON struct {
+	TransactionID []byte    `json:"transactionId"`
+	Transaction   []byte    `json:"transaction"`
+	State         string    `json:"state"`
+	NodeAccountID string    `json:"nodeAccountId,omitempty"`
+	Status        int32     `json:"status"`
+	Receipt       []byte    `json:"receipt,omitempty"`
+	Record        []byte    `json:"record,omitempty"`
+	Attempts      int       `json:"attempts"`
+	LastError     string    `json:"lastError,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// ToBytes returns the JSON representation of the entry, which OutboxEntryFromBytes reads.
+func (entry OutboxEntry) ToBytes() ([]byte, error) {
+	raw := _OutboxEntryJSON{
+		TransactionID: entry.TransactionID.ToBytes(),
+		Transaction:   entry.Transaction,
+		State:         entry.State.String(),
+		Status:        int32(entry.Status),
+		Attempts:      entry.Attempts,
+		LastError:     entry.LastError,
+		CreatedAt:     entry.CreatedAt,
+		UpdatedAt:     entry.UpdatedAt,
+	}
+
+	if entry.NodeAccountID != nil {
+		raw.NodeAccountID = entry.NodeAccountID.String()
+	}
+	if entry.Receipt != nil {
+		raw.Receipt = entry.Receipt.ToBytes()
+	}
+	if entry.Record != nil {
+		raw.Record = entry.Record.ToBytes()
+	}
+
+	return json.Marshal(raw)
+}
+
+// OutboxEntryFromBytes returns the entry from its JSON representation, as returned by OutboxEntry.ToBytes.
+func OutboxEntryFromBytes(data []byte) (OutboxEntry, error) {
+	var raw _OutboxEntryJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return OutboxEntry{}, err
+	}
+
+	transactionID, err := TransactionIDFromBytes(raw.TransactionID)
+	if err != nil {
+		return OutboxEntry{}, err
+	}
+
+	state, err := _OutboxStateFromString(raw.State)
+	if err != nil {
+		return OutboxEntry{}, err
+	}
+
+	entry := OutboxEntry{
+		TransactionID: transactionID,
+		Transaction:   raw.Transaction,
+		State:         state,
+		Status:        Status(raw.Status),
+		Attempts:      raw.Attempts,
+		LastError:     raw.LastError,
+		CreatedAt:     raw.CreatedAt,
+		UpdatedAt:     raw.UpdatedAt,
+	}
+
+	if raw.NodeAccountID != "" {
+		nodeAccountID, err := AccountIDFromString(raw.NodeAccountID)
+		if err != nil {
+			return OutboxEntry{}, err
+		}
+		entry.NodeAccountID = &nodeAccountID
+	}
+	if raw.Receipt != nil {
+		receipt, err := TransactionReceiptFromBytes(raw.Receipt)
+		if err != nil {
+			return OutboxEntry{}, err
+		}
+		entry.Receipt = &receipt
+	}
+	if raw.Record != nil {
+		record, err := TransactionRecordFromBytes(raw.Record)
+		if err != nil {
+			return OutboxEntry{}, err
+		}
+		entry.Record = &record
+	}
+
+	return entry, nil
+}
+
+// _SortOutboxEntries orders entries by the time they were added, then by transaction ID
+func _SortOutboxEntries(entries []OutboxEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].CreatedAt.Equal(entries[j].CreatedAt) {
+			return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+		}
+
+		return entries[i].TransactionID.String() < entries[j].TransactionID.String()
+	})
+}
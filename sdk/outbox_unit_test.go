@@ -0,0 +1,302 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _OutboxTransfer(t *testing.T, client *Client, amount int64) *TransferTransaction {
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-amount)).
+		AddHbarTransfer(AccountID{Account: 1001}, HbarFromTinybar(amount)).
+		FreezeWith(client)
+	require.NoError(t, err)
+
+	return tx
+}
+
+func _OutboxReceipt(status services.ResponseCodeEnum) *services.Response {
+	return &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+				Receipt: &services.TransactionReceipt{Status: status},
+			},
+		},
+	}
+}
+
+// _OutboxRecord answers the cost query and the query of a record with the transaction hash, which is read when the
+// query is received
+func _OutboxRecord(hash *[]byte) func(request *services.Query) *services.Response {
+	return func(request *services.Query) *services.Response {
+		return &services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{
+						NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+						ResponseType:                request.GetTransactionGetRecord().GetHeader().GetResponseType(),
+					},
+					TransactionRecord: &services.TransactionRecord{
+						Receipt:         &services.TransactionReceipt{Status: services.ResponseCodeEnum_SUCCESS},
+						TransactionHash: *hash,
+					},
+				},
+			},
+		}
+	}
+}
+
+func _OutboxPrecheck(status services.ResponseCodeEnum) *services.TransactionResponse {
+	return &services.TransactionResponse{NodeTransactionPrecheckCode: status}
+}
+
+func TestUnitOutboxFileStore(t *testing.T) {
+	t.Parallel()
+
+	directory := filepath.Join(t.TempDir(), "outbox")
+	store, err := NewFileOutboxStore(directory)
+	require.NoError(t, err)
+	assert.Equal(t, directory, store.GetDirectory())
+
+	validStart := time.Unix(1_700_000_000, 1)
+	transactionID := TransactionIDGenerate(AccountID{Account: 1800})
+	transactionID.ValidStart = &validStart
+	entry := OutboxEntry{
+		TransactionID: transactionID,
+		Transaction:   []byte{1, 2, 3},
+		State:         OutboxStateCompleted,
+		NodeAccountID: &AccountID{Account: 3},
+		Status:        StatusSuccess,
+		Receipt:       &TransactionReceipt{Status: StatusSuccess},
+		Record:        &TransactionRecord{Receipt: TransactionReceipt{Status: StatusSuccess}, TransactionHash: []byte{4}},
+		Attempts:      2,
+		CreatedAt:     validStart.UTC(),
+		UpdatedAt:     validStart.UTC(),
+	}
+	require.NoError(t, store.Save(entry))
+
+	// temporary files of an interrupted save are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(directory, ".outbox-123"), []byte("{"), 0o600))
+
+	stored, ok, err := store.Get(transactionID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, transactionID.String(), stored.TransactionID.String())
+	assert.Equal(t, entry.Transaction, stored.Transaction)
+	assert.Equal(t, OutboxStateCompleted, stored.State)
+	assert.Equal(t, AccountID{Account: 3}, *stored.NodeAccountID)
+	assert.Equal(t, StatusSuccess, stored.Receipt.Status)
+	assert.Equal(t, []byte{4}, stored.Record.TransactionHash)
+	assert.Equal(t, 2, stored.Attempts)
+	assert.True(t, entry.CreatedAt.Equal(stored.CreatedAt))
+
+	entries, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	require.NoError(t, store.Delete(transactionID))
+	require.NoError(t, store.Delete(transactionID))
+	_, ok, err = store.Get(transactionID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = OutboxEntryFromBytes([]byte(`{"state":"LOST"}`))
+	require.Error(t, err)
+	assert.Equal(t, "SUBMITTED", OutboxStateSubmitted.String())
+}
+
+func TestUnitOutboxSubmit(t *testing.T) {
+	t.Parallel()
+
+	var hash []byte
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+		_OutboxRecord(&hash),
+		_OutboxRecord(&hash),
+	}})
+	defer server.Close()
+
+	tx := _OutboxTransfer(t, client, 1)
+	hashes, err := tx.GetTransactionHashPerNode()
+	require.NoError(t, err)
+	hash = hashes[AccountID{Account: 3}]
+
+	outbox := NewOutbox(client, NewMemoryOutboxStore()).SetFetchRecord(true)
+	assert.True(t, outbox.GetFetchRecord())
+
+	entry, err := outbox.Submit(context.Background(), tx)
+	require.NoError(t, err)
+	assert.Equal(t, OutboxStateCompleted, entry.State)
+	assert.Equal(t, StatusSuccess, entry.Status)
+	assert.Equal(t, AccountID{Account: 3}, *entry.NodeAccountID)
+	assert.Equal(t, 2, entry.Attempts)
+	require.NotNil(t, entry.Record)
+	assert.Equal(t, hash, entry.Record.TransactionHash)
+
+	// the stored outcome is returned without contacting the network again
+	entry, err = outbox.Submit(context.Background(), tx)
+	require.NoError(t, err)
+	assert.Equal(t, OutboxStateCompleted, entry.State)
+
+	entries, err := outbox.Process(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = outbox.Add(NewTransferTransaction())
+	require.ErrorIs(t, err, errTransactionIsNotFrozen)
+
+	require.NoError(t, outbox.Remove(entry.TransactionID))
+	entries, err = outbox.List()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestUnitOutboxResume(t *testing.T) {
+	t.Parallel()
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+	}})
+	defer server.Close()
+
+	directory := t.TempDir()
+	store, err := NewFileOutboxStore(directory)
+	require.NoError(t, err)
+
+	// the process stops before the first transaction is submitted
+	outbox := NewOutbox(client, store)
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	pending, err := outbox.Submit(cancelled, _OutboxTransfer(t, client, 1))
+	require.Error(t, err)
+	assert.Equal(t, OutboxStatePending, pending.State)
+	assert.Equal(t, 1, pending.Attempts)
+	assert.NotEmpty(t, pending.LastError)
+
+	// and before the receipt of the second one is known
+	submitted, err := outbox.Add(_OutboxTransfer(t, client, 2))
+	require.NoError(t, err)
+	submitted.State = OutboxStateSubmitted
+	submitted.NodeAccountID = &AccountID{Account: 3}
+	require.NoError(t, store.Save(submitted))
+
+	store, err = NewFileOutboxStore(directory)
+	require.NoError(t, err)
+	entries, err := NewOutbox(client, store).Process(context.Background())
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, pending.TransactionID.String(), entries[0].TransactionID.String())
+	assert.Equal(t, submitted.TransactionID.String(), entries[1].TransactionID.String())
+	for _, entry := range entries {
+		assert.Equal(t, OutboxStateCompleted, entry.State)
+		assert.Equal(t, StatusSuccess, entry.Receipt.Status)
+		assert.Empty(t, entry.LastError)
+	}
+	assert.Equal(t, 3, entries[0].Attempts)
+	assert.Equal(t, 1, entries[1].Attempts)
+}
+
+func TestUnitOutboxDuplicate(t *testing.T) {
+	t.Parallel()
+
+	for _, matches := range []bool{true, false} {
+		hash := []byte("another transaction")
+		client, server := NewMockClientAndServer([][]interface{}{{
+			_OutboxPrecheck(services.ResponseCodeEnum_DUPLICATE_TRANSACTION),
+			_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+			_OutboxRecord(&hash),
+			_OutboxRecord(&hash),
+		}})
+		defer server.Close()
+
+		tx := _OutboxTransfer(t, client, 1)
+		if matches {
+			hashes, err := tx.GetTransactionHashPerNode()
+			require.NoError(t, err)
+			hash = hashes[AccountID{Account: 3}]
+		}
+
+		entry, err := NewOutbox(client, NewMemoryOutboxStore()).Submit(context.Background(), tx)
+		require.NoError(t, err)
+		assert.Nil(t, entry.Record)
+
+		if matches {
+			assert.Equal(t, OutboxStateCompleted, entry.State)
+			assert.Equal(t, StatusSuccess, entry.Status)
+			continue
+		}
+
+		assert.Equal(t, OutboxStateFailed, entry.State)
+		assert.Equal(t, StatusDuplicateTransaction, entry.Status)
+		assert.Equal(t, ErrOutboxDuplicateMismatch{TransactionID: entry.TransactionID}.Error(), entry.LastError)
+	}
+}
+
+func TestUnitOutboxFailed(t *testing.T) {
+	t.Parallel()
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_OutboxPrecheck(services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE),
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_INSUFFICIENT_ACCOUNT_BALANCE),
+	}})
+	defer server.Close()
+
+	outbox := NewOutbox(client, NewMemoryOutboxStore())
+
+	entry, err := outbox.Submit(context.Background(), _OutboxTransfer(t, client, 1))
+	require.NoError(t, err)
+	assert.Equal(t, OutboxStateFailed, entry.State)
+	assert.Equal(t, StatusInsufficientPayerBalance, entry.Status)
+	assert.Nil(t, entry.Receipt)
+
+	entry, err = outbox.Submit(context.Background(), _OutboxTransfer(t, client, 2))
+	require.NoError(t, err)
+	assert.Equal(t, OutboxStateFailed, entry.State)
+	assert.Equal(t, StatusInsufficientAccountBalance, entry.Status)
+	require.NotNil(t, entry.Receipt)
+	assert.True(t, entry.IsDone())
+}
+
+func TestUnitOutboxBusy(t *testing.T) {
+	t.Parallel()
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_OutboxPrecheck(services.ResponseCodeEnum_BUSY),
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+	}})
+	defer server.Close()
+	client.SetMaxAttempts(1)
+
+	// a transaction rejected because the node is busy stays pending
+	outbox := NewOutbox(client, NewMemoryOutboxStore())
+	entry, err := outbox.Submit(context.Background(), _OutboxTransfer(t, client, 1))
+	require.ErrorContains(t, err, StatusBusy.String())
+	assert.Equal(t, OutboxStatePending, entry.State)
+	assert.Contains(t, entry.LastError, StatusBusy.String())
+
+	entries, err := outbox.Process(context.Background())
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, OutboxStateCompleted, entries[0].State)
+	assert.Equal(t, StatusSuccess, entries[0].Receipt.Status)
+	assert.Equal(t, 3, entries[0].Attempts)
+}
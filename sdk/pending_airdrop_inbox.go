@@ -0,0 +1,297 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// pendingAirdropMaxBatchSize is the maximum number of pending airdrops a single claim or cancel transaction accepts
+const pendingAirdropMaxBatchSize = 10
+
+// PendingAirdropBatchResult is the outcome of a single claim, cancel or reject transaction of a PendingAirdropInbox.
+type PendingAirdropBatchResult struct {
+	PendingAirdropIds []PendingAirdropId
+	// TransactionID is the ID of the claim or cancel transaction, nil when it was not submitted.
+	TransactionID *TransactionID
+	// Receipt is the receipt of the claim or cancel transaction, nil when it was not received.
+	Receipt *TransactionReceipt
+	// RejectTransactionID is the ID of the TokenRejectTransaction of a reject, nil when the tokens were not rejected.
+	RejectTransactionID *TransactionID
+	// RejectReceipt is the receipt of the TokenRejectTransaction of a reject, nil when it was not received.
+	RejectReceipt *TransactionReceipt
+	Err           error
+}
+
+// PendingAirdropInbox discovers the pending airdrops of an account through the mirror node REST API and claims,
+// cancels or rejects them in batches sized to the network limits.
+//
+// Incoming airdrops are the ones the account received and can claim or reject, outgoing airdrops are the ones the
+// account sent and can cancel. The transactions are paid by the client operator; when the operator is not the
+// account, Sign or SignWithSigner must be called with the key of the account.
+type PendingAirdropInbox struct {
+	client    *Client
+	accountID AccountID
+	batchSize int
+	signer    Signer
+}
+
+// NewPendingAirdropInbox creates a PendingAirdropInbox for the pending airdrops of the account.
+func NewPendingAirdropInbox(client *Client, accountID AccountID) *PendingAirdropInbox {
+	return &PendingAirdropInbox{
+		client:    client,
+		accountID: accountID,
+		batchSize: pendingAirdropMaxBatchSize,
+	}
+}
+
+// GetAccountID returns the account whose pending airdrops are managed.
+func (inbox *PendingAirdropInbox) GetAccountID() AccountID {
+	return inbox.accountID
+}
+
+// SetBatchSize sets the number of pending airdrops handled by a single transaction, at most 10.
+func (inbox *PendingAirdropInbox) SetBatchSize(batchSize int) *PendingAirdropInbox {
+	inbox.batchSize = batchSize
+	return inbox
+}
+
+// GetBatchSize returns the number of pending airdrops handled by a single transaction.
+func (inbox *PendingAirdropInbox) GetBatchSize() int {
+	return inbox.batchSize
+}
+
+// Sign signs every transaction with the private key of the account.
+func (inbox *PendingAirdropInbox) Sign(privateKey PrivateKey) *PendingAirdropInbox {
+	return inbox.SignWithSigner(NewPrivateKeySigner(privateKey))
+}
+
+// SignWithSigner signs every transaction with the Signer of the account.
+func (inbox *PendingAirdropInbox) SignWithSigner(signer Signer) *PendingAirdropInbox {
+	inbox.signer = signer
+	return inbox
+}
+
+// Incoming returns the pending airdrops the account received.
+func (inbox *PendingAirdropInbox) Incoming(ctx context.Context) ([]MirrorAirdrop, error) {
+	restClient, err := inbox.client.GetMirrorRestClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return MirrorRestCollect(restClient.AccountPendingAirdrops(ctx, inbox.accountID, nil))
+}
+
+// Outgoing returns the pending airdrops the account sent that were not claimed yet.
+func (inbox *PendingAirdropInbox) Outgoing(ctx context.Context) ([]MirrorAirdrop, error) {
+	restClient, err := inbox.client.GetMirrorRestClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return MirrorRestCollect(restClient.AccountOutstandingAirdrops(ctx, inbox.accountID, nil))
+}
+
+// ClaimAll claims every pending airdrop the account received.
+func (inbox *PendingAirdropInbox) ClaimAll(ctx context.Context) ([]PendingAirdropBatchResult, error) {
+	airdrops, err := inbox.Incoming(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return inbox.Claim(ctx, _PendingAirdropIds(airdrops))
+}
+
+// CancelAll cancels every pending airdrop the account sent.
+func (inbox *PendingAirdropInbox) CancelAll(ctx context.Context) ([]PendingAirdropBatchResult, error) {
+	airdrops, err := inbox.Outgoing(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return inbox.Cancel(ctx, _PendingAirdropIds(airdrops))
+}
+
+// Claim claims the pending airdrops, which must all be received by the account. The batches are executed one after
+// the other and the returned error joins the errors of the failed batches.
+func (inbox *PendingAirdropInbox) Claim(ctx context.Context, ids []PendingAirdropId) ([]PendingAirdropBatchResult, error) {
+	if err := inbox._Validate(ids, true); err != nil {
+		return nil, err
+	}
+
+	return inbox._Execute(ctx, ids, func(batch []PendingAirdropId) (*PendingAirdropBatchResult, error) {
+		tx := NewTokenClaimAirdropTransaction()
+		for _, id := range batch {
+			tx.AddPendingAirdropId(id)
+		}
+		return inbox._Submit(ctx, tx)
+	})
+}
+
+// Cancel cancels the pending airdrops, which must all be sent by the account. The batches are executed one after
+// the other and the returned error joins the errors of the failed batches.
+func (inbox *PendingAirdropInbox) Cancel(ctx context.Context, ids []PendingAirdropId) ([]PendingAirdropBatchResult, error) {
+	if err := inbox._Validate(ids, false); err != nil {
+		return nil, err
+	}
+
+	return inbox._Execute(ctx, ids, func(batch []PendingAirdropId) (*PendingAirdropBatchResult, error) {
+		tx := NewTokenCancelAirdropTransaction()
+		for _, id := range batch {
+			tx.AddPendingAirdropId(id)
+		}
+		return inbox._Submit(ctx, tx)
+	})
+}
+
+// Reject claims the pending airdrops, which must all be received by the account, and returns them to their treasury
+// with a TokenRejectFlow, which also dissociates the account from the tokens.
+//
+// Rejecting a fungible token returns the full balance the account holds, not only the airdropped amount, and the
+// dissociation fails when the account holds other NFTs of a rejected collection.
+func (inbox *PendingAirdropInbox) Reject(ctx context.Context, ids []PendingAirdropId) ([]PendingAirdropBatchResult, error) {
+	if err := inbox._Validate(ids, true); err != nil {
+		return nil, err
+	}
+
+	return inbox._Execute(ctx, ids, func(batch []PendingAirdropId) (*PendingAirdropBatchResult, error) {
+		claim := NewTokenClaimAirdropTransaction()
+		for _, id := range batch {
+			claim.AddPendingAirdropId(id)
+		}
+		result, err := inbox._Submit(ctx, claim)
+		if err != nil {
+			return result, err
+		}
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		flow := NewTokenRejectFlow().SetOwnerID(inbox.accountID)
+		seen := make(map[TokenID]struct{})
+		for _, id := range batch {
+			if nftID := id.GetNftID(); nftID != nil {
+				flow.AddNftID(*nftID)
+			} else if tokenID := id.GetTokenID(); tokenID != nil {
+				if _, ok := seen[*tokenID]; !ok {
+					seen[*tokenID] = struct{}{}
+					flow.AddTokenID(*tokenID)
+				}
+			}
+		}
+		if inbox.signer != nil {
+			flow.SignWithSigner(inbox.signer)
+		}
+		if _, err := flow.FreezeWith(inbox.client); err != nil {
+			return result, err
+		}
+		response, err := flow.ExecuteWithContext(ctx, inbox.client)
+		if err != nil {
+			return result, err
+		}
+
+		result.RejectTransactionID = &response.TransactionID
+		receipt, err := response.GetReceiptWithContext(ctx, inbox.client)
+		result.RejectReceipt = &receipt
+
+		return result, err
+	})
+}
+
+func (inbox *PendingAirdropInbox) _Validate(ids []PendingAirdropId, incoming bool) error {
+	if inbox.batchSize < 1 || inbox.batchSize > pendingAirdropMaxBatchSize {
+		return fmt.Errorf("batch size must be between 1 and %d", pendingAirdropMaxBatchSize)
+	}
+
+	for _, id := range ids {
+		if id.GetTokenID() == nil && id.GetNftID() == nil {
+			return fmt.Errorf("pending airdrop %s has no token", id.String())
+		}
+
+		account := id.GetSender()
+		if incoming {
+			account = id.GetReceiver()
+		}
+		if account == nil || account.Compare(inbox.accountID) != 0 {
+			if incoming {
+				return fmt.Errorf("pending airdrop %s is not received by %s", id.String(), inbox.accountID.String())
+			}
+			return fmt.Errorf("pending airdrop %s is not sent by %s", id.String(), inbox.accountID.String())
+		}
+	}
+
+	return nil
+}
+
+// _Execute runs the batches one after the other, the ones left once the context is done fail with its error
+func (inbox *PendingAirdropInbox) _Execute(
+	ctx context.Context,
+	ids []PendingAirdropId,
+	execute func(batch []PendingAirdropId) (*PendingAirdropBatchResult, error),
+) ([]PendingAirdropBatchResult, error) {
+	results := make([]PendingAirdropBatchResult, 0, (len(ids)+inbox.batchSize-1)/inbox.batchSize)
+	var errs []error
+
+	for start := 0; start < len(ids); start += inbox.batchSize {
+		batch := ids[start:min(start+inbox.batchSize, len(ids))]
+
+		var result *PendingAirdropBatchResult
+		err := ctx.Err()
+		if err == nil {
+			result, err = execute(batch)
+		}
+		if result == nil {
+			result = &PendingAirdropBatchResult{}
+		}
+		result.PendingAirdropIds = batch
+		result.Err = err
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		results = append(results, *result)
+	}
+
+	return results, errors.Join(errs...)
+}
+
+// _Submit freezes, signs and executes the transaction and waits for its receipt
+func (inbox *PendingAirdropInbox) _Submit(ctx context.Context, tx TransactionInterface) (*PendingAirdropBatchResult, error) {
+	result := &PendingAirdropBatchResult{}
+
+	if _, err := TransactionFreezeWith(tx, inbox.client); err != nil {
+		return result, err
+	}
+	if inbox.signer != nil {
+		if _, err := TransactionSignWithSigner(tx, inbox.signer); err != nil {
+			return result, err
+		}
+	}
+
+	transactionID, err := TransactionGetTransactionID(tx)
+	if err != nil {
+		return result, err
+	}
+	result.TransactionID = &transactionID
+
+	response, err := TransactionExecuteWithContext(ctx, tx, inbox.client)
+	if err != nil {
+		return result, err
+	}
+
+	receipt, err := response.GetReceiptWithContext(ctx, inbox.client)
+	result.Receipt = &receipt
+
+	return result, err
+}
+
+func _PendingAirdropIds(airdrops []MirrorAirdrop) []PendingAirdropId {
+	ids := make([]PendingAirdropId, len(airdrops))
+	for i, airdrop := range airdrops {
+		ids[i] = airdrop.GetPendingAirdropId()
+	}
+
+	return ids
+}
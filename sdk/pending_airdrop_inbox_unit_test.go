@@ -0,0 +1,188 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// _PendingAirdropMirror serves the pending airdrops of 0.0.1800, the first page lists 11 fungible token airdrops and
+// links to the second one, which lists an NFT airdrop
+func _PendingAirdropMirror(t *testing.T, direction string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/accounts/0.0.1800/airdrops/"+direction, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		sender, receiver := "0.0.1001", "0.0.1800"
+		if direction == "outstanding" {
+			sender, receiver = receiver, sender
+		}
+
+		if r.URL.Query().Get("page") == "" {
+			airdrops := make([]string, 11)
+			for i := range airdrops {
+				airdrops[i] = fmt.Sprintf(`{"amount": %d, "receiver_id": %q, "sender_id": %q, "serial_number": null,
+					"timestamp": {"from": "1700000000.000000001", "to": null}, "token_id": "0.0.%d"}`, i+1, receiver, sender, 500+i)
+			}
+			next := "/api/v1/accounts/0.0.1800/airdrops/" + direction + "?page=2"
+			_, _ = fmt.Fprintf(w, `{"airdrops": [%s], "links": {"next": %q}}`, strings.Join(airdrops, ","), next)
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"airdrops": [{"amount": 0, "receiver_id": %q, "sender_id": %q, "serial_number": 7,
+			"timestamp": {"from": "1700000001.000000000", "to": null}, "token_id": "0.0.700"}], "links": {"next": null}}`, receiver, sender)
+	}))
+}
+
+func TestUnitMirrorRestClientAccountPendingAirdrops(t *testing.T) {
+	t.Parallel()
+
+	server := _PendingAirdropMirror(t, "pending")
+	defer server.Close()
+
+	airdrops, err := MirrorRestCollect(_NewTestMirrorRestClient(server).AccountPendingAirdrops(context.Background(), AccountID{Account: 1800}, nil))
+	require.NoError(t, err)
+	require.Len(t, airdrops, 12)
+
+	assert.Equal(t, AccountID{Account: 1001}, airdrops[0].SenderID)
+	assert.Equal(t, AccountID{Account: 1800}, airdrops[0].ReceiverID)
+	assert.Equal(t, TokenID{Token: 500}, airdrops[0].TokenID)
+	assert.Equal(t, uint64(1), airdrops[0].Amount)
+	assert.Nil(t, airdrops[0].SerialNumber)
+	assert.True(t, time.Unix(1_700_000_000, 1).Equal(airdrops[0].Timestamp))
+
+	id := airdrops[0].GetPendingAirdropId()
+	assert.Equal(t, TokenID{Token: 500}, *id.GetTokenID())
+	assert.Nil(t, id.GetNftID())
+
+	id = airdrops[11].GetPendingAirdropId()
+	require.NotNil(t, id.GetNftID())
+	assert.Equal(t, NftID{TokenID: TokenID{Token: 700}, SerialNumber: 7}, *id.GetNftID())
+	assert.Nil(t, id.GetTokenID())
+}
+
+func TestUnitPendingAirdropInboxClaimAll(t *testing.T) {
+	t.Parallel()
+
+	mirror := _PendingAirdropMirror(t, "pending")
+	defer mirror.Close()
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+	}})
+	defer server.Close()
+	client.SetMirrorRestApiBaseUrl(mirror.URL + "/api/v1")
+
+	inbox := NewPendingAirdropInbox(client, AccountID{Account: 1800})
+	assert.Equal(t, AccountID{Account: 1800}, inbox.GetAccountID())
+	assert.Equal(t, 10, inbox.GetBatchSize())
+
+	results, err := inbox.ClaimAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Len(t, results[0].PendingAirdropIds, 10)
+	assert.Len(t, results[1].PendingAirdropIds, 2)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+		require.NotNil(t, result.TransactionID)
+		require.NotNil(t, result.Receipt)
+		assert.Equal(t, StatusSuccess, result.Receipt.Status)
+	}
+	assert.NotEqual(t, results[0].TransactionID.String(), results[1].TransactionID.String())
+}
+
+func TestUnitPendingAirdropInboxCancel(t *testing.T) {
+	t.Parallel()
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_INVALID_PENDING_AIRDROP_ID),
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+	}})
+	defer server.Close()
+
+	ids := make([]PendingAirdropId, 3)
+	for i := range ids {
+		ids[i].SetSender(AccountID{Account: 1800}).SetReceiver(AccountID{Account: uint64(2000 + i)}).SetTokenID(TokenID{Token: 500})
+	}
+
+	inbox := NewPendingAirdropInbox(client, AccountID{Account: 1800}).SetBatchSize(2)
+	results, err := inbox.Cancel(context.Background(), ids)
+	require.ErrorContains(t, err, StatusInvalidPendingAirdropId.String())
+	require.Len(t, results, 2)
+	assert.ErrorContains(t, results[0].Err, StatusInvalidPendingAirdropId.String())
+	assert.NoError(t, results[1].Err)
+	assert.Len(t, results[1].PendingAirdropIds, 1)
+
+	// airdrops sent by another account cannot be cancelled
+	_, err = inbox.Cancel(context.Background(), []PendingAirdropId{ids[0], *ids[1].SetSender(AccountID{Account: 1001})})
+	require.ErrorContains(t, err, "is not sent by 0.0.1800")
+
+	// and airdrops sent by the account cannot be claimed by it
+	_, err = inbox.Claim(context.Background(), ids[:1])
+	require.ErrorContains(t, err, "is not received by 0.0.1800")
+
+	_, err = inbox.SetBatchSize(11).Claim(context.Background(), nil)
+	require.ErrorContains(t, err, "batch size")
+
+	// nothing is submitted once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err = inbox.SetBatchSize(10).Cancel(ctx, ids[:1])
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, results, 1)
+	assert.Nil(t, results[0].TransactionID)
+}
+
+func TestUnitPendingAirdropInboxReject(t *testing.T) {
+	t.Parallel()
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+		_OutboxPrecheck(services.ResponseCodeEnum_OK),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+		_OutboxReceipt(services.ResponseCodeEnum_SUCCESS),
+	}})
+	defer server.Close()
+
+	var id PendingAirdropId
+	id.SetSender(AccountID{Account: 1001}).SetReceiver(AccountID{Account: 1800}).SetTokenID(TokenID{Token: 500})
+
+	inbox := NewPendingAirdropInbox(client, AccountID{Account: 1800})
+	results, err := inbox.Reject(context.Background(), []PendingAirdropId{id})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.NotNil(t, results[0].TransactionID)
+	require.NotNil(t, results[0].RejectTransactionID)
+	assert.NotEqual(t, results[0].TransactionID.String(), results[0].RejectTransactionID.String())
+	require.NotNil(t, results[0].RejectReceipt)
+	assert.Equal(t, StatusSuccess, results[0].RejectReceipt.Status)
+
+	// the tokens are not rejected once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err = inbox.Reject(ctx, []PendingAirdropId{id})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, results, 1)
+	assert.Nil(t, results[0].RejectTransactionID)
+}
@@ -0,0 +1,217 @@
+//go:build cgo
+
+package pkcs11
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"sync"
+
+	p11 "github.com/miekg/pkcs11"
+	"github.com/pkg/errors"
+)
+
+type _Pkcs11Module struct {
+	ctx        *p11.Ctx
+	modulePath string
+	session    p11.SessionHandle
+	privateKey p11.ObjectHandle
+	publicKey  p11.ObjectHandle
+}
+
+type _LoadedModule struct {
+	ctx        *p11.Ctx
+	references int
+}
+
+// A module can only be initialized once per process, so signers using the same module share its context.
+var loadedModulesMutex sync.Mutex
+var loadedModules = map[string]*_LoadedModule{}
+
+func _LoadModule(modulePath string) (*p11.Ctx, error) {
+	loadedModulesMutex.Lock()
+	defer loadedModulesMutex.Unlock()
+
+	if loaded, ok := loadedModules[modulePath]; ok {
+		loaded.references++
+		return loaded.ctx, nil
+	}
+
+	ctx := p11.New(modulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("pkcs11: failed to load module %s", modulePath)
+	}
+
+	if err := ctx.Initialize(); err != nil {
+		ctx.Destroy()
+		return nil, errors.Wrap(err, "pkcs11: failed to initialize module")
+	}
+
+	loadedModules[modulePath] = &_LoadedModule{ctx: ctx, references: 1}
+
+	return ctx, nil
+}
+
+func _UnloadModule(modulePath string) error {
+	loadedModulesMutex.Lock()
+	defer loadedModulesMutex.Unlock()
+
+	loaded, ok := loadedModules[modulePath]
+	if !ok {
+		return nil
+	}
+
+	loaded.references--
+	if loaded.references > 0 {
+		return nil
+	}
+
+	delete(loadedModules, modulePath)
+	err := loaded.ctx.Finalize()
+	loaded.ctx.Destroy()
+
+	return err
+}
+
+func _OpenModule(config Config) (_Module, error) {
+	ctx, err := _LoadModule(config.ModulePath)
+	if err != nil {
+		return nil, err
+	}
+
+	module := &_Pkcs11Module{ctx: ctx, modulePath: config.ModulePath}
+	if err := module._Open(config); err != nil {
+		_ = module.close()
+		return nil, err
+	}
+
+	return module, nil
+}
+
+func (module *_Pkcs11Module) _Open(config Config) error {
+	slot, err := module._FindSlot(config)
+	if err != nil {
+		return err
+	}
+
+	session, err := module.ctx.OpenSession(slot, p11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return errors.Wrap(err, "pkcs11: failed to open session")
+	}
+	module.session = session
+
+	// the login state is shared by all sessions on the token, so another signer may have logged in already
+	if err := module.ctx.Login(session, p11.CKU_USER, config.PIN); err != nil && err != p11.Error(p11.CKR_USER_ALREADY_LOGGED_IN) {
+		return errors.Wrap(err, "pkcs11: failed to log in")
+	}
+
+	module.privateKey, err = module._FindObject(p11.CKO_PRIVATE_KEY, config)
+	if err != nil {
+		return err
+	}
+
+	module.publicKey, err = module._FindObject(p11.CKO_PUBLIC_KEY, config)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (module *_Pkcs11Module) _FindSlot(config Config) (uint, error) {
+	if config.SlotID != nil {
+		return *config.SlotID, nil
+	}
+
+	slots, err := module.ctx.GetSlotList(true)
+	if err != nil {
+		return 0, errors.Wrap(err, "pkcs11: failed to list slots")
+	}
+
+	for _, slot := range slots {
+		info, err := module.ctx.GetTokenInfo(slot)
+		if err != nil {
+			continue
+		}
+
+		if info.Label == config.TokenLabel {
+			return slot, nil
+		}
+	}
+
+	return 0, fmt.Errorf("pkcs11: no token labelled %q", config.TokenLabel)
+}
+
+func (module *_Pkcs11Module) _FindObject(class uint, config Config) (p11.ObjectHandle, error) {
+	template := []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, class),
+	}
+	if config.KeyLabel != "" {
+		template = append(template, p11.NewAttribute(p11.CKA_LABEL, config.KeyLabel))
+	}
+	if len(config.KeyID) > 0 {
+		template = append(template, p11.NewAttribute(p11.CKA_ID, config.KeyID))
+	}
+
+	if err := module.ctx.FindObjectsInit(module.session, template); err != nil {
+		return 0, errors.Wrap(err, "pkcs11: failed to search for key")
+	}
+
+	objects, _, err := module.ctx.FindObjects(module.session, 2)
+	finalErr := module.ctx.FindObjectsFinal(module.session)
+	if err != nil {
+		return 0, errors.Wrap(err, "pkcs11: failed to search for key")
+	}
+	if finalErr != nil {
+		return 0, errors.Wrap(finalErr, "pkcs11: failed to search for key")
+	}
+
+	kind := "private"
+	if class == p11.CKO_PUBLIC_KEY {
+		kind = "public"
+	}
+
+	switch len(objects) {
+	case 0:
+		return 0, fmt.Errorf("pkcs11: %s key not found", kind)
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, fmt.Errorf("pkcs11: more than one %s key matches the key label and ID", kind)
+	}
+}
+
+func (module *_Pkcs11Module) publicKeyPoint() ([]byte, error) {
+	attributes, err := module.ctx.GetAttributeValue(module.session, module.publicKey, []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "pkcs11: failed to read public key")
+	}
+
+	return attributes[0].Value, nil
+}
+
+func (module *_Pkcs11Module) sign(mechanism uint, data []byte) ([]byte, error) {
+	if err := module.ctx.SignInit(module.session, []*p11.Mechanism{p11.NewMechanism(mechanism, nil)}, module.privateKey); err != nil {
+		return nil, err
+	}
+
+	return module.ctx.Sign(module.session, data)
+}
+
+func (module *_Pkcs11Module) close() error {
+	var err error
+	// closing the last session on the token logs the user out
+	if module.session != 0 {
+		err = module.ctx.CloseSession(module.session)
+		module.session = 0
+	}
+
+	if unloadErr := _UnloadModule(module.modulePath); err == nil {
+		err = unloadErr
+	}
+
+	return err
+}
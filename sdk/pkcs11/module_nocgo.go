@@ -0,0 +1,11 @@
+//go:build !cgo
+
+package pkcs11
+
+// SPDX-License-Identifier: Apache-2.0
+
+import "github.com/pkg/errors"
+
+func _OpenModule(_ Config) (_Module, error) {
+	return nil, errors.New("pkcs11: loading a PKCS#11 module requires cgo")
+}
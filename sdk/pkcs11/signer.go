@@ -0,0 +1,293 @@
+// Package pkcs11 provides hiero.Signer implementations backed by keys stored in a PKCS#11 module, e.g. a
+// hardware security module or SoftHSM2.
+//
+// The key pair is looked up by CKA_LABEL and/or CKA_ID in the token selected by slot ID or token label.
+// ED25519 keys are signed with CKM_EDDSA, ECDSA secp256k1 keys with CKM_ECDSA over the Keccak-256 hash of the
+// message. ECDSA signatures are converted from DER to the raw 64 byte r||s format and normalised to low-S, which is
+// the format hiero.PublicKey verifies and puts into the signature map.
+//
+// The package requires cgo to load the module.
+package pkcs11
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"github.com/pkg/errors"
+)
+
+// PKCS#11 mechanism types used for signing, see the PKCS#11 v3.0 specification.
+const (
+	_CkmEcdsa uint = 0x00001041
+	_CkmEdDSA uint = 0x00001057
+)
+
+var errModulePathNotSet = errors.New("pkcs11: module path is not set")
+var errKeyNotSelected = errors.New("pkcs11: key label or key ID must be set")
+var errTokenNotSelected = errors.New("pkcs11: slot ID or token label must be set")
+
+// Config selects the PKCS#11 module, token and key pair a Signer uses.
+type Config struct {
+	// ModulePath is the path of the PKCS#11 shared library, e.g. /usr/lib/softhsm/libsofthsm2.so
+	ModulePath string
+	// SlotID selects the slot directly. When nil, the slot holding the token labelled TokenLabel is used.
+	SlotID *uint
+	// TokenLabel is the label of the token holding the key pair.
+	TokenLabel string
+	// KeyLabel is the CKA_LABEL of the private and public key objects.
+	KeyLabel string
+	// KeyID is the CKA_ID of the private and public key objects.
+	KeyID []byte
+	// PIN is the user PIN used to log in to the token.
+	PIN string
+}
+
+func (config Config) _Validate() error {
+	if config.ModulePath == "" {
+		return errModulePathNotSet
+	}
+
+	if config.SlotID == nil && config.TokenLabel == "" {
+		return errTokenNotSelected
+	}
+
+	if config.KeyLabel == "" && len(config.KeyID) == 0 {
+		return errKeyNotSelected
+	}
+
+	return nil
+}
+
+// _Module is an open, logged in session on a PKCS#11 token.
+type _Module interface {
+	// publicKeyPoint returns the CKA_EC_POINT attribute of the public key object selected by the config.
+	publicKeyPoint() ([]byte, error)
+	// sign signs data with the private key object selected by the config.
+	sign(mechanism uint, data []byte) ([]byte, error)
+	close() error
+}
+
+// Signer is a hiero.Signer and hiero.BatchSigner backed by a key pair stored in a PKCS#11 token.
+// It is safe for concurrent use; requests to the token are serialised over a single session.
+type Signer struct {
+	mutex     sync.Mutex
+	module    _Module
+	publicKey hiero.PublicKey
+	keyType   hiero.SignerKeyType
+}
+
+// NewEd25519Signer opens the PKCS#11 module and returns a Signer for the ED25519 key pair selected by config.
+// The Signer must be closed to log out of the token and unload the module.
+func NewEd25519Signer(config Config) (*Signer, error) {
+	return _NewSigner(config, hiero.SignerKeyTypeEd25519, _OpenModule)
+}
+
+// NewEcdsaSecp256k1Signer opens the PKCS#11 module and returns a Signer for the ECDSA secp256k1 key pair selected
+// by config. The Signer must be closed to log out of the token and unload the module.
+func NewEcdsaSecp256k1Signer(config Config) (*Signer, error) {
+	return _NewSigner(config, hiero.SignerKeyTypeEcdsaSecp256k1, _OpenModule)
+}
+
+func _NewSigner(config Config, keyType hiero.SignerKeyType, open func(Config) (_Module, error)) (*Signer, error) {
+	if err := config._Validate(); err != nil {
+		return nil, err
+	}
+
+	module, err := open(config)
+	if err != nil {
+		return nil, err
+	}
+
+	point, err := module.publicKeyPoint()
+	if err != nil {
+		_ = module.close()
+		return nil, err
+	}
+
+	var publicKey hiero.PublicKey
+	switch keyType {
+	case hiero.SignerKeyTypeEd25519:
+		publicKey, err = _Ed25519PublicKeyFromPoint(point)
+	case hiero.SignerKeyTypeEcdsaSecp256k1:
+		publicKey, err = _EcdsaPublicKeyFromPoint(point)
+	default:
+		err = fmt.Errorf("pkcs11: unsupported key type %s", keyType)
+	}
+	if err != nil {
+		_ = module.close()
+		return nil, err
+	}
+
+	return &Signer{
+		module:    module,
+		publicKey: publicKey,
+		keyType:   keyType,
+	}, nil
+}
+
+// PublicKey returns the public key of the key pair in the token.
+func (signer *Signer) PublicKey() hiero.PublicKey {
+	return signer.publicKey
+}
+
+// KeyType returns the signature algorithm of the key pair in the token.
+func (signer *Signer) KeyType() hiero.SignerKeyType {
+	return signer.keyType
+}
+
+// Sign signs message with the private key in the token.
+func (signer *Signer) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	signatures, err := signer.SignBatch(ctx, [][]byte{message})
+	if err != nil {
+		return nil, err
+	}
+
+	return signatures[0], nil
+}
+
+// SignBatch signs every message while holding the session, stopping at the first error or when ctx is done.
+func (signer *Signer) SignBatch(ctx context.Context, messages [][]byte) ([][]byte, error) {
+	signer.mutex.Lock()
+	defer signer.mutex.Unlock()
+
+	if signer.module == nil {
+		return nil, errors.New("pkcs11: signer is closed")
+	}
+
+	signatures := make([][]byte, 0, len(messages))
+	for _, message := range messages {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		signature, err := signer._Sign(message)
+		if err != nil {
+			return nil, err
+		}
+
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, nil
+}
+
+func (signer *Signer) _Sign(message []byte) ([]byte, error) {
+	if signer.keyType == hiero.SignerKeyTypeEd25519 {
+		signature, err := signer.module.sign(_CkmEdDSA, message)
+		if err != nil {
+			return nil, errors.Wrap(err, "pkcs11: EdDSA signing failed")
+		}
+
+		if len(signature) != 64 {
+			return nil, fmt.Errorf("pkcs11: invalid ED25519 signature length: %d bytes", len(signature))
+		}
+
+		return signature, nil
+	}
+
+	hash := hiero.Keccak256Hash(message)
+	signature, err := signer.module.sign(_CkmEcdsa, hash.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "pkcs11: ECDSA signing failed")
+	}
+
+	return _EcdsaSignatureToRaw(signature)
+}
+
+// Close logs out of the token and unloads the PKCS#11 module.
+func (signer *Signer) Close() error {
+	signer.mutex.Lock()
+	defer signer.mutex.Unlock()
+
+	if signer.module == nil {
+		return nil
+	}
+
+	err := signer.module.close()
+	signer.module = nil
+
+	return err
+}
+
+// _UnwrapPoint returns the contents of a CKA_EC_POINT attribute, which PKCS#11 modules encode as a DER OCTET STRING
+// but some return unwrapped.
+func _UnwrapPoint(point []byte, rawLength func(int) bool) []byte {
+	if rawLength(len(point)) {
+		return point
+	}
+
+	var inner []byte
+	rest, err := asn1.Unmarshal(point, &inner)
+	if err != nil || len(rest) != 0 {
+		return point
+	}
+
+	return inner
+}
+
+func _Ed25519PublicKeyFromPoint(point []byte) (hiero.PublicKey, error) {
+	raw := _UnwrapPoint(point, func(length int) bool { return length == 32 })
+	if len(raw) != 32 {
+		return hiero.PublicKey{}, fmt.Errorf("pkcs11: invalid ED25519 public key length: %d bytes", len(raw))
+	}
+
+	return hiero.PublicKeyFromBytesEd25519(raw)
+}
+
+func _EcdsaPublicKeyFromPoint(point []byte) (hiero.PublicKey, error) {
+	raw := _UnwrapPoint(point, func(length int) bool { return length == 33 || length == 65 })
+	key, err := secp256k1.ParsePubKey(raw)
+	if err != nil {
+		return hiero.PublicKey{}, errors.Wrap(err, "pkcs11: invalid ECDSA secp256k1 public key")
+	}
+
+	return hiero.PublicKeyFromBytesECDSA(key.SerializeCompressed())
+}
+
+type _EcdsaDerSignature struct {
+	R *big.Int
+	S *big.Int
+}
+
+// _EcdsaSignatureToRaw converts a CKM_ECDSA signature, either raw r||s or DER encoded, to the raw 64 byte r||s
+// format with a low S value.
+func _EcdsaSignatureToRaw(signature []byte) ([]byte, error) {
+	var r, s *big.Int
+	if len(signature) == 64 {
+		r = new(big.Int).SetBytes(signature[:32])
+		s = new(big.Int).SetBytes(signature[32:])
+	} else {
+		var der _EcdsaDerSignature
+		rest, err := asn1.Unmarshal(signature, &der)
+		if err != nil {
+			return nil, errors.Wrap(err, "pkcs11: invalid ECDSA signature")
+		}
+		if len(rest) != 0 {
+			return nil, errors.New("pkcs11: trailing data after DER encoded ECDSA signature")
+		}
+		r, s = der.R, der.S
+	}
+
+	order := secp256k1.Params().N
+	if r.Sign() <= 0 || s.Sign() <= 0 || r.Cmp(order) >= 0 || s.Cmp(order) >= 0 {
+		return nil, errors.New("pkcs11: ECDSA signature is out of range")
+	}
+
+	halfOrder := new(big.Int).Rsh(order, 1)
+	if s.Cmp(halfOrder) > 0 {
+		s = new(big.Int).Sub(order, s)
+	}
+
+	raw := make([]byte, 64)
+	r.FillBytes(raw[:32])
+	s.FillBytes(raw[32:])
+
+	return raw, nil
+}
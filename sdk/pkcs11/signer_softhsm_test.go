@@ -0,0 +1,151 @@
+//go:build (all || unit) && cgo
+
+package pkcs11
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/asn1"
+	"os"
+	"testing"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	p11 "github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The SoftHSM2 tests run against an initialized token, e.g.
+//
+//	softhsm2-util --init-token --free --label hiero --so-pin 0000 --pin 1234
+//	HIERO_PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so HIERO_PKCS11_TOKEN_LABEL=hiero HIERO_PKCS11_PIN=1234 \
+//	    go test -tags unit ./sdk/pkcs11/
+func _SoftHsmConfig(t *testing.T, keyLabel string) Config {
+	modulePath := os.Getenv("HIERO_PKCS11_MODULE")
+	if modulePath == "" {
+		t.Skip("HIERO_PKCS11_MODULE is not set")
+	}
+
+	return Config{
+		ModulePath: modulePath,
+		TokenLabel: os.Getenv("HIERO_PKCS11_TOKEN_LABEL"),
+		KeyLabel:   keyLabel,
+		PIN:        os.Getenv("HIERO_PKCS11_PIN"),
+	}
+}
+
+// _WithSoftHsmSession runs fn in a logged in session which is closed before the signer under test opens the module.
+func _WithSoftHsmSession(t *testing.T, config Config, fn func(ctx *p11.Ctx, session p11.SessionHandle)) {
+	ctx, err := _LoadModule(config.ModulePath)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, _UnloadModule(config.ModulePath))
+	}()
+
+	loader := &_Pkcs11Module{ctx: ctx}
+	slot, err := loader._FindSlot(config)
+	require.NoError(t, err)
+
+	session, err := ctx.OpenSession(slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+	require.NoError(t, err)
+	defer ctx.CloseSession(session)
+
+	err = ctx.Login(session, p11.CKU_USER, config.PIN)
+	if err != nil && err != p11.Error(p11.CKR_USER_ALREADY_LOGGED_IN) {
+		require.NoError(t, err)
+	}
+
+	fn(ctx, session)
+}
+
+func _GenerateSoftHsmKeyPair(t *testing.T, config Config, mechanism uint, curve asn1.ObjectIdentifier) {
+	params, err := asn1.Marshal(curve)
+	require.NoError(t, err)
+
+	_WithSoftHsmSession(t, config, func(ctx *p11.Ctx, session p11.SessionHandle) {
+		publicKey, privateKey, err := ctx.GenerateKeyPair(session,
+			[]*p11.Mechanism{p11.NewMechanism(mechanism, nil)},
+			[]*p11.Attribute{
+				p11.NewAttribute(p11.CKA_TOKEN, true),
+				p11.NewAttribute(p11.CKA_VERIFY, true),
+				p11.NewAttribute(p11.CKA_EC_PARAMS, params),
+				p11.NewAttribute(p11.CKA_LABEL, config.KeyLabel),
+			},
+			[]*p11.Attribute{
+				p11.NewAttribute(p11.CKA_TOKEN, true),
+				p11.NewAttribute(p11.CKA_SIGN, true),
+				p11.NewAttribute(p11.CKA_PRIVATE, true),
+				p11.NewAttribute(p11.CKA_SENSITIVE, true),
+				p11.NewAttribute(p11.CKA_LABEL, config.KeyLabel),
+			},
+		)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			_WithSoftHsmSession(t, config, func(ctx *p11.Ctx, session p11.SessionHandle) {
+				_ = ctx.DestroyObject(session, privateKey)
+				_ = ctx.DestroyObject(session, publicKey)
+			})
+		})
+	})
+}
+
+func _SignTransferWithSigner(t *testing.T, signer hiero.Signer) {
+	tx, err := hiero.NewTransferTransaction().
+		SetNodeAccountIDs([]hiero.AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(hiero.TransactionIDGenerate(hiero.AccountID{Account: 2})).
+		AddHbarTransfer(hiero.AccountID{Account: 2}, hiero.NewHbar(-1)).
+		AddHbarTransfer(hiero.AccountID{Account: 3}, hiero.NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	tx.SignWithSigner(signer)
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+
+	signatures, err := tx.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures, 2)
+
+	list, err := tx.GetSignableNodeBodyBytesList()
+	require.NoError(t, err)
+	for _, signable := range list {
+		for _, signature := range signatures[signable.NodeID] {
+			assert.True(t, signer.PublicKey().VerifySignedMessage(signable.Body, signature))
+		}
+	}
+}
+
+func TestUnitPkcs11SoftHsmEd25519(t *testing.T) {
+	config := _SoftHsmConfig(t, "hiero-ed25519-test")
+	// CKM_EC_EDWARDS_KEY_PAIR_GEN with the id-Ed25519 curve
+	_GenerateSoftHsmKeyPair(t, config, 0x00001055, asn1.ObjectIdentifier{1, 3, 101, 112})
+
+	signer, err := NewEd25519Signer(config)
+	require.NoError(t, err)
+	defer signer.Close()
+
+	message := []byte("hello")
+	signature, err := signer.Sign(context.Background(), message)
+	require.NoError(t, err)
+	assert.True(t, signer.PublicKey().VerifySignedMessage(message, signature))
+
+	_SignTransferWithSigner(t, signer)
+}
+
+func TestUnitPkcs11SoftHsmEcdsaSecp256k1(t *testing.T) {
+	config := _SoftHsmConfig(t, "hiero-ecdsa-test")
+	_GenerateSoftHsmKeyPair(t, config, p11.CKM_EC_KEY_PAIR_GEN, asn1.ObjectIdentifier{1, 3, 132, 0, 10})
+
+	signer, err := NewEcdsaSecp256k1Signer(config)
+	require.NoError(t, err)
+	defer signer.Close()
+
+	message := []byte("hello")
+	signature, err := signer.Sign(context.Background(), message)
+	require.NoError(t, err)
+	assert.True(t, signer.PublicKey().VerifySignedMessage(message, signature))
+
+	_SignTransferWithSigner(t, signer)
+}
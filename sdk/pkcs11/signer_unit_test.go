@@ -0,0 +1,229 @@
+//go:build all || unit
+
+package pkcs11
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = Config{
+	ModulePath: "/usr/lib/softhsm/libsofthsm2.so",
+	TokenLabel: "hiero",
+	KeyLabel:   "operator",
+	PIN:        "1234",
+}
+
+// _FakeModule emulates a token holding a single software key pair.
+type _FakeModule struct {
+	point      []byte
+	signFunc   func(mechanism uint, data []byte) ([]byte, error)
+	mechanisms []uint
+	closed     bool
+}
+
+func (module *_FakeModule) publicKeyPoint() ([]byte, error) {
+	return module.point, nil
+}
+
+func (module *_FakeModule) sign(mechanism uint, data []byte) ([]byte, error) {
+	module.mechanisms = append(module.mechanisms, mechanism)
+	return module.signFunc(mechanism, data)
+}
+
+func (module *_FakeModule) close() error {
+	module.closed = true
+	return nil
+}
+
+func _OpenFake(module *_FakeModule) func(Config) (_Module, error) {
+	return func(Config) (_Module, error) {
+		return module, nil
+	}
+}
+
+func _DerOctetString(t *testing.T, data []byte) []byte {
+	encoded, err := asn1.Marshal(data)
+	require.NoError(t, err)
+	return encoded
+}
+
+func _NewFakeEcdsaModule(t *testing.T, highS bool) (*_FakeModule, *secp256k1.PrivateKey) {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	return &_FakeModule{
+		point: _DerOctetString(t, key.PubKey().SerializeUncompressed()),
+		signFunc: func(_ uint, digest []byte) ([]byte, error) {
+			signature := ecdsa.Sign(key, digest)
+			r := signature.R()
+			s := signature.S()
+			rBytes := r.Bytes()
+			sBytes := s.Bytes()
+			der := _EcdsaDerSignature{
+				R: new(big.Int).SetBytes(rBytes[:]),
+				S: new(big.Int).SetBytes(sBytes[:]),
+			}
+			if highS {
+				der.S = new(big.Int).Sub(secp256k1.Params().N, der.S)
+			}
+			return asn1.Marshal(der)
+		},
+	}, key
+}
+
+func TestUnitPkcs11ConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewEd25519Signer(Config{})
+	require.ErrorIs(t, err, errModulePathNotSet)
+
+	_, err = NewEd25519Signer(Config{ModulePath: "libsofthsm2.so", KeyLabel: "operator"})
+	require.ErrorIs(t, err, errTokenNotSelected)
+
+	_, err = NewEcdsaSecp256k1Signer(Config{ModulePath: "libsofthsm2.so", TokenLabel: "hiero"})
+	require.ErrorIs(t, err, errKeyNotSelected)
+}
+
+func TestUnitPkcs11Ed25519Signer(t *testing.T) {
+	t.Parallel()
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	module := &_FakeModule{
+		point: _DerOctetString(t, key.PublicKey().BytesRaw()),
+		signFunc: func(_ uint, data []byte) ([]byte, error) {
+			return key.Sign(data), nil
+		},
+	}
+
+	signer, err := _NewSigner(testConfig, hiero.SignerKeyTypeEd25519, _OpenFake(module))
+	require.NoError(t, err)
+
+	assert.Equal(t, key.PublicKey().String(), signer.PublicKey().String())
+	assert.Equal(t, hiero.SignerKeyTypeEd25519, signer.KeyType())
+
+	message := []byte("hello")
+	signature, err := signer.Sign(context.Background(), message)
+	require.NoError(t, err)
+	assert.Equal(t, key.Sign(message), signature)
+	assert.Equal(t, []uint{_CkmEdDSA}, module.mechanisms)
+
+	require.NoError(t, signer.Close())
+	assert.True(t, module.closed)
+
+	_, err = signer.Sign(context.Background(), message)
+	require.Error(t, err)
+}
+
+func TestUnitPkcs11EcdsaSignerNormalisesDerHighS(t *testing.T) {
+	t.Parallel()
+
+	module, key := _NewFakeEcdsaModule(t, true)
+	signer, err := _NewSigner(testConfig, hiero.SignerKeyTypeEcdsaSecp256k1, _OpenFake(module))
+	require.NoError(t, err)
+	defer signer.Close()
+
+	publicKey, err := hiero.PublicKeyFromBytesECDSA(key.PubKey().SerializeCompressed())
+	require.NoError(t, err)
+	assert.Equal(t, publicKey.String(), signer.PublicKey().String())
+	assert.Equal(t, hiero.SignerKeyTypeEcdsaSecp256k1, signer.KeyType())
+
+	message := []byte("hello")
+	signature, err := signer.Sign(context.Background(), message)
+	require.NoError(t, err)
+	require.Len(t, signature, 64)
+
+	halfOrder := new(big.Int).Rsh(secp256k1.Params().N, 1)
+	assert.True(t, new(big.Int).SetBytes(signature[32:]).Cmp(halfOrder) <= 0)
+	assert.True(t, signer.PublicKey().VerifySignedMessage(message, signature))
+	assert.Equal(t, []uint{_CkmEcdsa}, module.mechanisms)
+}
+
+func TestUnitPkcs11EcdsaSignatureToRaw(t *testing.T) {
+	t.Parallel()
+
+	raw := make([]byte, 64)
+	raw[31] = 1
+	raw[63] = 2
+
+	converted, err := _EcdsaSignatureToRaw(raw)
+	require.NoError(t, err)
+	assert.Equal(t, raw, converted)
+
+	der, err := asn1.Marshal(_EcdsaDerSignature{R: big.NewInt(1), S: big.NewInt(2)})
+	require.NoError(t, err)
+	converted, err = _EcdsaSignatureToRaw(der)
+	require.NoError(t, err)
+	assert.Equal(t, raw, converted)
+
+	_, err = _EcdsaSignatureToRaw(make([]byte, 64))
+	require.Error(t, err)
+
+	_, err = _EcdsaSignatureToRaw([]byte{0x30, 0x01})
+	require.Error(t, err)
+}
+
+func TestUnitPkcs11EcdsaPublicKeyFromPoint(t *testing.T) {
+	t.Parallel()
+
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	expected := key.PubKey().SerializeCompressed()
+
+	for _, point := range [][]byte{
+		key.PubKey().SerializeUncompressed(),
+		key.PubKey().SerializeCompressed(),
+		_DerOctetString(t, key.PubKey().SerializeUncompressed()),
+		_DerOctetString(t, key.PubKey().SerializeCompressed()),
+	} {
+		publicKey, err := _EcdsaPublicKeyFromPoint(point)
+		require.NoError(t, err)
+		assert.Equal(t, expected, publicKey.BytesRaw())
+	}
+
+	_, err = _EcdsaPublicKeyFromPoint([]byte{0x04, 0x01})
+	require.Error(t, err)
+}
+
+func TestUnitPkcs11SignBatchStopsOnError(t *testing.T) {
+	t.Parallel()
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	errDevice := errors.New("device removed")
+	calls := 0
+	module := &_FakeModule{
+		point: key.PublicKey().BytesRaw(),
+		signFunc: func(_ uint, data []byte) ([]byte, error) {
+			calls++
+			if calls == 2 {
+				return nil, errDevice
+			}
+			return key.Sign(data), nil
+		},
+	}
+
+	signer, err := _NewSigner(testConfig, hiero.SignerKeyTypeEd25519, _OpenFake(module))
+	require.NoError(t, err)
+
+	_, err = signer.SignBatch(context.Background(), [][]byte{{1}, {2}, {3}})
+	require.ErrorIs(t, err, errDevice)
+	assert.Equal(t, 2, calls)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = signer.SignBatch(ctx, [][]byte{{1}})
+	require.ErrorIs(t, err, context.Canceled)
+}
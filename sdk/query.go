@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -84,6 +85,10 @@ func (q *Query) GetQueryPayment() Hbar {
 
 // GetCost returns the fee that would be charged to get the requested information (if a cost was requested).
 func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
+	return q.getCostWithContext(context.Background(), client, e)
+}
+
+func (q *Query) getCostWithContext(ctx context.Context, client *Client, e QueryInterface) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -96,14 +101,14 @@ func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
 	}
 	q.paymentTransactions = make([]*services.Transaction, 0)
 	if !q.nodeAccountIDs.locked {
-		q.SetNodeAccountIDs([]AccountID{client.network._GetNode().accountID})
+		q.SetNodeAccountIDs([]AccountID{client.network._SelectNode(_RequestTypeFromQuery(e.buildQuery())).accountID})
 	}
 
 	q.pb = e.buildQuery()
 
 	q.pbHeader.ResponseType = services.ResponseType_COST_ANSWER
 	q.paymentTransactionIDs._Advance()
-	resp, err := _Execute(client, e)
+	resp, err := _Execute(ctx, client, e)
 
 	if err != nil {
 		return Hbar{}, err
@@ -115,7 +120,7 @@ func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _QueryMakePaymentTransaction(transactionID TransactionID, nodeAccountID AccountID, operator *_Operator, cost Hbar) (*services.Transaction, error) {
+func _QueryMakePaymentTransaction(ctx context.Context, transactionID TransactionID, nodeAccountID AccountID, operator *_Operator, cost Hbar) (*services.Transaction, error) {
 	accountAmounts := make([]*services.AccountAmount, 0)
 	accountAmounts = append(accountAmounts, &services.AccountAmount{
 		AccountID: nodeAccountID._ToProtobuf(),
@@ -147,9 +152,13 @@ func _QueryMakePaymentTransaction(transactionID TransactionID, nodeAccountID Acc
 		return nil, errors.Wrap(err, "error serializing Query body")
 	}
 
-	signature := operator.signer(bodyBytes)
+	signatures, err := _SignerSignAll(ctx, operator.signer, [][]byte{bodyBytes})
+	if err != nil {
+		return nil, err
+	}
+
 	sigPairs := make([]*services.SignaturePair, 0)
-	sigPairs = append(sigPairs, operator.publicKey._ToSignaturePairProtobuf(signature))
+	sigPairs = append(sigPairs, operator.publicKey._ToSignaturePairProtobuf(signatures[0]))
 
 	return &services.Transaction{
 		BodyBytes: bodyBytes,
@@ -180,6 +189,10 @@ func (q *Query) SetPaymentTransactionID(transactionID TransactionID) *Query {
 }
 
 func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, error) {
+	return q.executeWithContext(context.Background(), client, e)
+}
+
+func (q *Query) executeWithContext(ctx context.Context, client *Client, e QueryInterface) (*services.Response, error) {
 	q.client = client
 	if client == nil {
 		return nil, errNoClientProvided
@@ -200,7 +213,7 @@ func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, e
 			cost = q.maxQueryPayment
 		}
 
-		actualCost, err := q.getCost(client, e)
+		actualCost, err := q.getCostWithContext(ctx, client, e)
 		if err != nil {
 			return nil, err
 		}
@@ -218,13 +231,13 @@ func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, e
 
 	q.paymentTransactions = make([]*services.Transaction, 0)
 	if !q.nodeAccountIDs.locked {
-		q.SetNodeAccountIDs([]AccountID{client.network._GetNode().accountID})
+		q.SetNodeAccountIDs([]AccountID{client.network._SelectNode(_RequestTypeFromQuery(e.buildQuery())).accountID})
 	}
 
 	q.pb = e.buildQuery()
 	q.pbHeader.ResponseType = services.ResponseType_ANSWER_ONLY
 
-	resp, err := _Execute(client, e)
+	resp, err := _Execute(ctx, client, e)
 	if err != nil {
 		return nil, err
 	}
@@ -258,12 +271,13 @@ func (q *Query) shouldRetry(e Executable, response any) _ExecutionState {
 	return executionStateError
 }
 
-func (q *Query) generatePayments(client *Client, cost Hbar) (*services.Transaction, error) {
+func (q *Query) generatePayments(ctx context.Context, client *Client, cost Hbar) (*services.Transaction, error) {
 	var tx *services.Transaction
 	var err error
 	nodeID := q.nodeAccountIDs._GetCurrent()
 	txnID := TransactionIDGenerate(client.operator.accountID)
 	tx, err = _QueryMakePaymentTransaction(
+		ctx,
 		txnID,
 		nodeID.(AccountID),
 		client.operator,
@@ -281,16 +295,20 @@ func (q *Query) advanceRequest() {
 	q.nodeAccountIDs._Advance()
 }
 
-func (q *Query) makeRequest() any {
+func (q *Query) makeRequest(ctx context.Context) (any, error) {
 	if q.client != nil && q.isPaymentRequired {
-		tx, err := q.generatePayments(q.client, q.queryPayment)
+		tx, err := q.generatePayments(ctx, q.client, q.queryPayment)
 		if err != nil {
-			return q.pb
+			return q.pb, err
 		}
 		q.pbHeader.Payment = tx
 	}
 
-	return q.pb
+	return q.pb, nil
+}
+
+func (q *Query) getRequestType() RequestType {
+	return _RequestTypeFromQuery(q.pb)
 }
 
 func (q *Query) mapResponse(response any, _ AccountID, _ any) (any, error) { // nolint
@@ -0,0 +1,158 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a client-side token-bucket limiter keyed by RequestType. When set on a client with
+// Client.SetRateLimiter, every attempt to send a transaction or query waits for a token of its request type, so that
+// high-volume jobs back off locally instead of being answered with BUSY or THROTTLED_AT_CONSENSUS by the network.
+// Request types without a limit are never delayed.
+type RateLimiter struct {
+	mutex   sync.Mutex
+	buckets map[RequestType][]*_TokenBucket
+	now     func() time.Time
+}
+
+// _TokenBucket holds up to capacity tokens and refills at rate tokens per second. It may be shared by several
+// request types, as the throttle groups of the network are.
+type _TokenBucket struct {
+	rate     float64
+	capacity float64
+	tokens   float64
+	updated  time.Time
+}
+
+// NewRateLimiter creates a RateLimiter without any limits.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		buckets: make(map[RequestType][]*_TokenBucket),
+		now:     time.Now,
+	}
+}
+
+// NewRateLimiterFromThrottleDefinitions creates a RateLimiter from the network throttles. Each throttle group
+// becomes a token bucket, filled at share times the rate of the group, with room for the bursts of its throttle
+// bucket. The share is the part of the network capacity this client may use, e.g. 0.1 for a tenth; a share outside
+// (0, 1] uses the full capacity.
+func NewRateLimiterFromThrottleDefinitions(definitions ThrottleDefinitions, share float64) *RateLimiter {
+	if share <= 0 || share > 1 {
+		share = 1
+	}
+
+	limiter := NewRateLimiter()
+	for _, bucket := range definitions.ThrottleBuckets {
+		for _, group := range bucket.ThrottleGroups {
+			if group.MilliOpsPerSec == 0 || len(group.Operations) == 0 {
+				continue
+			}
+
+			rate := float64(group.MilliOpsPerSec) / 1000 * share
+			tokenBucket := limiter._NewTokenBucket(rate, rate*bucket.BurstPeriod.Seconds())
+			for _, operation := range group.Operations {
+				limiter.buckets[operation] = append(limiter.buckets[operation], tokenBucket)
+			}
+		}
+	}
+
+	return limiter
+}
+
+// SetLimit limits requestType to opsPerSecond requests per second, allowing bursts of up to burst requests.
+// It replaces any limits the request type had before.
+func (limiter *RateLimiter) SetLimit(requestType RequestType, opsPerSecond float64, burst int) *RateLimiter {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	if opsPerSecond <= 0 {
+		delete(limiter.buckets, requestType)
+		return limiter
+	}
+
+	limiter.buckets[requestType] = []*_TokenBucket{limiter._NewTokenBucket(opsPerSecond, float64(burst))}
+	return limiter
+}
+
+// RemoveLimit removes the limits of requestType.
+func (limiter *RateLimiter) RemoveLimit(requestType RequestType) *RateLimiter {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	delete(limiter.buckets, requestType)
+	return limiter
+}
+
+// TryAcquire takes a token for requestType if one is available without waiting, and reports whether it did.
+func (limiter *RateLimiter) TryAcquire(requestType RequestType) bool {
+	return limiter._Acquire(requestType) == 0
+}
+
+// Wait blocks until a token for requestType is available and takes it, or returns the error of ctx once it is done.
+func (limiter *RateLimiter) Wait(ctx context.Context, requestType RequestType) error {
+	for {
+		delay := limiter._Acquire(requestType)
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (limiter *RateLimiter) _NewTokenBucket(rate float64, capacity float64) *_TokenBucket {
+	// a bucket which cannot hold a whole token would never let a request through
+	capacity = math.Max(capacity, 1)
+
+	return &_TokenBucket{
+		rate:     rate,
+		capacity: capacity,
+		tokens:   capacity,
+		updated:  limiter.now(),
+	}
+}
+
+// _Acquire takes a token from every bucket of requestType when all of them have one, and otherwise returns how
+// long to wait before the scarcest bucket is refilled.
+func (limiter *RateLimiter) _Acquire(requestType RequestType) time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	buckets := limiter.buckets[requestType]
+	now := limiter.now()
+
+	var delay time.Duration
+	for _, bucket := range buckets {
+		bucket._Refill(now)
+		if bucket.tokens < 1 {
+			delay = max(delay, time.Duration((1-bucket.tokens)/bucket.rate*float64(time.Second))+time.Millisecond)
+		}
+	}
+
+	if delay > 0 {
+		return delay
+	}
+
+	for _, bucket := range buckets {
+		bucket.tokens--
+	}
+
+	return 0
+}
+
+func (bucket *_TokenBucket) _Refill(now time.Time) {
+	if elapsed := now.Sub(bucket.updated).Seconds(); elapsed > 0 {
+		bucket.tokens = math.Min(bucket.capacity, bucket.tokens+elapsed*bucket.rate)
+	}
+	bucket.updated = now
+}
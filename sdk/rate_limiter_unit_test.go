@@ -0,0 +1,167 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _MockThrottleDefinitions() ThrottleDefinitions {
+	return ThrottleDefinitions{
+		ThrottleBuckets: []ThrottleBucket{
+			{
+				Name:        "ThroughputLimits",
+				BurstPeriod: 2 * time.Second,
+				ThrottleGroups: []ThrottleGroup{
+					{Operations: []RequestType{RequestTypeCryptoTransfer, RequestTypeCryptoCreate}, MilliOpsPerSec: 10_000},
+				},
+			},
+			{
+				Name:        "CreationLimits",
+				BurstPeriod: time.Second,
+				ThrottleGroups: []ThrottleGroup{
+					{Operations: []RequestType{RequestTypeCryptoCreate}, MilliOpsPerSec: 2_000},
+				},
+			},
+		},
+	}
+}
+
+func TestUnitThrottleDefinitionsFromBytes(t *testing.T) {
+	t.Parallel()
+
+	definitions := _MockThrottleDefinitions()
+
+	restored, err := ThrottleDefinitionsFromBytes(definitions.ToBytes())
+	require.NoError(t, err)
+	assert.Equal(t, definitions, restored)
+	assert.Contains(t, restored.String(), "Name: CreationLimits, BurstPeriod: 1s, ThrottleGroups: [Operations: [CryptoCreate], MilliOpsPerSec: 2000]")
+
+	_, err = ThrottleDefinitionsFromBytes(nil)
+	assert.ErrorIs(t, err, errByteArrayNull)
+
+	assert.Equal(t, FileID{Shard: 1, Realm: 2, File: 123}, GetThrottleDefinitionsFileIDFor(1, 2))
+}
+
+func TestUnitRateLimiterFromThrottleDefinitions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	limiter := NewRateLimiterFromThrottleDefinitions(_MockThrottleDefinitions(), 0.5)
+	limiter.now = func() time.Time { return now }
+
+	// creations are limited by both groups: 1 op/s with a burst of 1 at half the network capacity
+	assert.True(t, limiter.TryAcquire(RequestTypeCryptoCreate))
+	assert.False(t, limiter.TryAcquire(RequestTypeCryptoCreate))
+
+	// transfers share the throughput group, which has 10 tokens at half capacity and one taken by the creation
+	for range 9 {
+		assert.True(t, limiter.TryAcquire(RequestTypeCryptoTransfer))
+	}
+	assert.False(t, limiter.TryAcquire(RequestTypeCryptoTransfer))
+
+	// request types without a throttle group are not limited
+	assert.True(t, limiter.TryAcquire(RequestTypeConsensusSubmitMessage))
+
+	now = now.Add(time.Second)
+	assert.True(t, limiter.TryAcquire(RequestTypeCryptoCreate))
+	for range 4 {
+		assert.True(t, limiter.TryAcquire(RequestTypeCryptoTransfer))
+	}
+	assert.False(t, limiter.TryAcquire(RequestTypeCryptoTransfer))
+}
+
+func TestUnitRateLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter().SetLimit(RequestTypeCryptoTransfer, 20, 1)
+
+	start := time.Now()
+	for range 3 {
+		require.NoError(t, limiter.Wait(context.Background(), RequestTypeCryptoTransfer))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	limiter.SetLimit(RequestTypeCryptoTransfer, 0.001, 1)
+	require.True(t, limiter.TryAcquire(RequestTypeCryptoTransfer))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx, RequestTypeCryptoTransfer), context.DeadlineExceeded)
+
+	limiter.RemoveLimit(RequestTypeCryptoTransfer)
+	assert.True(t, limiter.TryAcquire(RequestTypeCryptoTransfer))
+}
+
+func TestUnitMockClientRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					Balance: 2000,
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	limiter := NewRateLimiter().SetLimit(RequestTypeCryptoGetAccountBalance, 0.001, 1)
+	client.SetRateLimiter(limiter)
+	assert.Same(t, limiter, client.GetRateLimiter())
+
+	query := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800})
+
+	balance, err := query.Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(2000), balance.Hbars)
+
+	// the only token was taken, so the next balance query waits locally until the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = query.ExecuteWithContext(ctx, client)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestUnitRateLimiterWaitsBeforeSigning(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	limiter := NewRateLimiter().SetLimit(RequestTypeCryptoTransfer, 0.001, 1)
+	require.True(t, limiter.TryAcquire(RequestTypeCryptoTransfer))
+	client.SetRateLimiter(limiter)
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	signer := &_TestSigner{key: key}
+
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 6}, NewHbar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	tx.SignWithSigner(signer)
+
+	// the request waits for the rate limiter until the context is done and is never signed
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = tx.ExecuteWithContext(ctx, client)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, signer.signCalls)
+}
@@ -4,6 +4,9 @@ package hiero
 
 import (
 	"fmt"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 type RequestType uint32
@@ -190,6 +193,8 @@ const (
 	RequestTypeHistoryProofVote           RequestType = 106
 	RequestTypeCrsPublication             RequestType = 107
 	RequestTypeAtomicBatch                RequestType = 108
+	RequestTypeLambdaSStore               RequestType = 109
+	RequestTypeHookDispatch               RequestType = 110
 )
 
 // String() returns a string representation of the status
@@ -385,7 +390,230 @@ func (requestType RequestType) String() string { // nolint
 		return "CRS_PUBLICATION"
 	case RequestTypeAtomicBatch:
 		return "ATOMIC_BATCH"
+	case RequestTypeLambdaSStore:
+		return "LAMBDA_S_STORE"
+	case RequestTypeHookDispatch:
+		return "HOOK_DISPATCH"
 	}
 
 	panic(fmt.Sprintf("unreachable: RequestType.String() switch statement is non-exhaustive. RequestType: %v", uint32(requestType)))
 }
+
+// _RequestTypeFromTransactionBody returns the RequestType of the body's data, or RequestTypeNone when it has none.
+func _RequestTypeFromTransactionBody(body *services.TransactionBody) RequestType { // nolint
+	switch body.Data.(type) {
+	case *services.TransactionBody_ContractCall:
+		return RequestTypeContractCall
+	case *services.TransactionBody_ContractCreateInstance:
+		return RequestTypeContractCreate
+	case *services.TransactionBody_ContractUpdateInstance:
+		return RequestTypeContractUpdate
+	case *services.TransactionBody_ContractDeleteInstance:
+		return RequestTypeContractDelete
+	case *services.TransactionBody_EthereumTransaction:
+		return RequestTypeEthereumTransaction
+	case *services.TransactionBody_CryptoAddLiveHash:
+		return RequestTypeCryptoAddLiveHash
+	case *services.TransactionBody_CryptoCreateAccount:
+		return RequestTypeCryptoCreate
+	case *services.TransactionBody_CryptoDelete:
+		return RequestTypeCryptoDelete
+	case *services.TransactionBody_CryptoDeleteLiveHash:
+		return RequestTypeCryptoDeleteLiveHash
+	case *services.TransactionBody_CryptoTransfer:
+		return RequestTypeCryptoTransfer
+	case *services.TransactionBody_CryptoUpdateAccount:
+		return RequestTypeCryptoUpdate
+	case *services.TransactionBody_CryptoApproveAllowance:
+		return RequestTypeCryptoApproveAllowance
+	case *services.TransactionBody_CryptoDeleteAllowance:
+		return RequestTypeCryptoDeleteAllowance
+	case *services.TransactionBody_FileAppend:
+		return RequestTypeFileAppend
+	case *services.TransactionBody_FileCreate:
+		return RequestTypeFileCreate
+	case *services.TransactionBody_FileDelete:
+		return RequestTypeFileDelete
+	case *services.TransactionBody_FileUpdate:
+		return RequestTypeFileUpdate
+	case *services.TransactionBody_SystemDelete:
+		return RequestTypeSystemDelete
+	case *services.TransactionBody_SystemUndelete:
+		return RequestTypeSystemUndelete
+	case *services.TransactionBody_Freeze:
+		return RequestTypeFreeze
+	case *services.TransactionBody_ConsensusCreateTopic:
+		return RequestTypeConsensusCreateTopic
+	case *services.TransactionBody_ConsensusUpdateTopic:
+		return RequestTypeConsensusUpdateTopic
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		return RequestTypeConsensusDeleteTopic
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		return RequestTypeConsensusSubmitMessage
+	case *services.TransactionBody_TokenCreation:
+		return RequestTypeTokenCreate
+	case *services.TransactionBody_TokenFreeze:
+		return RequestTypeTokenFreezeAccount
+	case *services.TransactionBody_TokenUnfreeze:
+		return RequestTypeTokenUnfreezeAccount
+	case *services.TransactionBody_TokenGrantKyc:
+		return RequestTypeTokenGrantKycToAccount
+	case *services.TransactionBody_TokenRevokeKyc:
+		return RequestTypeTokenRevokeKycFromAccount
+	case *services.TransactionBody_TokenDeletion:
+		return RequestTypeTokenDelete
+	case *services.TransactionBody_TokenUpdate:
+		return RequestTypeTokenUpdate
+	case *services.TransactionBody_TokenMint:
+		return RequestTypeTokenMint
+	case *services.TransactionBody_TokenBurn:
+		return RequestTypeTokenBurn
+	case *services.TransactionBody_TokenWipe:
+		return RequestTypeTokenAccountWipe
+	case *services.TransactionBody_TokenAssociate:
+		return RequestTypeTokenAssociateToAccount
+	case *services.TransactionBody_TokenDissociate:
+		return RequestTypeTokenDissociateFromAccount
+	case *services.TransactionBody_TokenFeeScheduleUpdate:
+		return RequestTypeTokenFeeScheduleUpdate
+	case *services.TransactionBody_TokenPause:
+		return RequestTypeTokenPause
+	case *services.TransactionBody_TokenUnpause:
+		return RequestTypeTokenUnpause
+	case *services.TransactionBody_TokenUpdateNfts:
+		return RequestTypeTokenUpdateNfts
+	case *services.TransactionBody_TokenReject:
+		return RequestTypeTokenReject
+	case *services.TransactionBody_TokenAirdrop:
+		return RequestTypeTokenAirdrop
+	case *services.TransactionBody_TokenCancelAirdrop:
+		return RequestTypeTokenCancelAirdrop
+	case *services.TransactionBody_TokenClaimAirdrop:
+		return RequestTypeTokenClaimAirdrop
+	case *services.TransactionBody_ScheduleCreate:
+		return RequestTypeScheduleCreate
+	case *services.TransactionBody_ScheduleDelete:
+		return RequestTypeScheduleDelete
+	case *services.TransactionBody_ScheduleSign:
+		return RequestTypeScheduleSign
+	case *services.TransactionBody_NodeStakeUpdate:
+		return RequestTypeNodeStakeUpdate
+	case *services.TransactionBody_UtilPrng:
+		return RequestTypePrng
+	case *services.TransactionBody_NodeCreate:
+		return RequestTypeNodeCreate
+	case *services.TransactionBody_NodeUpdate:
+		return RequestTypeNodeUpdate
+	case *services.TransactionBody_NodeDelete:
+		return RequestTypeNodeDelete
+	case *services.TransactionBody_StateSignatureTransaction:
+		return RequestTypeStateSignatureTransaction
+	case *services.TransactionBody_HintsPreprocessingVote:
+		return RequestTypeHintsPreprocessingVote
+	case *services.TransactionBody_HintsKeyPublication:
+		return RequestTypeHintsKeyPublication
+	case *services.TransactionBody_HintsPartialSignature:
+		return RequestTypeHintsPartialSignature
+	case *services.TransactionBody_HistoryProofSignature:
+		return RequestTypeHistoryAssemblySignature
+	case *services.TransactionBody_HistoryProofKeyPublication:
+		return RequestTypeHistoryProofKeyPublication
+	case *services.TransactionBody_HistoryProofVote:
+		return RequestTypeHistoryProofVote
+	case *services.TransactionBody_CrsPublication:
+		return RequestTypeCrsPublication
+	case *services.TransactionBody_AtomicBatch:
+		return RequestTypeAtomicBatch
+	case *services.TransactionBody_LambdaSstore:
+		return RequestTypeLambdaSStore
+	case *services.TransactionBody_HookDispatch:
+		return RequestTypeHookDispatch
+	}
+
+	return RequestTypeNone
+}
+
+// _RequestTypeFromQuery returns the RequestType of the query, or RequestTypeNone when it has none.
+func _RequestTypeFromQuery(query *services.Query) RequestType {
+	switch query.Query.(type) {
+	case *services.Query_GetByKey:
+		return RequestTypeGetByKey
+	case *services.Query_GetBySolidityID:
+		return RequestTypeGetBySolidityID
+	case *services.Query_ContractCallLocal:
+		return RequestTypeContractCallLocal
+	case *services.Query_ContractGetInfo:
+		return RequestTypeContractGetInfo
+	case *services.Query_ContractGetBytecode:
+		return RequestTypeContractGetBytecode
+	case *services.Query_ContractGetRecords:
+		return RequestTypeContractGetRecords
+	case *services.Query_CryptogetAccountBalance:
+		return RequestTypeCryptoGetAccountBalance
+	case *services.Query_CryptoGetAccountRecords:
+		return RequestTypeCryptoGetAccountRecords
+	case *services.Query_CryptoGetInfo:
+		return RequestTypeCryptoGetInfo
+	case *services.Query_CryptoGetLiveHash:
+		return RequestTypeCryptoGetLiveHash
+	case *services.Query_CryptoGetProxyStakers:
+		return RequestTypeCryptoGetStakers
+	case *services.Query_FileGetContents:
+		return RequestTypeFileGetContents
+	case *services.Query_FileGetInfo:
+		return RequestTypeFileGetInfo
+	case *services.Query_TransactionGetReceipt:
+		return RequestTypeTransactionGetReceipt
+	case *services.Query_TransactionGetRecord:
+		return RequestTypeTransactionGetRecord
+	case *services.Query_TransactionGetFastRecord:
+		return RequestTypeTransactionGetFastRecord
+	case *services.Query_ConsensusGetTopicInfo:
+		return RequestTypeConsensusGetTopicInfo
+	case *services.Query_NetworkGetVersionInfo:
+		return RequestTypeGetVersionInfo
+	case *services.Query_TokenGetInfo:
+		return RequestTypeTokenGetInfo
+	case *services.Query_ScheduleGetInfo:
+		return RequestTypeScheduleGetInfo
+	case *services.Query_TokenGetAccountNftInfos:
+		return RequestTypeTokenGetAccountNftInfos
+	case *services.Query_TokenGetNftInfo:
+		return RequestTypeTokenGetNftInfo
+	case *services.Query_TokenGetNftInfos:
+		return RequestTypeTokenGetNftInfos
+	case *services.Query_NetworkGetExecutionTime:
+		return RequestTypeNetworkGetExecutionTime
+	case *services.Query_AccountDetails:
+		return RequestTypeGetAccountDetails
+	}
+
+	return RequestTypeNone
+}
+
+// _RequestTypeFromRequest returns the RequestType of a request sent by _Execute.
+func _RequestTypeFromRequest(request any) RequestType {
+	switch request := request.(type) {
+	case *services.Query:
+		return _RequestTypeFromQuery(request)
+	case *services.Transaction:
+		signedTransaction := request.GetSignedTransactionBytes()
+		if len(signedTransaction) == 0 {
+			return RequestTypeNone
+		}
+
+		var signed services.SignedTransaction
+		if err := protobuf.Unmarshal(signedTransaction, &signed); err != nil {
+			return RequestTypeNone
+		}
+
+		var body services.TransactionBody
+		if err := protobuf.Unmarshal(signed.GetBodyBytes(), &body); err != nil {
+			return RequestTypeNone
+		}
+
+		return _RequestTypeFromTransactionBody(&body)
+	}
+
+	return RequestTypeNone
+}
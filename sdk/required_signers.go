@@ -0,0 +1,490 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// SignatureRequirement is one key which has to sign a transaction, e.g. the key of a sender account.
+type SignatureRequirement struct {
+	// Reason describes why the key has to sign, e.g. "sender 0.0.1234" or "supply key of token 0.0.5678".
+	Reason string
+	// Entity is the network entity whose key has to sign, nil for keys set in the transaction body.
+	Entity *EntityKey
+	// Key is the required key, nil when it could not be resolved.
+	Key Key
+	// Satisfied is true when the signatures attached to the transaction satisfy Key.
+	Satisfied bool
+	// MissingKeys are the unsigned public keys in the unsatisfied parts of Key.
+	MissingKeys []PublicKey
+	// Err is the error returned by the KeyResolver for Entity.
+	Err error
+}
+
+// SignerRequirements is the result of RequiredSigners.
+type SignerRequirements struct {
+	// Requirements are the keys which have to sign, the payer first.
+	Requirements []SignatureRequirement
+	// SignedKeys are the keys which signed the transaction, or will sign it when it is built.
+	SignedKeys []PublicKey
+	// Satisfied is true when every requirement is satisfied.
+	Satisfied bool
+}
+
+// GetUnsatisfied returns the requirements which are not satisfied yet.
+func (requirements SignerRequirements) GetUnsatisfied() []SignatureRequirement {
+	unsatisfied := make([]SignatureRequirement, 0)
+	for _, requirement := range requirements.Requirements {
+		if !requirement.Satisfied {
+			unsatisfied = append(unsatisfied, requirement)
+		}
+	}
+
+	return unsatisfied
+}
+
+// RequiredSigners reports which keys have to sign tx and whether the signatures already attached satisfy them.
+// Keys of network entities, such as the sender accounts of a TransferTransaction or the supply key of the token of a
+// TokenMintTransaction, are looked up with resolver. The requirements follow the signing rules of the network, with
+// the exception of signatures required by custom fee collectors and the keys of scheduled transactions.
+func RequiredSigners(tx TransactionInterface, resolver KeyResolver) (SignerRequirements, error) {
+	return RequiredSignersWithContext(context.Background(), tx, resolver)
+}
+
+// RequiredSignersWithContext is RequiredSigners, returning ctx.Err() if ctx is done while keys are resolved.
+func RequiredSignersWithContext(ctx context.Context, tx TransactionInterface, resolver KeyResolver) (SignerRequirements, error) {
+	if tx == nil {
+		return SignerRequirements{}, errParameterNull
+	}
+
+	walker := _RequiredSignersWalker{
+		ctx:      ctx,
+		resolver: resolver,
+		resolved: make(map[EntityKey]_ResolvedKey),
+		seen:     make(map[EntityKey]bool),
+	}
+
+	baseTx := tx.getBaseTransaction()
+	if baseTx.transactionIDs._Length() > 0 {
+		if payer := baseTx.transactionIDs._Get(0).(TransactionID).AccountID; payer != nil {
+			walker._RequireAccount("payer", *payer)
+		}
+	}
+
+	walker._Walk(_TransactionPointer(tx))
+
+	if err := ctx.Err(); err != nil {
+		return SignerRequirements{}, err
+	}
+
+	signedKeys := _SignedPublicKeys(baseTx)
+	signed := make(map[string]bool, len(signedKeys))
+	for _, key := range signedKeys {
+		signed[key.String()] = true
+	}
+	// keys added with Sign are only applied when the transaction is built
+	for i, key := range baseTx.publicKeys {
+		if baseTx.transactionSigners[i] != nil && !signed[key.String()] {
+			signed[key.String()] = true
+			signedKeys = append(signedKeys, key)
+		}
+	}
+
+	result := SignerRequirements{
+		Requirements: walker.requirements,
+		SignedKeys:   signedKeys,
+		Satisfied:    true,
+	}
+
+	for i, requirement := range result.Requirements {
+		if requirement.Key != nil {
+			evaluation := _EvaluateKey(requirement.Key, signed, nil)
+			requirement.Satisfied, requirement.MissingKeys = evaluation.Satisfied, evaluation.MissingKeys
+		} else {
+			requirement.MissingKeys = []PublicKey{}
+		}
+		result.Satisfied = result.Satisfied && requirement.Satisfied
+		result.Requirements[i] = requirement
+	}
+
+	return result, nil
+}
+
+type _ResolvedKey struct {
+	key Key
+	err error
+}
+
+type _RequiredSignersWalker struct {
+	ctx          context.Context
+	resolver     KeyResolver
+	resolved     map[EntityKey]_ResolvedKey
+	seen         map[EntityKey]bool
+	requirements []SignatureRequirement
+}
+
+// _TransactionPointer returns a pointer to tx, as TransactionFromBytes returns the transactions by value.
+func _TransactionPointer(tx TransactionInterface) TransactionInterface {
+	value := reflect.ValueOf(tx)
+	if value.Kind() != reflect.Struct {
+		return tx
+	}
+
+	pointer := reflect.New(value.Type())
+	pointer.Elem().Set(value)
+	if result, ok := pointer.Interface().(TransactionInterface); ok {
+		return result
+	}
+
+	return tx
+}
+
+func (walker *_RequiredSignersWalker) _RequireEntity(reason string, entity EntityKey) {
+	if walker.seen[entity] {
+		return
+	}
+
+	resolved, ok := walker.resolved[entity]
+	if !ok {
+		if walker.ctx.Err() != nil {
+			return
+		}
+		if walker.resolver == nil {
+			resolved.err = ErrKeyNotResolved{Entity: entity}
+		} else {
+			resolved.key, resolved.err = walker.resolver.ResolveKey(walker.ctx, entity)
+		}
+		walker.resolved[entity] = resolved
+	}
+
+	// an entity without the key, e.g. a topic without a submit key, does not require a signature
+	if resolved.err == nil && resolved.key == nil {
+		return
+	}
+
+	walker.seen[entity] = true
+	walker.requirements = append(walker.requirements, SignatureRequirement{
+		Reason: reason,
+		Entity: &entity,
+		Key:    resolved.key,
+		Err:    resolved.err,
+	})
+}
+
+func (walker *_RequiredSignersWalker) _RequireKey(reason string, key Key) {
+	if key == nil {
+		return
+	}
+
+	walker.requirements = append(walker.requirements, SignatureRequirement{
+		Reason: reason,
+		Key:    key,
+	})
+}
+
+// _RequireAccount requires the key of an account once, the first role of the account, e.g. the payer, is reported.
+func (walker *_RequiredSignersWalker) _RequireAccount(reason string, accountID AccountID) {
+	walker._RequireEntity(reason+" "+accountID.String(), EntityKey{Type: EntityKeyTypeAccount, ID: accountID.String()})
+}
+
+func (walker *_RequiredSignersWalker) _RequireOptionalAccount(reason string, accountID *AccountID) {
+	if accountID != nil {
+		walker._RequireAccount(reason, *accountID)
+	}
+}
+
+// _RequireReceiver requires the key of an account receiving value if the account requires receiver signatures.
+func (walker *_RequiredSignersWalker) _RequireReceiver(accountID AccountID) {
+	if walker.seen[EntityKey{Type: EntityKeyTypeAccount, ID: accountID.String()}] {
+		return
+	}
+
+	walker._RequireEntity("receiver "+accountID.String(), EntityKey{Type: EntityKeyTypeAccountReceiver, ID: accountID.String()})
+}
+
+func (walker *_RequiredSignersWalker) _RequireOwner(owner *AccountID) {
+	if owner == nil {
+		// the payer owns allowances without an owner
+		return
+	}
+
+	walker._RequireAccount("owner", *owner)
+}
+
+func (walker *_RequiredSignersWalker) _RequireToken(tokenID *TokenID, keyType EntityKeyType) {
+	if tokenID == nil {
+		return
+	}
+
+	entity := EntityKey{Type: keyType, ID: tokenID.String()}
+	walker._RequireEntity(entity.String(), entity)
+}
+
+func (walker *_RequiredSignersWalker) _RequireTopic(topicID *TopicID, keyType EntityKeyType) {
+	if topicID == nil {
+		return
+	}
+
+	entity := EntityKey{Type: keyType, ID: topicID.String()}
+	walker._RequireEntity(entity.String(), entity)
+}
+
+func (walker *_RequiredSignersWalker) _RequireFile(fileID *FileID, deletion bool) {
+	if fileID == nil {
+		return
+	}
+
+	count := len(walker.requirements)
+	entity := EntityKey{Type: EntityKeyTypeFile, ID: fileID.String()}
+	walker._RequireEntity(entity.String(), entity)
+
+	// any single key of the file's key list may delete it, all of them are required for other changes
+	if deletion && len(walker.requirements) > count {
+		requirement := &walker.requirements[count]
+		if keys, ok := requirement.Key.(*KeyList); ok {
+			requirement.Key = &KeyList{keys: keys.keys, threshold: 1}
+		}
+	}
+}
+
+func (walker *_RequiredSignersWalker) _RequireContract(contractID *ContractID) {
+	if contractID == nil {
+		return
+	}
+
+	entity := EntityKey{Type: EntityKeyTypeContractAdmin, ID: contractID.String()}
+	walker._RequireEntity(entity.String(), entity)
+}
+
+func (walker *_RequiredSignersWalker) _RequireNode(nodeID *uint64) {
+	if nodeID == nil {
+		return
+	}
+
+	entity := EntityKey{Type: EntityKeyTypeNodeAdmin, ID: strconv.FormatUint(*nodeID, 10)}
+	walker._RequireEntity(entity.String(), entity)
+}
+
+func (walker *_RequiredSignersWalker) _RequireHbarTransfers(transfers []*_HbarTransfer) {
+	for _, transfer := range transfers {
+		if transfer.accountID == nil || transfer.hookCall != nil {
+			continue
+		}
+
+		if transfer.amount.AsTinybar() < 0 {
+			// approved transfers are authorized by the spender, which pays for the transaction
+			if !transfer.isApproved {
+				walker._RequireAccount("sender", *transfer.accountID)
+			}
+		} else if transfer.amount.AsTinybar() > 0 {
+			walker._RequireReceiver(*transfer.accountID)
+		}
+	}
+}
+
+func (walker *_RequiredSignersWalker) _RequireTokenTransfers(tokenTransfers map[TokenID]*_TokenTransfer, nftTransfers map[TokenID][]*_TokenNftTransfer, receivers bool) {
+	for _, tokenID := range _SortedTokenIDs(tokenTransfers, nftTransfers) {
+		if transfers, ok := tokenTransfers[tokenID]; ok {
+			for _, transfer := range transfers.Transfers {
+				if transfer.accountID == nil || transfer.hookCall != nil {
+					continue
+				}
+
+				if transfer.amount.AsTinybar() < 0 {
+					if !transfer.isApproved {
+						walker._RequireAccount("sender", *transfer.accountID)
+					}
+				} else if receivers && transfer.amount.AsTinybar() > 0 {
+					walker._RequireReceiver(*transfer.accountID)
+				}
+			}
+		}
+
+		for _, transfer := range nftTransfers[tokenID] {
+			if !transfer.IsApproved && transfer.SenderHookCall == nil {
+				walker._RequireAccount("sender", transfer.SenderAccountID)
+			}
+			if receivers && transfer.ReceiverHookCall == nil {
+				walker._RequireReceiver(transfer.ReceiverAccountID)
+			}
+		}
+	}
+}
+
+// _SortedTokenIDs returns the tokens of the transfer maps in a stable order, so requirements are reported in the same
+// order on every call.
+func _SortedTokenIDs(tokenTransfers map[TokenID]*_TokenTransfer, nftTransfers map[TokenID][]*_TokenNftTransfer) []TokenID {
+	tokenIDs := make([]TokenID, 0, len(tokenTransfers)+len(nftTransfers))
+	seen := make(map[TokenID]bool)
+	for tokenID := range tokenTransfers {
+		seen[tokenID] = true
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	for tokenID := range nftTransfers {
+		if !seen[tokenID] {
+			tokenIDs = append(tokenIDs, tokenID)
+		}
+	}
+
+	sort.Slice(tokenIDs, func(i, j int) bool {
+		return tokenIDs[i].Compare(tokenIDs[j]) < 0
+	})
+
+	return tokenIDs
+}
+
+func (walker *_RequiredSignersWalker) _Walk(tx TransactionInterface) { // nolint
+	switch t := tx.(type) {
+	case *TransferTransaction:
+		walker._RequireHbarTransfers(t.hbarTransfers)
+		walker._RequireTokenTransfers(t.tokenTransfers, t.nftTransfers, true)
+	case *TokenAirdropTransaction:
+		// receivers which cannot accept the airdrop get a pending airdrop instead of having to sign
+		walker._RequireTokenTransfers(t.tokenTransfers, t.nftTransfers, false)
+	case *TokenCancelAirdropTransaction:
+		for _, pendingAirdropID := range t.pendingAirdropIds {
+			walker._RequireOptionalAccount("sender", pendingAirdropID.sender)
+		}
+	case *TokenClaimAirdropTransaction:
+		for _, pendingAirdropID := range t.pendingAirdropIds {
+			walker._RequireOptionalAccount("receiver", pendingAirdropID.receiver)
+		}
+	case *TokenRejectTransaction:
+		walker._RequireOptionalAccount("owner", t.ownerID)
+	case *AccountAllowanceApproveTransaction:
+		for _, allowance := range t.hbarAllowances {
+			walker._RequireOwner(allowance.OwnerAccountID)
+		}
+		for _, allowance := range t.tokenAllowances {
+			walker._RequireOwner(allowance.OwnerAccountID)
+		}
+		for _, allowance := range t.nftAllowances {
+			walker._RequireOwner(allowance.OwnerAccountID)
+		}
+	case *AccountAllowanceDeleteTransaction:
+		for _, allowance := range t.nftWipe {
+			walker._RequireOwner(allowance.OwnerAccountID)
+		}
+	case *AccountCreateTransaction:
+		if t.receiverSignatureRequired {
+			walker._RequireKey("key of the new account", t.key)
+		}
+	case *AccountUpdateTransaction:
+		walker._RequireOptionalAccount("updated account", t.accountID)
+		walker._RequireKey("new key of the account", t.key)
+	case *AccountDeleteTransaction:
+		walker._RequireOptionalAccount("deleted account", t.deleteAccountID)
+		if t.transferAccountID != nil {
+			walker._RequireReceiver(*t.transferAccountID)
+		}
+	case *LiveHashAddTransaction:
+		walker._RequireOptionalAccount("account", t.accountID)
+	case *LiveHashDeleteTransaction:
+		walker._RequireOptionalAccount("account", t.accountID)
+	case *ContractCreateTransaction:
+		walker._RequireKey("admin key of the new contract", t.adminKey)
+		walker._RequireOptionalAccount("auto renew account", t.autoRenewAccountID)
+	case *ContractUpdateTransaction:
+		walker._RequireContract(t.contractID)
+		walker._RequireKey("new admin key of the contract", t.adminKey)
+		walker._RequireOptionalAccount("auto renew account", t.autoRenewAccountID)
+	case *ContractDeleteTransaction:
+		walker._RequireContract(t.contractID)
+		if t.transferAccountID != nil {
+			walker._RequireReceiver(*t.transferAccountID)
+		}
+	case *FileCreateTransaction:
+		if t.keys != nil {
+			walker._RequireKey("keys of the new file", t.keys)
+		}
+	case *FileAppendTransaction:
+		walker._RequireFile(t.fileID, false)
+	case *FileUpdateTransaction:
+		walker._RequireFile(t.fileID, false)
+		if t.keys != nil {
+			walker._RequireKey("new keys of the file", t.keys)
+		}
+	case *FileDeleteTransaction:
+		walker._RequireFile(t.fileID, true)
+	case *TokenCreateTransaction:
+		walker._RequireKey("admin key of the new token", t.adminKey)
+		walker._RequireOptionalAccount("treasury", t.treasuryAccountID)
+		walker._RequireOptionalAccount("auto renew account", t.autoRenewAccountID)
+	case *TokenUpdateTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenAdmin)
+		walker._RequireKey("new admin key of the token", t.adminKey)
+		walker._RequireOptionalAccount("new treasury", t.treasuryAccountID)
+		walker._RequireOptionalAccount("auto renew account", t.autoRenewAccountID)
+	case *TokenDeleteTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenAdmin)
+	case *TokenMintTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenSupply)
+	case *TokenBurnTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenSupply)
+	case *TokenWipeTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenWipe)
+	case *TokenFreezeTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenFreeze)
+	case *TokenUnfreezeTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenFreeze)
+	case *TokenGrantKycTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenKyc)
+	case *TokenRevokeKycTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenKyc)
+	case *TokenPauseTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenPause)
+	case *TokenUnpauseTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenPause)
+	case *TokenFeeScheduleUpdateTransaction:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenFeeSchedule)
+	case *TokenUpdateNfts:
+		walker._RequireToken(t.tokenID, EntityKeyTypeTokenMetadata)
+	case *TokenAssociateTransaction:
+		walker._RequireOptionalAccount("associated account", t.accountID)
+	case *TokenDissociateTransaction:
+		walker._RequireOptionalAccount("dissociated account", t.accountID)
+	case *TopicCreateTransaction:
+		walker._RequireKey("admin key of the new topic", t.adminKey)
+		walker._RequireOptionalAccount("auto renew account", t.autoRenewAccountID)
+	case *TopicUpdateTransaction:
+		walker._RequireTopic(t.topicID, EntityKeyTypeTopicAdmin)
+		walker._RequireKey("new admin key of the topic", t.adminKey)
+		walker._RequireOptionalAccount("auto renew account", t.autoRenewAccountID)
+	case *TopicDeleteTransaction:
+		walker._RequireTopic(t.topicID, EntityKeyTypeTopicAdmin)
+	case *TopicMessageSubmitTransaction:
+		walker._RequireTopic(t.topicID, EntityKeyTypeTopicSubmit)
+	case *ScheduleCreateTransaction:
+		walker._RequireKey("admin key of the new schedule", t.adminKey)
+	case *ScheduleDeleteTransaction:
+		if t.scheduleID != nil {
+			entity := EntityKey{Type: EntityKeyTypeScheduleAdmin, ID: t.scheduleID.String()}
+			walker._RequireEntity(entity.String(), entity)
+		}
+	case *NodeCreateTransaction:
+		walker._RequireKey("admin key of the new node", t.adminKey)
+	case *NodeUpdateTransaction:
+		walker._RequireNode(t.nodeID)
+		walker._RequireKey("new admin key of the node", t.adminKey)
+	case *NodeDeleteTransaction:
+		walker._RequireNode(t.nodeID)
+	case *LambdaSStoreTransaction:
+		entityID := t.hookId.GetEntityId()
+		if entityID.accountId != nil {
+			walker._RequireAccount("hook owner", *entityID.accountId)
+		}
+		walker._RequireContract(entityID.contractId)
+	case *BatchTransaction:
+		for i, inner := range t.innerTransactions {
+			walker._RequireKey(fmt.Sprintf("batch key of inner transaction %d", i), inner.getBaseTransaction().batchKey)
+		}
+	case *ScheduleSignTransaction, *ContractExecuteTransaction, *EthereumTransaction, *FreezeTransaction,
+		*SystemDeleteTransaction, *SystemUndeleteTransaction, *PrngTransaction:
+		// only the payer signs
+	}
+}
@@ -0,0 +1,277 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _GenerateRequiredSignersKeys(t *testing.T, count int) []PrivateKey {
+	keys := make([]PrivateKey, count)
+	for i := range keys {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+
+	return keys
+}
+
+func TestUnitRequiredSignersTransferNestedThreshold(t *testing.T) {
+	t.Parallel()
+
+	keys := _GenerateRequiredSignersKeys(t, 5)
+	payer, sender, receiver, plain, owner := AccountID{Account: 5}, AccountID{Account: 6}, AccountID{Account: 7}, AccountID{Account: 8}, AccountID{Account: 9}
+
+	// 2 of [key1, key2, 1 of [key3, key4]]
+	senderKey := KeyListWithThreshold(2).
+		Add(keys[1].PublicKey()).
+		Add(keys[2].PublicKey()).
+		Add(KeyListWithThreshold(1).Add(keys[3].PublicKey()).Add(keys[4].PublicKey()))
+
+	resolver := NewStaticKeyResolver().
+		SetAccountKey(payer, keys[0].PublicKey(), false).
+		SetAccountKey(sender, senderKey, false).
+		SetAccountKey(receiver, keys[4].PublicKey(), true).
+		SetAccountKey(plain, keys[1].PublicKey(), false)
+
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(payer)).
+		AddHbarTransfer(sender, NewHbar(-3)).
+		AddHbarTransfer(receiver, NewHbar(1)).
+		AddHbarTransfer(plain, NewHbar(1)).
+		AddApprovedHbarTransfer(owner, NewHbar(-1), true).
+		AddHbarTransfer(payer, NewHbar(2)).
+		Freeze()
+	require.NoError(t, err)
+
+	requirements, err := RequiredSigners(tx, resolver)
+	require.NoError(t, err)
+	assert.False(t, requirements.Satisfied)
+	require.Len(t, requirements.Requirements, 3)
+	assert.Equal(t, "payer 0.0.5", requirements.Requirements[0].Reason)
+	assert.Equal(t, "sender 0.0.6", requirements.Requirements[1].Reason)
+	assert.Equal(t, "receiver 0.0.7", requirements.Requirements[2].Reason)
+	assert.Equal(t, EntityKey{Type: EntityKeyTypeAccountReceiver, ID: "0.0.7"}, *requirements.Requirements[2].Entity)
+	assert.Len(t, requirements.Requirements[1].MissingKeys, 4)
+
+	tx.Sign(keys[0]).Sign(keys[1])
+
+	requirements, err = RequiredSigners(tx, resolver)
+	require.NoError(t, err)
+	assert.Len(t, requirements.SignedKeys, 2)
+	assert.True(t, requirements.Requirements[0].Satisfied)
+	assert.False(t, requirements.Requirements[1].Satisfied)
+	assert.Equal(t, []PublicKey{keys[2].PublicKey(), keys[3].PublicKey(), keys[4].PublicKey()}, requirements.Requirements[1].MissingKeys)
+
+	// key4 satisfies the nested list and the receiver
+	tx.Sign(keys[4])
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+
+	requirements, err = RequiredSigners(tx, resolver)
+	require.NoError(t, err)
+	assert.True(t, requirements.Satisfied)
+	assert.Empty(t, requirements.GetUnsatisfied())
+}
+
+func TestUnitRequiredSignersFromBytes(t *testing.T) {
+	t.Parallel()
+
+	keys := _GenerateRequiredSignersKeys(t, 2)
+	tokenID := TokenID{Token: 7}
+
+	tx, err := NewTokenMintTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		SetTokenID(tokenID).
+		SetAmount(10).
+		Freeze()
+	require.NoError(t, err)
+	tx.Sign(keys[1])
+
+	data, err := tx.ToBytes()
+	require.NoError(t, err)
+	restored, err := TransactionFromBytes(data)
+	require.NoError(t, err)
+
+	// the token is unknown to the resolver
+	resolver := NewStaticKeyResolver().SetAccountKey(AccountID{Account: 5}, keys[0].PublicKey(), false)
+	requirements, err := RequiredSigners(restored, resolver)
+	require.NoError(t, err)
+	require.Len(t, requirements.Requirements, 2)
+	assert.Equal(t, "supply key of token 0.0.7", requirements.Requirements[1].Reason)
+	assert.Nil(t, requirements.Requirements[1].Key)
+	var notResolved ErrKeyNotResolved
+	require.ErrorAs(t, requirements.Requirements[1].Err, &notResolved)
+	assert.Equal(t, EntityKeyTypeTokenSupply, notResolved.Entity.Type)
+
+	resolver.SetTokenKey(tokenID, EntityKeyTypeTokenSupply, keys[1].PublicKey())
+	requirements, err = RequiredSigners(restored, resolver)
+	require.NoError(t, err)
+	assert.False(t, requirements.Requirements[0].Satisfied)
+	assert.True(t, requirements.Requirements[1].Satisfied)
+	assert.NoError(t, requirements.Requirements[1].Err)
+}
+
+func TestUnitRequiredSignersEntityAndBodyKeys(t *testing.T) {
+	t.Parallel()
+
+	keys := _GenerateRequiredSignersKeys(t, 4)
+	payer := AccountID{Account: 5}
+	fileID := FileID{File: 150}
+	fileKeys := NewKeyList().Add(keys[1].PublicKey()).Add(keys[2].PublicKey())
+
+	resolver := NewStaticKeyResolver().
+		SetAccountKey(payer, keys[0].PublicKey(), false).
+		SetAccountKey(AccountID{Account: 6}, keys[1].PublicKey(), false).
+		SetFileKeys(fileID, *fileKeys).
+		SetTopicKey(TopicID{Topic: 9}, EntityKeyTypeTopicSubmit, nil)
+
+	// one key of the file's key list is enough to delete it
+	deleteTx, err := NewFileDeleteTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(payer)).
+		SetFileID(fileID).
+		Freeze()
+	require.NoError(t, err)
+	deleteTx.Sign(keys[0]).Sign(keys[2])
+
+	requirements, err := RequiredSigners(deleteTx, resolver)
+	require.NoError(t, err)
+	require.Len(t, requirements.Requirements, 2)
+	assert.True(t, requirements.Satisfied)
+
+	// appending requires every key
+	appendTx, err := NewFileAppendTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(payer)).
+		SetFileID(fileID).
+		SetContents([]byte{1}).
+		FreezeWith(nil)
+	require.NoError(t, err)
+	appendTx.Sign(keys[0]).Sign(keys[2])
+
+	requirements, err = RequiredSigners(appendTx, resolver)
+	require.NoError(t, err)
+	assert.False(t, requirements.Satisfied)
+	assert.Equal(t, []PublicKey{keys[1].PublicKey()}, requirements.Requirements[1].MissingKeys)
+
+	// the old and the new key of an updated account
+	updateTx := NewAccountUpdateTransaction().
+		SetTransactionID(TransactionIDGenerate(payer)).
+		SetAccountID(AccountID{Account: 6}).
+		SetKey(keys[3].PublicKey())
+
+	requirements, err = RequiredSigners(updateTx, resolver)
+	require.NoError(t, err)
+	require.Len(t, requirements.Requirements, 3)
+	assert.Equal(t, "updated account 0.0.6", requirements.Requirements[1].Reason)
+	assert.Equal(t, "new key of the account", requirements.Requirements[2].Reason)
+	assert.Nil(t, requirements.Requirements[2].Entity)
+
+	// a topic without a submit key only needs the payer
+	submitTx := NewTopicMessageSubmitTransaction().
+		SetTransactionID(TransactionIDGenerate(payer)).
+		SetTopicID(TopicID{Topic: 9}).
+		SetMessage([]byte("hello"))
+
+	requirements, err = RequiredSigners(submitTx, resolver)
+	require.NoError(t, err)
+	require.Len(t, requirements.Requirements, 1)
+}
+
+func TestUnitRequiredSignersMirrorKeyResolver(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tokens/0.0.7":
+			_, _ = w.Write([]byte(`{
+				"token_id": "0.0.7",
+				"decimals": "0",
+				"type": "FUNGIBLE_COMMON",
+				"supply_type": "INFINITE",
+				"total_supply": "0",
+				"max_supply": "0",
+				"expiry_timestamp": 1800000000000000000,
+				"admin_key": null,
+				"supply_key": {"_type": "ED25519", "key": "2b60955bcbf0cf5e9ea880b52e5b63f664b08edf6ed15e301049517438d61864"}
+			}`))
+		case "/api/v1/network/nodes":
+			assert.Equal(t, "eq:3", r.URL.Query().Get("node.id"))
+			_, _ = w.Write([]byte(`{
+				"nodes": [{
+					"node_id": 3,
+					"node_account_id": "0.0.6",
+					"admin_key": {"_type": "ED25519", "key": "2b60955bcbf0cf5e9ea880b52e5b63f664b08edf6ed15e301049517438d61864"}
+				}],
+				"links": {"next": null}
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resolver := NewMirrorKeyResolver(_NewTestMirrorRestClient(server))
+
+	supplyKey, err := resolver.ResolveKey(context.Background(), EntityKey{Type: EntityKeyTypeTokenSupply, ID: "0.0.7"})
+	require.NoError(t, err)
+	assert.Equal(t, "2b60955bcbf0cf5e9ea880b52e5b63f664b08edf6ed15e301049517438d61864", supplyKey.(PublicKey).StringRaw())
+
+	// the token is cached
+	adminKey, err := resolver.ResolveKey(context.Background(), EntityKey{Type: EntityKeyTypeTokenAdmin, ID: "0.0.7"})
+	require.NoError(t, err)
+	assert.Nil(t, adminKey)
+	assert.Equal(t, int32(1), requests.Load())
+
+	nodeKey, err := resolver.ResolveKey(context.Background(), EntityKey{Type: EntityKeyTypeNodeAdmin, ID: "3"})
+	require.NoError(t, err)
+	assert.Equal(t, supplyKey.String(), nodeKey.String())
+
+	_, err = resolver.ResolveKey(context.Background(), EntityKey{Type: EntityKeyTypeFile, ID: "0.0.150"})
+	require.ErrorAs(t, err, &ErrKeyNotResolved{})
+}
+
+func TestUnitRequiredSignersSigningSession(t *testing.T) {
+	t.Parallel()
+
+	keys := _GenerateRequiredSignersKeys(t, 2)
+	resolver := NewStaticKeyResolver().
+		SetAccountKey(AccountID{Account: 5}, keys[0].PublicKey(), false).
+		SetTokenKey(TokenID{Token: 7}, EntityKeyTypeTokenPause, keys[1].PublicKey())
+
+	tx, err := NewTokenPauseTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		SetTokenID(TokenID{Token: 7}).
+		Freeze()
+	require.NoError(t, err)
+
+	session, err := NewSigningSession(tx)
+	require.NoError(t, err)
+	require.NoError(t, session.ResolveRequiredKey(context.Background(), resolver))
+
+	status, err := session.GetStatus()
+	require.NoError(t, err)
+	assert.Equal(t, []PublicKey{keys[0].PublicKey(), keys[1].PublicKey()}, status.MissingKeys)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = RequiredSignersWithContext(ctx, tx, resolver)
+	require.ErrorIs(t, err, context.Canceled)
+}
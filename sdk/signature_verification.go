@@ -0,0 +1,193 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// SignatureStatus is the outcome of verifying one signature attached to a transaction.
+type SignatureStatus int
+
+const (
+	// SignatureStatusValid is a signature which verifies against the body it is attached to.
+	SignatureStatusValid SignatureStatus = iota
+	// SignatureStatusInvalid is a signature which does not verify against the body it is attached to.
+	SignatureStatusInvalid
+	// SignatureStatusKeyTypeMismatch is a signature whose public key prefix is not a key of the signature's type, e.g.
+	// an ED25519 signature paired with an ECDSA key.
+	SignatureStatusKeyTypeMismatch
+	// SignatureStatusUnknownKey is a signature whose public key prefix is not a full public key, so the key cannot
+	// be recovered locally.
+	SignatureStatusUnknownKey
+	// SignatureStatusUnsupported is a signature which cannot be verified locally, e.g. RSA, ECDSA P-384 and contract
+	// signatures.
+	SignatureStatusUnsupported
+)
+
+// String returns a string representation of the SignatureStatus
+func (status SignatureStatus) String() string {
+	switch status {
+	case SignatureStatusValid:
+		return "VALID"
+	case SignatureStatusInvalid:
+		return "INVALID"
+	case SignatureStatusKeyTypeMismatch:
+		return "KEY_TYPE_MISMATCH"
+	case SignatureStatusUnknownKey:
+		return "UNKNOWN_KEY"
+	case SignatureStatusUnsupported:
+		return "UNSUPPORTED"
+	}
+
+	return "UNKNOWN"
+}
+
+// VerifiedSignature is one signature pair of a transaction body together with the outcome of its verification.
+type VerifiedSignature struct {
+	NodeAccountID AccountID
+	TransactionID TransactionID
+	// Chunk is the index of the chunk of chunked transactions and 0 otherwise.
+	Chunk        int
+	PubKeyPrefix []byte
+	// PublicKey is nil when the prefix is not a public key of the signature's type.
+	PublicKey *PublicKey
+	Status    SignatureStatus
+}
+
+// SignatureVerification is the result of verifying every signature attached to a transaction.
+type SignatureVerification struct {
+	// Signatures are the verified signatures, in the order of the transaction bodies.
+	Signatures []VerifiedSignature
+	// SignedKeys are the public keys with a valid signature on every body of the transaction.
+	SignedKeys []PublicKey
+	// PartialKeys are the public keys with a valid signature on some, but not all, bodies of the transaction.
+	PartialKeys []PublicKey
+}
+
+// GetInvalid returns the signatures which are not valid.
+func (verification SignatureVerification) GetInvalid() []VerifiedSignature {
+	invalid := make([]VerifiedSignature, 0)
+	for _, signature := range verification.Signatures {
+		if signature.Status != SignatureStatusValid {
+			invalid = append(invalid, signature)
+		}
+	}
+
+	return invalid
+}
+
+// IsValid reports whether every signature attached to the transaction is valid.
+func (verification SignatureVerification) IsValid() bool {
+	return len(verification.GetInvalid()) == 0
+}
+
+// EvaluateKey reports whether the keys in SignedKeys satisfy key. See EvaluateKey.
+func (verification SignatureVerification) EvaluateKey(key Key, authorizedContracts ...ContractID) KeyEvaluation {
+	return EvaluateKey(key, verification.SignedKeys, authorizedContracts...)
+}
+
+// VerifySignatures verifies every signature pair attached to the transaction against the signed body of its node and
+// chunk. The transaction must be frozen. Verification does not modify the transaction: the Signers added with Sign,
+// SignWith, SignWithSigner or SignWithOperator are not called, so their signatures are only verified once the
+// transaction has been serialized, e.g. with ToBytes, or executed.
+func (tx *Transaction[T]) VerifySignatures() (SignatureVerification, error) {
+	if !tx.IsFrozen() {
+		return SignatureVerification{}, errTransactionIsNotFrozen
+	}
+
+	verification := SignatureVerification{
+		Signatures:  make([]VerifiedSignature, 0),
+		SignedKeys:  make([]PublicKey, 0),
+		PartialKeys: make([]PublicKey, 0),
+	}
+
+	chunks := make(map[string]int)
+	validCounts := make(map[string]int)
+	keys := make([]PublicKey, 0)
+	for _, signedTransaction := range tx.signedTransactions.slice {
+		signed := signedTransaction.(*services.SignedTransaction)
+		var body services.TransactionBody
+		if err := protobuf.Unmarshal(signed.GetBodyBytes(), &body); err != nil {
+			return SignatureVerification{}, err
+		}
+
+		nodeAccountID := AccountID{}
+		if body.NodeAccountID != nil {
+			nodeAccountID = *_AccountIDFromProtobuf(body.NodeAccountID)
+		}
+		transactionID := _TransactionIDFromProtobuf(body.TransactionID)
+
+		chunk, ok := chunks[transactionID.String()]
+		if !ok {
+			chunk = len(chunks)
+			chunks[transactionID.String()] = chunk
+		}
+
+		validOnBody := make(map[string]bool)
+		for _, sigPair := range signed.GetSigMap().GetSigPair() {
+			verified := _VerifySignaturePair(sigPair, signed.GetBodyBytes())
+			verified.NodeAccountID = nodeAccountID
+			verified.TransactionID = transactionID
+			verified.Chunk = chunk
+			verification.Signatures = append(verification.Signatures, verified)
+
+			if verified.Status != SignatureStatusValid || validOnBody[verified.PublicKey.String()] {
+				continue
+			}
+
+			validOnBody[verified.PublicKey.String()] = true
+			if validCounts[verified.PublicKey.String()] == 0 {
+				keys = append(keys, *verified.PublicKey)
+			}
+			validCounts[verified.PublicKey.String()]++
+		}
+	}
+
+	for _, key := range keys {
+		if validCounts[key.String()] == tx.signedTransactions._Length() {
+			verification.SignedKeys = append(verification.SignedKeys, key)
+		} else {
+			verification.PartialKeys = append(verification.PartialKeys, key)
+		}
+	}
+
+	return verification, nil
+}
+
+func _VerifySignaturePair(sigPair *services.SignaturePair, bodyBytes []byte) VerifiedSignature {
+	verified := VerifiedSignature{PubKeyPrefix: sigPair.GetPubKeyPrefix()}
+
+	var signature []byte
+	var parse func([]byte) (PublicKey, error)
+	var other func([]byte) (PublicKey, error)
+	switch sigPair.GetSignature().(type) {
+	case *services.SignaturePair_Ed25519:
+		signature, parse, other = sigPair.GetEd25519(), PublicKeyFromBytesEd25519, PublicKeyFromBytesECDSA
+	case *services.SignaturePair_ECDSASecp256K1:
+		signature, parse, other = sigPair.GetECDSASecp256K1(), PublicKeyFromBytesECDSA, PublicKeyFromBytesEd25519
+	default:
+		verified.Status = SignatureStatusUnsupported
+		return verified
+	}
+
+	key, err := parse(sigPair.GetPubKeyPrefix())
+	if err != nil {
+		if _, err := other(sigPair.GetPubKeyPrefix()); err == nil {
+			verified.Status = SignatureStatusKeyTypeMismatch
+		} else {
+			verified.Status = SignatureStatusUnknownKey
+		}
+		return verified
+	}
+
+	verified.PublicKey = &key
+	if key.VerifySignedMessage(bodyBytes, signature) {
+		verified.Status = SignatureStatusValid
+	} else {
+		verified.Status = SignatureStatusInvalid
+	}
+
+	return verified
+}
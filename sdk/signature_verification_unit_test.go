@@ -0,0 +1,209 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitVerifySignaturesDeserializedTransaction(t *testing.T) {
+	t.Parallel()
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	ecdsaKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 6}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+	tx.Sign(ed25519Key).Sign(ecdsaKey)
+
+	data, err := tx.ToBytes()
+	require.NoError(t, err)
+	restored, err := TransactionFromBytes(data)
+	require.NoError(t, err)
+
+	verification, err := TransactionVerifySignatures(restored)
+	require.NoError(t, err)
+	assert.True(t, verification.IsValid())
+	require.Len(t, verification.Signatures, 4)
+	assert.Equal(t, AccountID{Account: 3}, verification.Signatures[0].NodeAccountID)
+	assert.Equal(t, AccountID{Account: 4}, verification.Signatures[3].NodeAccountID)
+	assert.Equal(t, []PublicKey{ed25519Key.PublicKey(), ecdsaKey.PublicKey()}, verification.SignedKeys)
+	assert.Empty(t, verification.PartialKeys)
+
+	signatures, err := TransactionGetSignatures(restored)
+	require.NoError(t, err)
+	assert.Len(t, signatures[AccountID{Account: 3}], 2)
+}
+
+func TestUnitVerifySignaturesReportsInvalidSignatures(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	ecdsaKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 6}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	signables, err := tx.GetSignableNodeBodyBytesList()
+	require.NoError(t, err)
+	// a valid signature for the first node and a signature of the wrong body for the second
+	_, err = tx.AddSignatureV2(key.PublicKey(), key.Sign(signables[0].Body), signables[0].TransactionID, signables[0].NodeID)
+	require.NoError(t, err)
+	_, err = tx.AddSignatureV2(key.PublicKey(), key.Sign(signables[0].Body), signables[1].TransactionID, signables[1].NodeID)
+	require.NoError(t, err)
+
+	// an ED25519 signature paired with an ECDSA key, a truncated prefix and a contract signature
+	sigMap := tx.signedTransactions._Get(0).(*services.SignedTransaction).SigMap
+	sigMap.SigPair = append(sigMap.SigPair,
+		&services.SignaturePair{
+			PubKeyPrefix: ecdsaKey.PublicKey().BytesRaw(),
+			Signature:    &services.SignaturePair_Ed25519{Ed25519: key.Sign(signables[0].Body)},
+		},
+		&services.SignaturePair{
+			PubKeyPrefix: key.PublicKey().BytesRaw()[:6],
+			Signature:    &services.SignaturePair_Ed25519{Ed25519: key.Sign(signables[0].Body)},
+		},
+		&services.SignaturePair{
+			PubKeyPrefix: key.PublicKey().BytesRaw(),
+			Signature:    &services.SignaturePair_Contract{Contract: []byte{1}},
+		},
+	)
+
+	verification, err := tx.VerifySignatures()
+	require.NoError(t, err)
+	assert.False(t, verification.IsValid())
+	assert.Empty(t, verification.SignedKeys)
+	assert.Equal(t, []PublicKey{key.PublicKey()}, verification.PartialKeys)
+
+	invalid := verification.GetInvalid()
+	require.Len(t, invalid, 4)
+	assert.Equal(t, SignatureStatusKeyTypeMismatch, invalid[0].Status)
+	assert.Nil(t, invalid[0].PublicKey)
+	assert.Equal(t, SignatureStatusUnknownKey, invalid[1].Status)
+	assert.Equal(t, SignatureStatusUnsupported, invalid[2].Status)
+	assert.Equal(t, SignatureStatusInvalid, invalid[3].Status)
+	assert.Equal(t, AccountID{Account: 4}, invalid[3].NodeAccountID)
+	assert.Equal(t, "INVALID", invalid[3].Status.String())
+}
+
+func TestUnitVerifySignaturesChunkedTransaction(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	tx, err := NewFileAppendTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		SetFileID(FileID{File: 7}).
+		SetContents(make([]byte, 2500)).
+		SetMaxChunkSize(1024).
+		FreezeWith(nil)
+	require.NoError(t, err)
+	tx.Sign(key)
+	// the signature is attached when the transaction is serialized
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+
+	verification, err := tx.VerifySignatures()
+	require.NoError(t, err)
+	require.Len(t, verification.Signatures, 6)
+
+	// every chunk is signed for both nodes
+	nodes := make(map[int][]AccountID)
+	for _, signature := range verification.Signatures {
+		assert.Equal(t, SignatureStatusValid, signature.Status)
+		nodes[signature.Chunk] = append(nodes[signature.Chunk], signature.NodeAccountID)
+	}
+	require.Len(t, nodes, 3)
+	for _, chunkNodes := range nodes {
+		assert.ElementsMatch(t, []AccountID{{Account: 3}, {Account: 4}}, chunkNodes)
+	}
+	assert.Equal(t, []PublicKey{key.PublicKey()}, verification.SignedKeys)
+	assert.True(t, verification.EvaluateKey(key.PublicKey()).Satisfied)
+}
+
+func TestUnitVerifySignaturesDoesNotSign(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	signer := &_TestSigner{key: key}
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+
+	// the transaction ID is generated from the operator, so it may be regenerated when it expires
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 6}, NewHbar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	tx.SignWithSigner(signer)
+	require.False(t, tx.transactionIDs.locked)
+
+	verification, err := tx.VerifySignatures()
+	require.NoError(t, err)
+	assert.Empty(t, verification.Signatures)
+	assert.Equal(t, 0, signer.signCalls)
+	assert.False(t, tx.transactionIDs.locked)
+}
+
+func TestUnitVerifySignaturesRequiresFrozenTransaction(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTransferTransaction().VerifySignatures()
+	require.ErrorIs(t, err, errTransactionIsNotFrozen)
+}
+
+func TestUnitEvaluateKeyNestedKeyListAndContracts(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]PublicKey, 3)
+	for i := range keys {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys[i] = key.PublicKey()
+	}
+	contractID := ContractID{Contract: 1001}
+
+	// 2 of [key0, 1 of [key1, contract], delegatable contract]
+	key := KeyListWithThreshold(2).
+		Add(keys[0]).
+		Add(KeyListWithThreshold(1).Add(keys[1]).Add(contractID)).
+		Add(DelegatableContractID{Contract: 1002})
+
+	evaluation := EvaluateKey(key, []PublicKey{keys[2]})
+	assert.False(t, evaluation.Satisfied)
+	assert.Equal(t, []PublicKey{keys[0], keys[1]}, evaluation.MissingKeys)
+	assert.Equal(t, []ContractID{contractID, {Contract: 1002}}, evaluation.MissingContracts)
+
+	assert.True(t, EvaluateKey(key, []PublicKey{keys[0], keys[1]}).Satisfied)
+	assert.True(t, EvaluateKey(key, []PublicKey{keys[0]}, contractID).Satisfied)
+	assert.True(t, EvaluateKey(key, nil, ContractID{Contract: 1001}, ContractID{Contract: 1002}).Satisfied)
+	assert.False(t, EvaluateKey(key, nil, contractID).Satisfied)
+
+	// without a threshold every key is required and an empty list is never satisfied
+	assert.False(t, EvaluateKey(NewKeyList().Add(keys[0]).Add(keys[1]), []PublicKey{keys[0]}).Satisfied)
+	assert.False(t, EvaluateKey(NewKeyList(), keys).Satisfied)
+}
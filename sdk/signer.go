@@ -0,0 +1,149 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// SignerKeyType is the signature algorithm a Signer produces signatures for.
+type SignerKeyType int
+
+const (
+	SignerKeyTypeEd25519 SignerKeyType = iota
+	SignerKeyTypeEcdsaSecp256k1
+)
+
+func (keyType SignerKeyType) String() string {
+	switch keyType {
+	case SignerKeyTypeEd25519:
+		return "ED25519"
+	case SignerKeyTypeEcdsaSecp256k1:
+		return "ECDSA_SECP256K1"
+	default:
+		return fmt.Sprintf("SignerKeyType(%d)", int(keyType))
+	}
+}
+
+// Signer produces signatures for transaction and query payment body bytes. Implementations may keep the private key
+// outside of the process, e.g. in an HSM, a cloud KMS or a remote signing service, so signing can fail or be
+// cancelled through ctx. A Signer can be used anywhere a PrivateKey or TransactionSigner is accepted:
+// Client.SetOperatorWithSigner, Transaction.SignWithSigner and TokenRejectFlow.SignWithSigner.
+type Signer interface {
+	// PublicKey returns the public key matching the signatures produced by Sign.
+	PublicKey() PublicKey
+	// KeyType returns the signature algorithm of the key.
+	KeyType() SignerKeyType
+	// Sign returns the signature of message.
+	Sign(ctx context.Context, message []byte) ([]byte, error)
+}
+
+// BatchSigner is optionally implemented by a Signer which can sign several messages in a single round trip.
+// It is used when a transaction is built for several nodes or transaction IDs at once, e.g. by ToBytes.
+// The returned signatures must be in the same order as messages.
+type BatchSigner interface {
+	Signer
+	SignBatch(ctx context.Context, messages [][]byte) ([][]byte, error)
+}
+
+type _PrivateKeySigner struct {
+	privateKey PrivateKey
+}
+
+// NewPrivateKeySigner returns a Signer which signs with a PrivateKey held in memory.
+func NewPrivateKeySigner(privateKey PrivateKey) Signer {
+	return &_PrivateKeySigner{
+		privateKey: privateKey,
+	}
+}
+
+func (signer *_PrivateKeySigner) PublicKey() PublicKey {
+	return signer.privateKey.PublicKey()
+}
+
+func (signer *_PrivateKeySigner) KeyType() SignerKeyType {
+	return _SignerKeyTypeOf(signer.PublicKey())
+}
+
+func (signer *_PrivateKeySigner) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	if ctx != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	return signer.privateKey.Sign(message), nil
+}
+
+type _TransactionSignerAdapter struct {
+	publicKey PublicKey
+	signer    TransactionSigner
+}
+
+// NewSignerFromTransactionSigner wraps a TransactionSigner callback and the public key it signs for into a Signer.
+func NewSignerFromTransactionSigner(publicKey PublicKey, signer TransactionSigner) Signer {
+	return &_TransactionSignerAdapter{
+		publicKey: publicKey,
+		signer:    signer,
+	}
+}
+
+func (signer *_TransactionSignerAdapter) PublicKey() PublicKey {
+	return signer.publicKey
+}
+
+func (signer *_TransactionSignerAdapter) KeyType() SignerKeyType {
+	return _SignerKeyTypeOf(signer.publicKey)
+}
+
+func (signer *_TransactionSignerAdapter) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	if ctx != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	return signer.signer(message), nil
+}
+
+func _SignerKeyTypeOf(publicKey PublicKey) SignerKeyType {
+	if publicKey.ecdsaPublicKey != nil {
+		return SignerKeyTypeEcdsaSecp256k1
+	}
+
+	return SignerKeyTypeEd25519
+}
+
+// _SignerSignAll signs every message, using SignBatch when the signer supports it.
+func _SignerSignAll(ctx context.Context, signer Signer, messages [][]byte) ([][]byte, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if batchSigner, ok := signer.(BatchSigner); ok && len(messages) > 1 {
+		signatures, err := batchSigner.SignBatch(ctx, messages)
+		if err != nil {
+			return nil, ErrSigningFailed{PublicKey: signer.PublicKey(), Err: err}
+		}
+
+		if len(signatures) != len(messages) {
+			return nil, ErrSigningFailed{
+				PublicKey: signer.PublicKey(),
+				Err:       errors.Errorf("expected %d signatures from SignBatch, got %d", len(messages), len(signatures)),
+			}
+		}
+
+		return signatures, nil
+	}
+
+	signatures := make([][]byte, 0, len(messages))
+	for _, message := range messages {
+		signature, err := signer.Sign(ctx, message)
+		if err != nil {
+			return nil, ErrSigningFailed{PublicKey: signer.PublicKey(), Err: err}
+		}
+
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, nil
+}
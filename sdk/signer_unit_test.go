@@ -0,0 +1,213 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type _TestSigner struct {
+	key        PrivateKey
+	err        error
+	signCalls  int
+	batchCalls int
+	batchSizes []int
+}
+
+func (s *_TestSigner) PublicKey() PublicKey {
+	return s.key.PublicKey()
+}
+
+func (s *_TestSigner) KeyType() SignerKeyType {
+	return _SignerKeyTypeOf(s.key.PublicKey())
+}
+
+func (s *_TestSigner) Sign(_ context.Context, message []byte) ([]byte, error) {
+	s.signCalls++
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return s.key.Sign(message), nil
+}
+
+type _TestBatchSigner struct {
+	_TestSigner
+}
+
+func (s *_TestBatchSigner) SignBatch(_ context.Context, messages [][]byte) ([][]byte, error) {
+	s.batchCalls++
+	s.batchSizes = append(s.batchSizes, len(messages))
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	signatures := make([][]byte, len(messages))
+	for i, message := range messages {
+		signatures[i] = s.key.Sign(message)
+	}
+
+	return signatures, nil
+}
+
+func _NewFrozenSignerTestTransaction(t *testing.T, nodeAccountIDs []AccountID) *TransferTransaction {
+	transactionID := TransactionIDGenerate(AccountID{Account: 324})
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs(nodeAccountIDs).
+		SetTransactionID(transactionID).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	return tx
+}
+
+func TestUnitSignerKeyType(t *testing.T) {
+	t.Parallel()
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	ecdsaKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	assert.Equal(t, SignerKeyTypeEd25519, NewPrivateKeySigner(ed25519Key).KeyType())
+	assert.Equal(t, SignerKeyTypeEcdsaSecp256k1, NewPrivateKeySigner(ecdsaKey).KeyType())
+	assert.Equal(t, SignerKeyTypeEcdsaSecp256k1, NewSignerFromTransactionSigner(ecdsaKey.PublicKey(), ecdsaKey.Sign).KeyType())
+	assert.Equal(t, "ECDSA_SECP256K1", SignerKeyTypeEcdsaSecp256k1.String())
+}
+
+func TestUnitTransactionSignWithSigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	tx := _NewFrozenSignerTestTransaction(t, []AccountID{{Account: 3}})
+	tx.SignWithSigner(&_TestSigner{key: key})
+
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+
+	signatures, err := tx.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures[AccountID{Account: 3}], 1)
+
+	for publicKey, signature := range signatures[AccountID{Account: 3}] {
+		assert.Equal(t, key.PublicKey().String(), publicKey.String())
+		assert.True(t, key.PublicKey().Verify(tx.GetSignedTransactionBodyBytes(0), signature))
+	}
+}
+
+func TestUnitTransactionAlreadySignedDoesNotCallSigner(t *testing.T) {
+	t.Parallel()
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	ecdsaKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	signer := &_TestSigner{key: ed25519Key}
+
+	tx := _NewFrozenSignerTestTransaction(t, []AccountID{{Account: 3}})
+	tx.SignWithSigner(signer)
+
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, 1, signer.signCalls)
+
+	tx.SignWithSigner(&_TestSigner{key: ecdsaKey})
+
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, 1, signer.signCalls)
+
+	signatures, err := tx.GetSignatures()
+	require.NoError(t, err)
+	for publicKey, signature := range signatures[AccountID{Account: 3}] {
+		assert.True(t, publicKey.VerifySignedMessage(tx.GetSignedTransactionBodyBytes(0), signature))
+	}
+}
+
+func TestUnitTransactionSignerErrorReturnedFromToBytes(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	errHsm := errors.New("hsm unavailable")
+
+	tx := _NewFrozenSignerTestTransaction(t, []AccountID{{Account: 3}})
+	tx.SignWithSigner(&_TestSigner{key: key, err: errHsm})
+
+	_, err = tx.ToBytes()
+	require.ErrorIs(t, err, errHsm)
+
+	var signingErr ErrSigningFailed
+	require.ErrorAs(t, err, &signingErr)
+	assert.Equal(t, key.PublicKey().String(), signingErr.PublicKey.String())
+}
+
+func TestUnitTransactionBatchSignerSignsAllNodesAtOnce(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	signer := &_TestBatchSigner{_TestSigner{key: key}}
+
+	tx := _NewFrozenSignerTestTransaction(t, []AccountID{{Account: 3}, {Account: 4}, {Account: 5}})
+	tx.SignWithSigner(signer)
+
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, signer.batchCalls)
+	assert.Equal(t, []int{3}, signer.batchSizes)
+	assert.Equal(t, 0, signer.signCalls)
+
+	for i := 0; i < 3; i++ {
+		signedTx := tx.signedTransactions._Get(i).(*services.SignedTransaction)
+		require.Len(t, signedTx.SigMap.SigPair, 1)
+		assert.True(t, key.PublicKey().Verify(signedTx.BodyBytes, signedTx.SigMap.SigPair[0].GetEd25519()))
+	}
+}
+
+func TestUnitTransactionOperatorSignerErrorReturnedFromExecute(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	errKms := errors.New("kms denied")
+	client.SetOperatorWithSigner(AccountID{Account: 2}, &_TestSigner{key: key, err: errKms})
+
+	_, err = NewFileCreateTransaction().
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.ErrorIs(t, err, errKms)
+}
+
+func TestUnitQueryOperatorSignerErrorReturnedFromExecute(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	errKms := errors.New("kms denied")
+	client.SetOperatorWithSigner(AccountID{Account: 2}, &_TestSigner{key: key, err: errKms})
+
+	_, err = NewAccountInfoQuery().
+		SetAccountID(AccountID{Account: 5}).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetQueryPayment(NewHbar(1)).
+		Execute(client)
+	require.ErrorIs(t, err, errKms)
+}
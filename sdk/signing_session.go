@@ -0,0 +1,442 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const signingBundleVersion = 1
+
+// SigningSession coordinates collecting signatures for a frozen transaction from several offline parties.
+// The coordinator exports a SigningBundle, every party signs it with SigningBundle.Sign and returns the resulting
+// SigningBundleSignatures, which are verified against the transaction body before being merged back with
+// AddSignatures. The session reports which keys of the required key structure still have to sign.
+type SigningSession struct {
+	transaction TransactionInterface
+	requiredKey Key
+}
+
+// SigningBundle is a self-describing, JSON serializable description of everything an offline party needs to sign a
+// transaction: the body bytes for every node and chunk, the keys required to sign, the memo and the expiry. The
+// required keys are empty when the session had no required key when the bundle was exported.
+type SigningBundle struct {
+	Version            int                 `json:"version"`
+	TransactionID      string              `json:"transactionId"`
+	TransactionType    string              `json:"transactionType"`
+	Memo               string              `json:"memo"`
+	ValidStart         time.Time           `json:"validStart"`
+	Expiry             time.Time           `json:"expiry"`
+	RequiredKey        []byte              `json:"requiredKey,omitempty"`
+	RequiredKeyString  string              `json:"requiredKeyString,omitempty"`
+	RequiredPublicKeys []string            `json:"requiredPublicKeys"`
+	Transaction        []byte              `json:"transaction"`
+	Bodies             []SigningBundleBody `json:"bodies"`
+}
+
+// SigningBundleBody is the body bytes of the transaction sent to one node for one chunk.
+type SigningBundleBody struct {
+	NodeAccountID string `json:"nodeAccountId"`
+	TransactionID string `json:"transactionId"`
+	Chunk         int    `json:"chunk"`
+	BodyBytes     []byte `json:"bodyBytes"`
+}
+
+// SigningBundleSignatures holds the signatures one key produced for the bodies of a SigningBundle.
+type SigningBundleSignatures struct {
+	Version       int                      `json:"version"`
+	TransactionID string                   `json:"transactionId"`
+	PublicKey     string                   `json:"publicKey"`
+	Signatures    []SigningBundleSignature `json:"signatures"`
+}
+
+// SigningBundleSignature is the signature of the body sent to one node for one chunk.
+type SigningBundleSignature struct {
+	NodeAccountID string `json:"nodeAccountId"`
+	TransactionID string `json:"transactionId"`
+	Signature     []byte `json:"signature"`
+}
+
+// SigningSessionStatus describes how far a SigningSession is from satisfying its required key.
+type SigningSessionStatus struct {
+	// Satisfied is true when the attached signatures satisfy the required key.
+	Satisfied bool
+	// SignedKeys are the keys which signed the body for every node and chunk.
+	SignedKeys []PublicKey
+	// MissingKeys are the keys in unsatisfied parts of the required key structure which have not signed yet.
+	// Any subset of them which satisfies the thresholds is enough.
+	MissingKeys []PublicKey
+}
+
+// ErrInvalidBundleSignature is returned by SigningSession.AddSignatures when a signature does not verify against
+// the transaction body it claims to sign.
+type ErrInvalidBundleSignature struct {
+	PublicKey     PublicKey
+	NodeAccountID AccountID
+	TransactionID TransactionID
+}
+
+// Error() implements the Error interface
+func (e ErrInvalidBundleSignature) Error() string {
+	return fmt.Sprintf("invalid signature by %s for transaction %s on node %s", e.PublicKey.String(), e.TransactionID.String(), e.NodeAccountID.String())
+}
+
+var errSigningSessionKeyNotSet = errors.New("required key is not set on the signing session")
+var errSigningBundleVersion = errors.New("unsupported signing bundle version")
+
+// NewSigningSession creates a signing session for a frozen transaction.
+func NewSigningSession(transaction TransactionInterface) (*SigningSession, error) {
+	if transaction == nil || !transaction.getBaseTransaction().IsFrozen() {
+		return nil, errTransactionIsNotFrozen
+	}
+
+	return &SigningSession{
+		transaction: transaction,
+	}, nil
+}
+
+// SigningSessionFromBundle recreates a signing session from a bundle, e.g. after the coordinator restarted. The
+// signatures already attached to the transaction of the bundle are only counted by GetStatus when they verify.
+// The session has no required key unless the bundle carries one, see ExportBundle.
+func SigningSessionFromBundle(bundle *SigningBundle) (*SigningSession, error) {
+	if bundle.Version != signingBundleVersion {
+		return nil, errSigningBundleVersion
+	}
+
+	transaction, err := TransactionFromBytes(bundle.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := NewSigningSession(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bundle.RequiredKey) > 0 {
+		requiredKey, err := KeyFromBytes(bundle.RequiredKey)
+		if err != nil {
+			return nil, err
+		}
+		session.requiredKey = requiredKey
+	}
+
+	return session, nil
+}
+
+// SetRequiredKey sets the key structure, e.g. a threshold KeyList, whose signatures the session collects.
+func (session *SigningSession) SetRequiredKey(key Key) *SigningSession {
+	session.requiredKey = key
+	return session
+}
+
+// ResolveRequiredKey sets the required key to every key RequiredSigners reports for the transaction of the session.
+func (session *SigningSession) ResolveRequiredKey(ctx context.Context, resolver KeyResolver) error {
+	requirements, err := RequiredSignersWithContext(ctx, session.transaction, resolver)
+	if err != nil {
+		return err
+	}
+
+	requiredKey := NewKeyList()
+	for _, requirement := range requirements.Requirements {
+		if requirement.Err != nil {
+			return requirement.Err
+		}
+		requiredKey.Add(requirement.Key)
+	}
+
+	session.requiredKey = requiredKey
+	return nil
+}
+
+// GetRequiredKey returns the key structure whose signatures the session collects.
+func (session *SigningSession) GetRequiredKey() Key {
+	return session.requiredKey
+}
+
+// GetTransaction returns the transaction with every signature merged so far.
+func (session *SigningSession) GetTransaction() TransactionInterface {
+	return session.transaction
+}
+
+// ExportBundle returns the bundle to hand to the offline signers. The required keys of the bundle are not derived
+// from the transaction by ExportBundle itself: they are the required key of the session, so SetRequiredKey or
+// ResolveRequiredKey has to be called before exporting, otherwise the bundle carries no required keys.
+func (session *SigningSession) ExportBundle() (*SigningBundle, error) {
+	baseTx := session.transaction.getBaseTransaction()
+
+	// serializing may rewrite the bodies of chunked transactions, so the bodies are read afterwards
+	transactionBytes, err := baseTx.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	signables, err := baseTx.GetSignableNodeBodyBytesList()
+	if err != nil {
+		return nil, err
+	}
+
+	firstTransactionID := baseTx.transactionIDs._Get(0).(TransactionID)
+	validStart := time.Time{}
+	if firstTransactionID.ValidStart != nil {
+		validStart = *firstTransactionID.ValidStart
+	}
+
+	bundle := SigningBundle{
+		Version:            signingBundleVersion,
+		TransactionID:      firstTransactionID.String(),
+		TransactionType:    session.transaction.getName(),
+		Memo:               baseTx.GetTransactionMemo(),
+		ValidStart:         validStart,
+		Expiry:             validStart.Add(baseTx.GetTransactionValidDuration()),
+		RequiredPublicKeys: make([]string, 0),
+		Transaction:        transactionBytes,
+		Bodies:             make([]SigningBundleBody, 0, len(signables)),
+	}
+
+	if session.requiredKey != nil {
+		bundle.RequiredKey, err = protobuf.Marshal(session.requiredKey._ToProtoKey())
+		if err != nil {
+			return nil, err
+		}
+		bundle.RequiredKeyString = session.requiredKey.String()
+		for _, key := range _KeyPublicKeys(session.requiredKey) {
+			bundle.RequiredPublicKeys = append(bundle.RequiredPublicKeys, key.String())
+		}
+	}
+
+	chunks := make(map[string]int)
+	for _, signable := range signables {
+		transactionID := signable.TransactionID.String()
+		chunk, ok := chunks[transactionID]
+		if !ok {
+			chunk = len(chunks)
+			chunks[transactionID] = chunk
+		}
+
+		bundle.Bodies = append(bundle.Bodies, SigningBundleBody{
+			NodeAccountID: signable.NodeID.String(),
+			TransactionID: transactionID,
+			Chunk:         chunk,
+			BodyBytes:     signable.Body,
+		})
+	}
+
+	return &bundle, nil
+}
+
+// AddSignatures verifies every signature of a party against the transaction body it signs and merges them into the
+// transaction. Nothing is merged unless every signature is valid and every body of the transaction is signed.
+func (session *SigningSession) AddSignatures(signatures *SigningBundleSignatures) error {
+	publicKey, err := PublicKeyFromString(signatures.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	baseTx := session.transaction.getBaseTransaction()
+	signables, err := baseTx.GetSignableNodeBodyBytesList()
+	if err != nil {
+		return err
+	}
+
+	bodies := make(map[string]SignableNodeTransactionBodyBytes, len(signables))
+	for _, signable := range signables {
+		bodies[signable.TransactionID.String()+"@"+signable.NodeID.String()] = signable
+	}
+
+	signed := make(map[string][]byte, len(signatures.Signatures))
+	for _, signature := range signatures.Signatures {
+		id := signature.TransactionID + "@" + signature.NodeAccountID
+		signable, ok := bodies[id]
+		if !ok {
+			return fmt.Errorf("signature for transaction %s on node %s does not match any transaction body", signature.TransactionID, signature.NodeAccountID)
+		}
+
+		if !publicKey.VerifySignedMessage(signable.Body, signature.Signature) {
+			return ErrInvalidBundleSignature{
+				PublicKey:     publicKey,
+				NodeAccountID: signable.NodeID,
+				TransactionID: signable.TransactionID,
+			}
+		}
+
+		signed[id] = signature.Signature
+	}
+
+	if len(signed) != len(bodies) {
+		return fmt.Errorf("signatures by %s cover %d of %d transaction bodies", publicKey.String(), len(signed), len(bodies))
+	}
+
+	for id, signature := range signed {
+		signable := bodies[id]
+		if _, err := baseTx.AddSignatureV2(publicKey, signature, signable.TransactionID, signable.NodeID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetStatus reports which keys signed and which keys of the required key are still missing. Only signatures which
+// verify against the body of their node and chunk are counted.
+func (session *SigningSession) GetStatus() (SigningSessionStatus, error) {
+	if session.requiredKey == nil {
+		return SigningSessionStatus{}, errSigningSessionKeyNotSet
+	}
+
+	signedKeys := _SignedPublicKeys(session.transaction.getBaseTransaction())
+	signed := make(map[string]bool, len(signedKeys))
+	for _, key := range signedKeys {
+		signed[key.String()] = true
+	}
+
+	evaluation := _EvaluateKey(session.requiredKey, signed, nil)
+
+	return SigningSessionStatus{
+		Satisfied:   evaluation.Satisfied,
+		SignedKeys:  signedKeys,
+		MissingKeys: evaluation.MissingKeys,
+	}, nil
+}
+
+// ToBytes serializes the bundle as JSON.
+func (bundle *SigningBundle) ToBytes() ([]byte, error) {
+	return json.Marshal(bundle)
+}
+
+// SigningBundleFromBytes deserializes a bundle produced by SigningBundle.ToBytes.
+func SigningBundleFromBytes(data []byte) (*SigningBundle, error) {
+	var bundle SigningBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, err
+	}
+
+	if bundle.Version != signingBundleVersion {
+		return nil, errSigningBundleVersion
+	}
+
+	return &bundle, nil
+}
+
+// GetTransaction deserializes the transaction described by the bundle, so a signer can inspect it before signing.
+func (bundle *SigningBundle) GetTransaction() (TransactionInterface, error) {
+	return TransactionFromBytes(bundle.Transaction)
+}
+
+// Sign signs every body of the bundle with signer and returns the signatures to send back to the coordinator.
+func (bundle *SigningBundle) Sign(ctx context.Context, signer Signer) (*SigningBundleSignatures, error) {
+	messages := make([][]byte, len(bundle.Bodies))
+	for i, body := range bundle.Bodies {
+		messages[i] = body.BodyBytes
+	}
+
+	signatures, err := _SignerSignAll(ctx, signer, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	result := SigningBundleSignatures{
+		Version:       signingBundleVersion,
+		TransactionID: bundle.TransactionID,
+		PublicKey:     signer.PublicKey().String(),
+		Signatures:    make([]SigningBundleSignature, len(bundle.Bodies)),
+	}
+	for i, body := range bundle.Bodies {
+		result.Signatures[i] = SigningBundleSignature{
+			NodeAccountID: body.NodeAccountID,
+			TransactionID: body.TransactionID,
+			Signature:     signatures[i],
+		}
+	}
+
+	return &result, nil
+}
+
+// ToBytes serializes the signatures as JSON.
+func (signatures *SigningBundleSignatures) ToBytes() ([]byte, error) {
+	return json.Marshal(signatures)
+}
+
+// SigningBundleSignaturesFromBytes deserializes signatures produced by SigningBundleSignatures.ToBytes.
+func SigningBundleSignaturesFromBytes(data []byte) (*SigningBundleSignatures, error) {
+	var signatures SigningBundleSignatures
+	if err := json.Unmarshal(data, &signatures); err != nil {
+		return nil, err
+	}
+
+	if signatures.Version != signingBundleVersion {
+		return nil, errSigningBundleVersion
+	}
+
+	return &signatures, nil
+}
+
+// _SignedPublicKeys returns the keys with a valid signature in the signature map of every signed transaction. The
+// signatures are verified against the body they are attached to, so forged or stale signature pairs, e.g. in a
+// bundle received from another party, do not count.
+func _SignedPublicKeys(tx *Transaction[TransactionInterface]) []PublicKey {
+	counts := make(map[string]int)
+	keys := make([]PublicKey, 0)
+
+	for _, signedTransaction := range tx.signedTransactions.slice {
+		signed := signedTransaction.(*services.SignedTransaction)
+		seen := make(map[string]bool)
+		for _, sigPair := range signed.GetSigMap().GetSigPair() {
+			verified := _VerifySignaturePair(sigPair, signed.GetBodyBytes())
+			if verified.Status != SignatureStatusValid {
+				continue
+			}
+
+			id := verified.PublicKey.String()
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			if counts[id] == 0 {
+				keys = append(keys, *verified.PublicKey)
+			}
+			counts[id]++
+		}
+	}
+
+	signed := make([]PublicKey, 0, len(keys))
+	for _, key := range keys {
+		if counts[key.String()] == len(tx.signedTransactions.slice) {
+			signed = append(signed, key)
+		}
+	}
+
+	return signed
+}
+
+// _KeyPublicKeys returns every public key in a key structure, depth first.
+func _KeyPublicKeys(key Key) []PublicKey {
+	switch k := key.(type) {
+	case PublicKey:
+		return []PublicKey{k}
+	case *PublicKey:
+		return []PublicKey{*k}
+	case PrivateKey:
+		return []PublicKey{k.PublicKey()}
+	case *PrivateKey:
+		return []PublicKey{k.PublicKey()}
+	case KeyList:
+		return _KeyPublicKeys(&k)
+	case *KeyList:
+		keys := make([]PublicKey, 0)
+		for _, child := range k.keys {
+			keys = append(keys, _KeyPublicKeys(child)...)
+		}
+		return keys
+	default:
+		return []PublicKey{}
+	}
+}
@@ -0,0 +1,247 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewSigningSessionTestKeys(t *testing.T) (PrivateKey, PrivateKey, PrivateKey) {
+	alice, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	bob, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	carol, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	return alice, bob, carol
+}
+
+func _ExchangeSigningBundle(t *testing.T, session *SigningSession, key PrivateKey) *SigningBundleSignatures {
+	bundle, err := session.ExportBundle()
+	require.NoError(t, err)
+	data, err := bundle.ToBytes()
+	require.NoError(t, err)
+
+	received, err := SigningBundleFromBytes(data)
+	require.NoError(t, err)
+	signatures, err := received.Sign(context.Background(), NewPrivateKeySigner(key))
+	require.NoError(t, err)
+	data, err = signatures.ToBytes()
+	require.NoError(t, err)
+
+	returned, err := SigningBundleSignaturesFromBytes(data)
+	require.NoError(t, err)
+
+	return returned
+}
+
+func TestUnitSigningSessionThresholdKeyList(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, carol := _NewSigningSessionTestKeys(t)
+	requiredKey := KeyListWithThreshold(2).
+		Add(alice.PublicKey()).
+		Add(bob.PublicKey()).
+		Add(carol.PublicKey())
+
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		SetTransactionMemo("multi-party").
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 6}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	session, err := NewSigningSession(tx)
+	require.NoError(t, err)
+	session.SetRequiredKey(requiredKey)
+
+	bundle, err := session.ExportBundle()
+	require.NoError(t, err)
+	assert.Equal(t, "multi-party", bundle.Memo)
+	assert.Equal(t, "TransferTransaction", bundle.TransactionType)
+	assert.Equal(t, tx.GetTransactionID().String(), bundle.TransactionID)
+	assert.Equal(t, bundle.ValidStart.Add(tx.GetTransactionValidDuration()), bundle.Expiry)
+	assert.Len(t, bundle.Bodies, 2)
+	assert.Equal(t, []string{alice.PublicKey().String(), bob.PublicKey().String(), carol.PublicKey().String()}, bundle.RequiredPublicKeys)
+
+	status, err := session.GetStatus()
+	require.NoError(t, err)
+	assert.False(t, status.Satisfied)
+	assert.Len(t, status.MissingKeys, 3)
+
+	require.NoError(t, session.AddSignatures(_ExchangeSigningBundle(t, session, alice)))
+
+	status, err = session.GetStatus()
+	require.NoError(t, err)
+	assert.False(t, status.Satisfied)
+	require.Len(t, status.SignedKeys, 1)
+	assert.Equal(t, alice.PublicKey().String(), status.SignedKeys[0].String())
+	assert.Len(t, status.MissingKeys, 2)
+
+	require.NoError(t, session.AddSignatures(_ExchangeSigningBundle(t, session, bob)))
+
+	status, err = session.GetStatus()
+	require.NoError(t, err)
+	assert.True(t, status.Satisfied)
+	assert.Len(t, status.SignedKeys, 2)
+	assert.Empty(t, status.MissingKeys)
+
+	// the merged signatures survive serialization
+	data, err := tx.ToBytes()
+	require.NoError(t, err)
+	restored, err := TransactionFromBytes(data)
+	require.NoError(t, err)
+	restoredSession, err := NewSigningSession(restored)
+	require.NoError(t, err)
+	status, err = restoredSession.SetRequiredKey(requiredKey).GetStatus()
+	require.NoError(t, err)
+	assert.True(t, status.Satisfied)
+}
+
+func TestUnitSigningSessionRejectsInvalidSignatures(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, _ := _NewSigningSessionTestKeys(t)
+
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 6}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	session, err := NewSigningSession(tx)
+	require.NoError(t, err)
+	session.SetRequiredKey(NewKeyList().Add(alice.PublicKey()).Add(bob.PublicKey()))
+
+	signatures := _ExchangeSigningBundle(t, session, alice)
+
+	// a signature claimed by the wrong key
+	forged := *signatures
+	forged.PublicKey = bob.PublicKey().String()
+	var invalid ErrInvalidBundleSignature
+	require.ErrorAs(t, session.AddSignatures(&forged), &invalid)
+	assert.Equal(t, bob.PublicKey().String(), invalid.PublicKey.String())
+
+	// signatures which do not cover every node
+	partial := *signatures
+	partial.Signatures = signatures.Signatures[:1]
+	require.Error(t, session.AddSignatures(&partial))
+
+	status, err := session.GetStatus()
+	require.NoError(t, err)
+	assert.Empty(t, status.SignedKeys)
+	assert.Len(t, status.MissingKeys, 2)
+}
+
+func TestUnitSigningSessionFromBundleIgnoresForgedSignatures(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, _ := _NewSigningSessionTestKeys(t)
+
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 6}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+	tx.AddSignature(bob.PublicKey(), make([]byte, 64))
+
+	coordinator, err := NewSigningSession(tx)
+	require.NoError(t, err)
+
+	// without a required key the bundle carries none
+	bundle, err := coordinator.ExportBundle()
+	require.NoError(t, err)
+	assert.Empty(t, bundle.RequiredKey)
+	assert.Empty(t, bundle.RequiredPublicKeys)
+	session, err := SigningSessionFromBundle(bundle)
+	require.NoError(t, err)
+	_, err = session.GetStatus()
+	require.ErrorIs(t, err, errSigningSessionKeyNotSet)
+
+	bundle, err = coordinator.SetRequiredKey(NewKeyList().Add(alice.PublicKey()).Add(bob.PublicKey())).ExportBundle()
+	require.NoError(t, err)
+	session, err = SigningSessionFromBundle(bundle)
+	require.NoError(t, err)
+
+	status, err := session.GetStatus()
+	require.NoError(t, err)
+	assert.False(t, status.Satisfied)
+	assert.Empty(t, status.SignedKeys)
+	assert.Len(t, status.MissingKeys, 2)
+
+	signatures, err := bundle.Sign(context.Background(), NewPrivateKeySigner(alice))
+	require.NoError(t, err)
+	require.NoError(t, session.AddSignatures(signatures))
+
+	status, err = session.GetStatus()
+	require.NoError(t, err)
+	assert.False(t, status.Satisfied)
+	require.Len(t, status.SignedKeys, 1)
+	assert.Equal(t, alice.PublicKey().String(), status.SignedKeys[0].String())
+	require.Len(t, status.MissingKeys, 1)
+	assert.Equal(t, bob.PublicKey().String(), status.MissingKeys[0].String())
+}
+
+func TestUnitSigningSessionChunkedTransactionFromBundle(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, _ := _NewSigningSessionTestKeys(t)
+	requiredKey := NewKeyList().Add(alice.PublicKey()).Add(bob.PublicKey())
+
+	contents := make([]byte, 2500)
+	tx, err := NewFileAppendTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		SetFileID(FileID{File: 7}).
+		SetContents(contents).
+		SetMaxChunkSize(1024).
+		FreezeWith(nil)
+	require.NoError(t, err)
+
+	coordinator, err := NewSigningSession(tx)
+	require.NoError(t, err)
+	bundle, err := coordinator.SetRequiredKey(requiredKey).ExportBundle()
+	require.NoError(t, err)
+
+	require.Len(t, bundle.Bodies, 6)
+	assert.Equal(t, 0, bundle.Bodies[0].Chunk)
+	assert.Equal(t, 2, bundle.Bodies[5].Chunk)
+
+	again, err := coordinator.ExportBundle()
+	require.NoError(t, err)
+	assert.Equal(t, bundle.Bodies, again.Bodies)
+
+	session, err := SigningSessionFromBundle(bundle)
+	require.NoError(t, err)
+	assert.Equal(t, requiredKey.String(), session.GetRequiredKey().String())
+
+	for _, key := range []PrivateKey{alice, bob} {
+		signatures, err := bundle.Sign(context.Background(), NewPrivateKeySigner(key))
+		require.NoError(t, err)
+		require.NoError(t, session.AddSignatures(signatures))
+	}
+
+	status, err := session.GetStatus()
+	require.NoError(t, err)
+	assert.True(t, status.Satisfied)
+}
+
+func TestUnitSigningSessionRequiresFrozenTransaction(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSigningSession(NewTransferTransaction())
+	require.ErrorIs(t, err, errTransactionIsNotFrozen)
+}
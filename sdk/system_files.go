@@ -0,0 +1,117 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// _SystemFileCache holds the decoded fee schedules and exchange rates files until they expire.
+type _SystemFileCache struct {
+	mutex                   sync.Mutex
+	feeSchedules            *FeeSchedules
+	feeSchedulesExpiration  time.Time
+	exchangeRates           *ExchangeRates
+	exchangeRatesExpiration time.Time
+}
+
+// GetFeeSchedules returns the current and next fee schedules from the fee schedule file of the client's shard and
+// realm. They are cached until the current fee schedule expires.
+func (client *Client) GetFeeSchedules() (FeeSchedules, error) {
+	return client.GetFeeSchedulesWithContext(context.Background())
+}
+
+// GetFeeSchedulesWithContext is GetFeeSchedules with a context which cancels the file query.
+func (client *Client) GetFeeSchedulesWithContext(ctx context.Context) (FeeSchedules, error) {
+	cache := client.systemFiles
+
+	cache.mutex.Lock()
+	if cache.feeSchedules != nil && time.Now().Before(cache.feeSchedulesExpiration) {
+		feeSchedules := *cache.feeSchedules
+		cache.mutex.Unlock()
+		return feeSchedules, nil
+	}
+	cache.mutex.Unlock()
+
+	contents, err := NewFileContentsQuery().
+		SetFileID(GetFeeScheduleFileIDFor(client.shard, client.realm)).
+		ExecuteWithContext(ctx, client)
+	if err != nil {
+		return FeeSchedules{}, err
+	}
+
+	feeSchedules, err := FeeSchedulesFromBytes(contents)
+	if err != nil {
+		return FeeSchedules{}, err
+	}
+
+	var expiration time.Time
+	if current := feeSchedules.GetCurrent(); current != nil && current.ExpirationTime != nil {
+		expiration = *current.ExpirationTime
+	}
+
+	cache.mutex.Lock()
+	cache.feeSchedules = &feeSchedules
+	cache.feeSchedulesExpiration = expiration
+	cache.mutex.Unlock()
+
+	return feeSchedules, nil
+}
+
+// GetExchangeRates returns the current and next exchange rates from the exchange rates file of the client's shard
+// and realm. They are cached until the current exchange rate expires.
+func (client *Client) GetExchangeRates() (ExchangeRates, error) {
+	return client.GetExchangeRatesWithContext(context.Background())
+}
+
+// GetExchangeRatesWithContext is GetExchangeRates with a context which cancels the file query.
+func (client *Client) GetExchangeRatesWithContext(ctx context.Context) (ExchangeRates, error) {
+	cache := client.systemFiles
+
+	cache.mutex.Lock()
+	if cache.exchangeRates != nil && time.Now().Before(cache.exchangeRatesExpiration) {
+		exchangeRates := *cache.exchangeRates
+		cache.mutex.Unlock()
+		return exchangeRates, nil
+	}
+	cache.mutex.Unlock()
+
+	contents, err := NewFileContentsQuery().
+		SetFileID(GetExchangeRatesFileIDFor(client.shard, client.realm)).
+		ExecuteWithContext(ctx, client)
+	if err != nil {
+		return ExchangeRates{}, err
+	}
+
+	exchangeRates, err := ExchangeRatesFromBytes(contents)
+	if err != nil {
+		return ExchangeRates{}, err
+	}
+
+	cache.mutex.Lock()
+	cache.exchangeRates = &exchangeRates
+	cache.exchangeRatesExpiration = exchangeRates.Current.GetExpirationTime()
+	cache.mutex.Unlock()
+
+	return exchangeRates, nil
+}
+
+// GetThrottleDefinitions returns the network throttles from the throttle definitions file of the client's shard and
+// realm. The file has no expiry, so it is fetched on every call.
+func (client *Client) GetThrottleDefinitions() (ThrottleDefinitions, error) {
+	return client.GetThrottleDefinitionsWithContext(context.Background())
+}
+
+// GetThrottleDefinitionsWithContext is GetThrottleDefinitions with a context which cancels the file query.
+func (client *Client) GetThrottleDefinitionsWithContext(ctx context.Context) (ThrottleDefinitions, error) {
+	contents, err := NewFileContentsQuery().
+		SetFileID(GetThrottleDefinitionsFileIDFor(client.shard, client.realm)).
+		ExecuteWithContext(ctx, client)
+	if err != nil {
+		return ThrottleDefinitions{}, err
+	}
+
+	return ThrottleDefinitionsFromBytes(contents)
+}
@@ -0,0 +1,110 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _MockFileContentsResponses(contents []byte) []interface{} {
+	return []interface{}{
+		&services.Response{
+			Response: &services.Response_FileGetContents{
+				FileGetContents: &services.FileGetContentsResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_COST_ANSWER, Cost: 2},
+				},
+			},
+		},
+		func(request *services.Query) *services.Response {
+			return &services.Response{
+				Response: &services.Response_FileGetContents{
+					FileGetContents: &services.FileGetContentsResponse{
+						Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY, Cost: 2},
+						FileContents: &services.FileGetContentsResponse_FileContents{
+							FileID:   request.GetFileGetContents().GetFileID(),
+							Contents: contents,
+						},
+					},
+				},
+			}
+		},
+	}
+}
+
+func TestUnitClientGetExchangeRatesCachedUntilExpiry(t *testing.T) {
+	t.Parallel()
+
+	current := NewExchangeRate(1, 12)
+	current.expirationTime = &services.TimestampSeconds{Seconds: time.Now().Add(time.Hour).Unix()}
+	next := NewExchangeRate(1, 15)
+	next.expirationTime = &services.TimestampSeconds{Seconds: time.Now().Add(2 * time.Hour).Unix()}
+	contents := ExchangeRates{Current: current, Next: next}.ToBytes()
+
+	// a single query is answered, so the second call must be served from the cache
+	client, server := NewMockClientAndServer([][]interface{}{_MockFileContentsResponses(contents)})
+	defer server.Close()
+
+	rates, err := client.GetExchangeRates()
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), rates.Current.GetCents())
+	assert.Equal(t, int32(15), rates.Next.GetCents())
+
+	cached, err := client.GetExchangeRates()
+	require.NoError(t, err)
+	assert.Equal(t, rates, cached)
+}
+
+func TestUnitClientGetFeeSchedulesRefetchesExpired(t *testing.T) {
+	t.Parallel()
+
+	// the bundled schedules expired long ago, so they are not cached
+	bundled, err := BundledFeeSchedules()
+	require.NoError(t, err)
+	contents := bundled.ToBytes()
+
+	responses := append(_MockFileContentsResponses(contents), _MockFileContentsResponses(contents)...)
+	client, server := NewMockClientAndServer([][]interface{}{responses})
+	defer server.Close()
+
+	for range 2 {
+		feeSchedules, err := client.GetFeeSchedules()
+		require.NoError(t, err)
+		require.NotNil(t, feeSchedules.GetCurrent())
+		assert.Equal(t, RequestTypeCryptoCreate, feeSchedules.GetCurrent().TransactionFeeSchedules[0].RequestType)
+		assert.NotNil(t, feeSchedules.GetNext())
+	}
+}
+
+func TestUnitExchangeRatesConversionSwitchover(t *testing.T) {
+	t.Parallel()
+
+	switchover := time.Unix(1_800_000_000, 0)
+	current := NewExchangeRate(1, 10)
+	current.expirationTime = &services.TimestampSeconds{Seconds: switchover.Unix()}
+	rates := ExchangeRates{Current: current, Next: NewExchangeRate(2, 10)}
+
+	restored, err := ExchangeRatesFromBytes(rates.ToBytes())
+	require.NoError(t, err)
+	assert.Equal(t, switchover, restored.Current.GetExpirationTime())
+
+	before := switchover.Add(-time.Second)
+	assert.Equal(t, int32(1), restored.At(before).Hbars)
+	assert.Equal(t, int32(2), restored.At(switchover).Hbars)
+
+	assert.InDelta(t, 30.0, restored.HbarToCents(NewHbar(3), before), 1e-9)
+	assert.InDelta(t, 15.0, restored.HbarToCents(NewHbar(3), switchover), 1e-9)
+	assert.Equal(t, NewHbar(5), restored.CentsToHbar(50, before))
+	assert.Equal(t, NewHbar(10), restored.CentsToHbar(50, switchover))
+	assert.Equal(t, HbarFromTinybar(3), restored.CentsToHbar(0.0000003, before))
+
+	// a rate without cents converts to zero instead of dividing by zero
+	assert.Equal(t, Hbar{}, (&ExchangeRate{}).CentsToHbar(1))
+	assert.Zero(t, (&ExchangeRate{}).HbarToCents(NewHbar(1)))
+}
@@ -0,0 +1,201 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Telemetry receives the executions of a client with their attempts, the node health transitions and the receipt
+// polling, so that they can be traced and measured. It is set with Client.SetTelemetry; the sdk/opentelemetry package
+// records them as OpenTelemetry spans and metrics. Implementations must be safe for concurrent use.
+type Telemetry interface {
+	// StartExecution is called when Execute of a transaction or query starts. The returned context is used for the
+	// attempts and end is called with the number of attempts and the error once Execute returns.
+	StartExecution(ctx context.Context, request string, transactionID string) (_ context.Context, end func(attempts int64, err error))
+	// StartAttempt is called before an attempt to send the request to a node. The returned context is used for the
+	// gRPC call and end is called with the outcome of the attempt.
+	StartAttempt(ctx context.Context, request string, attempt int64) (_ context.Context, end func(TelemetryAttempt))
+	// StartReceipt is called when GetReceipt starts polling for the receipt of a transaction. The returned context is
+	// used for the receipt queries and end is called with the receipt status and the error.
+	StartReceipt(ctx context.Context, transactionID TransactionID, nodeAccountID AccountID) (_ context.Context, end func(status Status, err error))
+	// RecordHealthTransition is called when a node address is marked unhealthy or recovers.
+	RecordHealthTransition(address string, healthy bool)
+}
+
+// TelemetryAttempt is the outcome of a single attempt to send a request to a node.
+type TelemetryAttempt struct {
+	// NodeAccountID is the account ID of the node the request was sent to, or nil if no node was selected.
+	NodeAccountID *AccountID
+	// NodeAddress is the address of the node, or empty if no node was selected.
+	NodeAddress string
+	// Status is the precheck status returned by the node or the name of the gRPC code, or empty.
+	Status string
+	// GrpcCode is the gRPC code of the call, or nil if the call was not made or did not return a gRPC status.
+	GrpcCode *codes.Code
+	// Backoff is how long the SDK waits before the next attempt, or nil if it does not wait.
+	Backoff *time.Duration
+	// Err is the gRPC error or the error which prevented sending the request, or nil.
+	Err error
+}
+
+// _Telemetry wraps the Telemetry of a client. A nil *_Telemetry records nothing.
+type _Telemetry struct {
+	telemetry Telemetry
+}
+
+// _NewTelemetry returns the wrapper of telemetry, or nil if telemetry is nil.
+func _NewTelemetry(telemetry Telemetry) *_Telemetry {
+	if telemetry == nil {
+		return nil
+	}
+
+	return &_Telemetry{telemetry: telemetry}
+}
+
+// _ExecutionSpan is a single Execute call.
+type _ExecutionSpan struct {
+	end      func(attempts int64, err error)
+	attempts int64
+}
+
+// _StartExecution starts a transaction or query execution.
+func (telemetry *_Telemetry) _StartExecution(ctx context.Context, e Executable) (context.Context, *_ExecutionSpan) {
+	if telemetry == nil {
+		return ctx, nil
+	}
+
+	txID, _ := e.getTransactionIDAndMessage()
+	ctx, end := telemetry.telemetry.StartExecution(ctx, e.getName(), txID)
+
+	return ctx, &_ExecutionSpan{end: end}
+}
+
+func (execution *_ExecutionSpan) _End(err error) {
+	if execution == nil || execution.end == nil {
+		return
+	}
+
+	execution.end(execution.attempts, err)
+}
+
+// _AttemptSpan is a single attempt to send a request to a node.
+type _AttemptSpan struct {
+	end    func(TelemetryAttempt)
+	result TelemetryAttempt
+}
+
+// _StartAttempt starts an attempt within the execution.
+func (telemetry *_Telemetry) _StartAttempt(ctx context.Context, execution *_ExecutionSpan, e Executable, attempt int64) (context.Context, *_AttemptSpan) {
+	if telemetry == nil {
+		return ctx, nil
+	}
+
+	if execution != nil {
+		execution.attempts++
+	}
+
+	ctx, end := telemetry.telemetry.StartAttempt(ctx, e.getName(), attempt)
+
+	return ctx, &_AttemptSpan{end: end}
+}
+
+func (attempt *_AttemptSpan) _SetNode(node *_Node) {
+	if attempt == nil {
+		return
+	}
+
+	accountID := node.accountID
+	attempt.result.NodeAccountID = &accountID
+	attempt.result.NodeAddress = node.address._String()
+}
+
+// _SetError records the gRPC error returned by the node, or the error which prevented sending the request.
+func (attempt *_AttemptSpan) _SetError(err error) {
+	if attempt == nil {
+		return
+	}
+
+	attempt.result.Err = err
+	if grpcStatus, ok := status.FromError(err); ok {
+		code := grpcStatus.Code()
+		attempt.result.Status = code.String()
+		attempt.result.GrpcCode = &code
+	}
+}
+
+// _SetStatus records the precheck status returned by the node.
+func (attempt *_AttemptSpan) _SetStatus(err error) {
+	if attempt == nil {
+		return
+	}
+
+	var precheckErr ErrHederaPreCheckStatus
+	if errors.As(err, &precheckErr) {
+		code := codes.OK
+		attempt.result.Status = precheckErr.Status.String()
+		attempt.result.GrpcCode = &code
+	}
+}
+
+// _SetBackoff records how long the SDK waits before the next attempt.
+func (attempt *_AttemptSpan) _SetBackoff(backoff time.Duration) {
+	if attempt == nil {
+		return
+	}
+
+	attempt.result.Backoff = &backoff
+}
+
+// _End reports the outcome of the attempt. Ending an attempt more than once has no effect.
+func (attempt *_AttemptSpan) _End() {
+	if attempt == nil || attempt.end == nil {
+		return
+	}
+
+	end := attempt.end
+	attempt.end = nil
+	end(attempt.result)
+}
+
+// _RecordHealthTransition records a node being marked unhealthy or recovering.
+func (telemetry *_Telemetry) _RecordHealthTransition(address string, healthy bool) {
+	if telemetry == nil {
+		return
+	}
+
+	telemetry.telemetry.RecordHealthTransition(address, healthy)
+}
+
+// _ReceiptSpan is the polling for a receipt.
+type _ReceiptSpan struct {
+	end func(status Status, err error)
+}
+
+// _StartReceipt starts polling for the receipt of a transaction.
+func (telemetry *_Telemetry) _StartReceipt(ctx context.Context, response TransactionResponse) (context.Context, *_ReceiptSpan) {
+	if telemetry == nil {
+		return ctx, nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, end := telemetry.telemetry.StartReceipt(ctx, response.TransactionID, response.NodeID)
+
+	return ctx, &_ReceiptSpan{end: end}
+}
+
+func (receipt *_ReceiptSpan) _End(result TransactionReceipt, err error) {
+	if receipt == nil || receipt.end == nil {
+		return
+	}
+
+	receipt.end(result.Status, err)
+}
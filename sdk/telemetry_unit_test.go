@@ -0,0 +1,150 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type _TelemetryContextKey struct{}
+
+// _RecordingTelemetry records the events it receives and marks the contexts it returns with the request name
+type _RecordingTelemetry struct {
+	mutex       sync.Mutex
+	executions  []string
+	attempts    []TelemetryAttempt
+	parents     []any
+	receipts    []Status
+	transitions []bool
+	ended       int64
+}
+
+func (telemetry *_RecordingTelemetry) StartExecution(ctx context.Context, request string, _ string) (context.Context, func(int64, error)) {
+	telemetry.mutex.Lock()
+	telemetry.executions = append(telemetry.executions, request)
+	telemetry.mutex.Unlock()
+
+	return context.WithValue(ctx, _TelemetryContextKey{}, request), func(attempts int64, _ error) {
+		telemetry.mutex.Lock()
+		defer telemetry.mutex.Unlock()
+		telemetry.ended += attempts
+	}
+}
+
+func (telemetry *_RecordingTelemetry) StartAttempt(ctx context.Context, _ string, _ int64) (context.Context, func(TelemetryAttempt)) {
+	parent := ctx.Value(_TelemetryContextKey{})
+	return ctx, func(attempt TelemetryAttempt) {
+		telemetry.mutex.Lock()
+		defer telemetry.mutex.Unlock()
+		telemetry.attempts = append(telemetry.attempts, attempt)
+		telemetry.parents = append(telemetry.parents, parent)
+	}
+}
+
+func (telemetry *_RecordingTelemetry) StartReceipt(ctx context.Context, _ TransactionID, _ AccountID) (context.Context, func(Status, error)) {
+	return ctx, func(status Status, _ error) {
+		telemetry.mutex.Lock()
+		defer telemetry.mutex.Unlock()
+		telemetry.receipts = append(telemetry.receipts, status)
+	}
+}
+
+func (telemetry *_RecordingTelemetry) RecordHealthTransition(_ string, healthy bool) {
+	telemetry.mutex.Lock()
+	defer telemetry.mutex.Unlock()
+	telemetry.transitions = append(telemetry.transitions, healthy)
+}
+
+func TestUnitMockTelemetry(t *testing.T) {
+	t.Parallel()
+
+	receipt := &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{
+					NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+					ResponseType:                services.ResponseType_ANSWER_ONLY,
+				},
+				Receipt: &services.TransactionReceipt{
+					Status: services.ResponseCodeEnum_SUCCESS,
+				},
+			},
+		},
+	}
+	responses := [][]interface{}{{
+		status.Error(codes.Unavailable, "unavailable"),
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY},
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+		receipt,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	telemetry := &_RecordingTelemetry{}
+	client.SetTelemetry(telemetry)
+	require.Equal(t, telemetry, client.GetTelemetry())
+
+	response, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		SetMinBackoff(0).
+		Execute(client)
+	require.NoError(t, err)
+
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"TransferTransaction", "TransactionReceiptQuery"}, telemetry.executions)
+	assert.Equal(t, int64(4), telemetry.ended)
+	assert.Equal(t, []any{"TransferTransaction", "TransferTransaction", "TransferTransaction", "TransactionReceiptQuery"}, telemetry.parents)
+
+	require.Len(t, telemetry.attempts, 4)
+	for _, attempt := range telemetry.attempts {
+		require.NotNil(t, attempt.NodeAccountID)
+		assert.Equal(t, AccountID{Account: 3}, *attempt.NodeAccountID)
+		assert.NotEmpty(t, attempt.NodeAddress)
+		require.NotNil(t, attempt.GrpcCode)
+	}
+	assert.Equal(t, codes.Unavailable, *telemetry.attempts[0].GrpcCode)
+	assert.Equal(t, "Unavailable", telemetry.attempts[0].Status)
+	assert.Error(t, telemetry.attempts[0].Err)
+	assert.Equal(t, "BUSY", telemetry.attempts[1].Status)
+	assert.NotNil(t, telemetry.attempts[1].Backoff)
+	assert.Equal(t, "OK", telemetry.attempts[2].Status)
+	assert.Nil(t, telemetry.attempts[2].Backoff)
+	assert.Equal(t, "SUCCESS", telemetry.attempts[3].Status)
+
+	assert.Equal(t, []Status{StatusSuccess}, telemetry.receipts)
+	assert.Equal(t, []bool{false, true}, telemetry.transitions)
+
+	client.SetTelemetry(nil)
+	require.Nil(t, client.GetTelemetry())
+}
+
+func TestUnitTelemetryDisabled(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, _NewTelemetry(nil))
+
+	var telemetry *_Telemetry
+	ctx, execution := telemetry._StartExecution(context.Background(), NewTransferTransaction())
+	require.Nil(t, execution)
+	_, attempt := telemetry._StartAttempt(ctx, execution, NewTransferTransaction(), 0)
+	require.Nil(t, attempt)
+	attempt._SetStatus(ErrHederaPreCheckStatus{Status: StatusBusy})
+	attempt._End()
+	execution._End(nil)
+	telemetry._RecordHealthTransition("127.0.0.1:50211", false)
+}
@@ -0,0 +1,143 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ThrottleDefinitions are the network-wide throttles, as stored in the throttle definitions file.
+type ThrottleDefinitions struct {
+	ThrottleBuckets []ThrottleBucket
+}
+
+// ThrottleBucket is a throttle shared by its groups of operations. BurstPeriod is the period over which the
+// capacity of the bucket is computed, which allows bursts above the steady rate of the groups.
+type ThrottleBucket struct {
+	Name           string
+	BurstPeriod    time.Duration
+	ThrottleGroups []ThrottleGroup
+}
+
+// ThrottleGroup limits the operations of the group to MilliOpsPerSec thousandths of an operation per second across
+// the network.
+type ThrottleGroup struct {
+	Operations     []RequestType
+	MilliOpsPerSec uint64
+}
+
+func _ThrottleDefinitionsFromProtobuf(pb *services.ThrottleDefinitions) (ThrottleDefinitions, error) {
+	if pb == nil {
+		return ThrottleDefinitions{}, errParameterNull
+	}
+
+	buckets := make([]ThrottleBucket, 0, len(pb.GetThrottleBuckets()))
+	for _, bucket := range pb.GetThrottleBuckets() {
+		groups := make([]ThrottleGroup, 0, len(bucket.GetThrottleGroups()))
+		for _, group := range bucket.GetThrottleGroups() {
+			operations := make([]RequestType, 0, len(group.GetOperations()))
+			for _, operation := range group.GetOperations() {
+				operations = append(operations, RequestType(operation))
+			}
+
+			groups = append(groups, ThrottleGroup{
+				Operations:     operations,
+				MilliOpsPerSec: group.GetMilliOpsPerSec(),
+			})
+		}
+
+		buckets = append(buckets, ThrottleBucket{
+			Name:           bucket.GetName(),
+			BurstPeriod:    time.Duration(bucket.GetBurstPeriodMs()) * time.Millisecond,
+			ThrottleGroups: groups,
+		})
+	}
+
+	return ThrottleDefinitions{ThrottleBuckets: buckets}, nil
+}
+
+func (definitions ThrottleDefinitions) _ToProtobuf() *services.ThrottleDefinitions {
+	buckets := make([]*services.ThrottleBucket, 0, len(definitions.ThrottleBuckets))
+	for _, bucket := range definitions.ThrottleBuckets {
+		groups := make([]*services.ThrottleGroup, 0, len(bucket.ThrottleGroups))
+		for _, group := range bucket.ThrottleGroups {
+			operations := make([]services.HederaFunctionality, 0, len(group.Operations))
+			for _, operation := range group.Operations {
+				operations = append(operations, services.HederaFunctionality(operation))
+			}
+
+			groups = append(groups, &services.ThrottleGroup{
+				Operations:     operations,
+				MilliOpsPerSec: group.MilliOpsPerSec,
+			})
+		}
+
+		buckets = append(buckets, &services.ThrottleBucket{
+			Name:           bucket.Name,
+			BurstPeriodMs:  uint64(bucket.BurstPeriod / time.Millisecond),
+			ThrottleGroups: groups,
+		})
+	}
+
+	return &services.ThrottleDefinitions{ThrottleBuckets: buckets}
+}
+
+// ToBytes returns the byte representation of the ThrottleDefinitions
+func (definitions ThrottleDefinitions) ToBytes() []byte {
+	data, err := protobuf.Marshal(definitions._ToProtobuf())
+	if err != nil {
+		return make([]byte, 0)
+	}
+
+	return data
+}
+
+// ThrottleDefinitionsFromBytes returns the ThrottleDefinitions from the contents of the throttle definitions file
+func ThrottleDefinitionsFromBytes(data []byte) (ThrottleDefinitions, error) {
+	if data == nil {
+		return ThrottleDefinitions{}, errByteArrayNull
+	}
+	pb := services.ThrottleDefinitions{}
+	err := protobuf.Unmarshal(data, &pb)
+	if err != nil {
+		return ThrottleDefinitions{}, err
+	}
+
+	return _ThrottleDefinitionsFromProtobuf(&pb)
+}
+
+// String returns a string representation of the ThrottleDefinitions
+func (definitions ThrottleDefinitions) String() string {
+	buckets := make([]string, 0, len(definitions.ThrottleBuckets))
+	for _, bucket := range definitions.ThrottleBuckets {
+		buckets = append(buckets, bucket.String())
+	}
+
+	return fmt.Sprintf("ThrottleBuckets: [%s]", strings.Join(buckets, ", "))
+}
+
+// String returns a string representation of the ThrottleBucket
+func (bucket ThrottleBucket) String() string {
+	groups := make([]string, 0, len(bucket.ThrottleGroups))
+	for _, group := range bucket.ThrottleGroups {
+		groups = append(groups, group.String())
+	}
+
+	return fmt.Sprintf("Name: %s, BurstPeriod: %s, ThrottleGroups: [%s]", bucket.Name, bucket.BurstPeriod, strings.Join(groups, ", "))
+}
+
+// String returns a string representation of the ThrottleGroup
+func (group ThrottleGroup) String() string {
+	operations := make([]string, 0, len(group.Operations))
+	for _, operation := range group.Operations {
+		// the protobuf name is used since the throttle file may list operations unknown to this SDK
+		operations = append(operations, services.HederaFunctionality(operation).String())
+	}
+
+	return fmt.Sprintf("Operations: [%s], MilliOpsPerSec: %d", strings.Join(operations, ", "), group.MilliOpsPerSec)
+}
@@ -111,6 +111,23 @@ func TestUnitTokenAirdropTransactionAddTokenTransfer(t *testing.T) {
 	assert.Equal(t, amount, transfers[tokenID][0].Amount)
 }
 
+func TestUnitTokenAirdropTransactionAddTokenTransferSeveralTokens(t *testing.T) {
+	t.Parallel()
+
+	accountID := AccountID{Account: 2}
+
+	transaction := NewTokenAirdropTransaction().
+		AddTokenTransferWithDecimals(TokenID{Token: 1}, accountID, 100, 2).
+		AddTokenTransferWithDecimals(TokenID{Token: 3}, accountID, 5, 8)
+
+	transfers := transaction.GetTokenTransfers()
+	require.Len(t, transfers[TokenID{Token: 1}], 1)
+	require.Len(t, transfers[TokenID{Token: 3}], 1)
+	assert.Equal(t, int64(100), transfers[TokenID{Token: 1}][0].Amount)
+	assert.Equal(t, int64(5), transfers[TokenID{Token: 3}][0].Amount)
+	assert.Equal(t, map[TokenID]uint32{{Token: 1}: 2, {Token: 3}: 8}, transaction.GetTokenIDDecimals())
+}
+
 func TestUnitTokenAirdropTransactionAddNftTransfer(t *testing.T) {
 	t.Parallel()
 
@@ -44,6 +44,27 @@ func _TokenNftInfoFromProtobuf(pb *services.TokenNftInfo) TokenNftInfo {
 	}
 }
 
+func _TokenNftInfoFromMirror(nft MirrorNft, ledgerID LedgerID) TokenNftInfo {
+	accountID := AccountID{}
+	if nft.AccountID != nil {
+		accountID = *nft.AccountID
+	}
+
+	spenderID := AccountID{}
+	if nft.SpenderID != nil {
+		spenderID = *nft.SpenderID
+	}
+
+	return TokenNftInfo{
+		NftID:        nft.NftID,
+		AccountID:    accountID,
+		CreationTime: nft.CreatedTimestamp,
+		Metadata:     nft.Metadata,
+		LedgerID:     ledgerID,
+		SpenderID:    spenderID,
+	}
+}
+
 func (tokenNftInfo *TokenNftInfo) _ToProtobuf() *services.TokenNftInfo {
 	return &services.TokenNftInfo{
 		NftID:        tokenNftInfo.NftID._ToProtobuf(),
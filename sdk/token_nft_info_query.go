@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -94,12 +95,12 @@ func (q *TokenNftInfoQuery) ByNftID(id NftID) *TokenNftInfoQuery {
 	return q
 }
 
-// Deprecated
+// Deprecated: use TokenNftInfosQuery to list the NFTs of a token
 func (q *TokenNftInfoQuery) ByTokenID(id TokenID) *TokenNftInfoQuery {
 	return q
 }
 
-// Deprecated
+// Deprecated: use AccountNftInfosQuery to list the NFTs of an account
 func (q *TokenNftInfoQuery) ByAccountID(id AccountID) *TokenNftInfoQuery {
 	return q
 }
@@ -110,7 +111,12 @@ func (q *TokenNftInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *TokenNftInfoQuery) Execute(client *Client) ([]TokenNftInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, returning ctx.Err() if ctx is cancelled before it completes
+func (q *TokenNftInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TokenNftInfo, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return []TokenNftInfo{}, err
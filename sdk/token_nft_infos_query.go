@@ -0,0 +1,265 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const _TokenServiceGetTokenNftInfosFullMethodName = "/proto.TokenService/getTokenNftInfos"
+
+// _NftInfosMirrorPageSize is the largest page the mirror node returns for the NFT list endpoints
+const _NftInfosMirrorPageSize = 100
+
+var errInvalidNftInfosRange = errors.New("start must not be negative and end must be greater than start")
+
+// TokenNftInfosQuery
+// Applicable only to tokens of type NON_FUNGIBLE_UNIQUE.
+// Gets info on the NFTs of a TokenID in the range [start, end) of the minted order.
+// Networks which no longer serve this query are answered from the mirror node instead.
+type TokenNftInfosQuery struct {
+	Query
+	tokenID *TokenID
+	start   int64
+	end     int64
+}
+
+// NewTokenNftInfosQuery creates TokenNftInfosQuery which
+// gets info on the NFTs of a TokenID (of type NON_FUNGIBLE_UNIQUE) in the range [start, end)
+func NewTokenNftInfosQuery() *TokenNftInfosQuery {
+	header := services.QueryHeader{}
+	return &TokenNftInfosQuery{
+		Query: _NewQuery(true, &header),
+	}
+}
+
+// SetGrpcDeadline When execution is attempted, a single attempt will timeout when this deadline is reached. (The SDK may subsequently retry the execution.)
+func (q *TokenNftInfosQuery) SetGrpcDeadline(deadline *time.Duration) *TokenNftInfosQuery {
+	q.Query.SetGrpcDeadline(deadline)
+	return q
+}
+
+// SetTokenID Sets the ID of the token whose NFTs are listed
+func (q *TokenNftInfosQuery) SetTokenID(tokenID TokenID) *TokenNftInfosQuery {
+	q.tokenID = &tokenID
+	return q
+}
+
+// GetTokenID returns the ID of the token whose NFTs are listed
+func (q *TokenNftInfosQuery) GetTokenID() TokenID {
+	if q.tokenID == nil {
+		return TokenID{}
+	}
+
+	return *q.tokenID
+}
+
+// SetStart sets the index (inclusive) of the first NFT to list
+func (q *TokenNftInfosQuery) SetStart(start int64) *TokenNftInfosQuery {
+	q.start = start
+	return q
+}
+
+// GetStart returns the index (inclusive) of the first NFT to list
+func (q *TokenNftInfosQuery) GetStart() int64 {
+	return q.start
+}
+
+// SetEnd sets the index (exclusive) of the last NFT to list
+func (q *TokenNftInfosQuery) SetEnd(end int64) *TokenNftInfosQuery {
+	q.end = end
+	return q
+}
+
+// GetEnd returns the index (exclusive) of the last NFT to list
+func (q *TokenNftInfosQuery) GetEnd() int64 {
+	return q.end
+}
+
+func (q *TokenNftInfosQuery) GetCost(client *Client) (Hbar, error) {
+	return q.Query.getCost(client, q)
+}
+
+// Execute executes the Query with the provided client
+func (q *TokenNftInfosQuery) Execute(client *Client) ([]TokenNftInfo, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, returning ctx.Err() if ctx is cancelled before it completes
+func (q *TokenNftInfosQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TokenNftInfo, error) {
+	if q.start < 0 || q.end <= q.start {
+		return []TokenNftInfo{}, errInvalidNftInfosRange
+	}
+
+	resp, err := q.Query.executeWithContext(ctx, client, q)
+	if err != nil {
+		if !_NftInfosShouldFallback(err) {
+			return []TokenNftInfo{}, err
+		}
+
+		restClient, restErr := client.GetMirrorRestClient()
+		if restErr != nil {
+			return []TokenNftInfo{}, err
+		}
+
+		params := NewMirrorRestParams().SetOrder("asc").SetLimit(int(min(q.end, _NftInfosMirrorPageSize)))
+		return _NftInfosFromMirror(client, restClient.TokenNfts(ctx, q.GetTokenID(), params), q.start, q.end)
+	}
+
+	return _NftInfosFromProtobuf(resp.GetTokenGetNftInfos().GetNfts()), nil
+}
+
+// SetMaxQueryPayment sets the maximum payment allowed for this Query.
+func (q *TokenNftInfosQuery) SetMaxQueryPayment(maxPayment Hbar) *TokenNftInfosQuery {
+	q.Query.SetMaxQueryPayment(maxPayment)
+	return q
+}
+
+// SetQueryPayment sets the payment amount for this Query.
+func (q *TokenNftInfosQuery) SetQueryPayment(paymentAmount Hbar) *TokenNftInfosQuery {
+	q.Query.SetQueryPayment(paymentAmount)
+	return q
+}
+
+// SetNodeAccountIDs sets the _Node AccountID for this TokenNftInfosQuery.
+func (q *TokenNftInfosQuery) SetNodeAccountIDs(accountID []AccountID) *TokenNftInfosQuery {
+	q.Query.SetNodeAccountIDs(accountID)
+	return q
+}
+
+// SetMaxRetry sets the max number of errors before execution will fail.
+func (q *TokenNftInfosQuery) SetMaxRetry(count int) *TokenNftInfosQuery {
+	q.Query.SetMaxRetry(count)
+	return q
+}
+
+// SetMaxBackoff The maximum amount of time to wait between retries.
+// Every retry attempt will increase the wait time exponentially until it reaches this time.
+func (q *TokenNftInfosQuery) SetMaxBackoff(max time.Duration) *TokenNftInfosQuery {
+	q.Query.SetMaxBackoff(max)
+	return q
+}
+
+// SetMinBackoff sets the minimum amount of time to wait between retries.
+func (q *TokenNftInfosQuery) SetMinBackoff(min time.Duration) *TokenNftInfosQuery {
+	q.Query.SetMinBackoff(min)
+	return q
+}
+
+// SetPaymentTransactionID assigns the payment transaction id.
+func (q *TokenNftInfosQuery) SetPaymentTransactionID(transactionID TransactionID) *TokenNftInfosQuery {
+	q.Query.SetPaymentTransactionID(transactionID)
+	return q
+}
+
+func (q *TokenNftInfosQuery) SetLogLevel(level LogLevel) *TokenNftInfosQuery {
+	q.Query.SetLogLevel(level)
+	return q
+}
+
+// ---------- Parent functions specific implementation ----------
+
+func (q *TokenNftInfosQuery) getMethod(channel *_Channel) _Method {
+	return _Method{
+		query: channel._LegacyQuery(_TokenServiceGetTokenNftInfosFullMethodName),
+	}
+}
+
+func (q *TokenNftInfosQuery) getName() string {
+	return "TokenNftInfosQuery"
+}
+
+func (q *TokenNftInfosQuery) buildQuery() *services.Query {
+	body := &services.TokenGetNftInfosQuery{
+		Header: q.pbHeader,
+		Start:  q.start,
+		End:    q.end,
+	}
+
+	if q.tokenID != nil {
+		body.TokenID = q.tokenID._ToProtobuf()
+	}
+
+	return &services.Query{
+		Query: &services.Query_TokenGetNftInfos{
+			TokenGetNftInfos: body,
+		},
+	}
+}
+
+func (q *TokenNftInfosQuery) validateNetworkOnIDs(client *Client) error {
+	if client == nil || !client.autoValidateChecksums {
+		return nil
+	}
+
+	if q.tokenID != nil {
+		if err := q.tokenID.ValidateChecksum(client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (q *TokenNftInfosQuery) getQueryResponse(response *services.Response) queryResponse {
+	return response.GetTokenGetNftInfos()
+}
+
+// _NftInfosShouldFallback reports whether a NFT list query failed because the network no longer serves it, either
+// because the node does not know the gRPC method or because it answered NOT_SUPPORTED
+func _NftInfosShouldFallback(err error) bool {
+	var precheckErr ErrHederaPreCheckStatus
+	if errors.As(err, &precheckErr) {
+		return precheckErr.Status == StatusNotSupported
+	}
+
+	return status.Code(err) == codes.Unimplemented
+}
+
+func _NftInfosFromProtobuf(pb []*services.TokenNftInfo) []TokenNftInfo {
+	infos := make([]TokenNftInfo, 0, len(pb))
+	for _, nft := range pb {
+		infos = append(infos, _TokenNftInfoFromProtobuf(nft))
+	}
+
+	return infos
+}
+
+// _NftInfosFromMirror collects the NFTs with an index in [start, end) from a mirror node NFT list, skipping burned
+// NFTs the network would not count either
+func _NftInfosFromMirror(client *Client, nfts iter.Seq2[MirrorNft, error], start int64, end int64) ([]TokenNftInfo, error) {
+	var ledgerID LedgerID
+	if client.GetLedgerID() != nil {
+		ledgerID = *client.GetLedgerID()
+	}
+
+	infos := make([]TokenNftInfo, 0)
+	index := int64(0)
+	for nft, err := range nfts {
+		if err != nil {
+			return []TokenNftInfo{}, err
+		}
+
+		if nft.Deleted {
+			continue
+		}
+
+		if index >= start {
+			infos = append(infos, _TokenNftInfoFromMirror(nft, ledgerID))
+		}
+
+		index++
+		if index >= end {
+			break
+		}
+	}
+
+	return infos, nil
+}
@@ -0,0 +1,141 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// _NftInfosMirror serves the NFTs listed at path in ascending order of serial number, the first page lists serials
+// 1 to 3 of which 2 is burned and links to the second one, which lists serials 4 and 5
+func _NftInfosMirror(t *testing.T, path string, owner string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, path, r.URL.Path)
+		assert.Equal(t, "asc", r.URL.Query().Get("order"))
+		w.Header().Set("Content-Type", "application/json")
+
+		nft := func(serial int, deleted bool) string {
+			return fmt.Sprintf(`{"account_id": %q, "created_timestamp": "1700000000.00000000%d", "deleted": %t,
+				"metadata": "aXBmczovL25mdA==", "serial_number": %d, "spender": null, "token_id": "0.0.5005"}`, owner, serial, deleted, serial)
+		}
+
+		if r.URL.Query().Get("serialnumber") == "" {
+			next := path + "?order=asc&serialnumber=gt:3"
+			_, _ = fmt.Fprintf(w, `{"nfts": [%s], "links": {"next": %q}}`, strings.Join([]string{nft(1, false), nft(2, true), nft(3, false)}, ","), next)
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"nfts": [%s], "links": {"next": null}}`, strings.Join([]string{nft(4, false), nft(5, false)}, ","))
+	}))
+}
+
+func TestUnitTokenNftInfosQueryGet(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Duration(time.Minute)
+	query := NewTokenNftInfosQuery().
+		SetTokenID(TokenID{Token: 5005}).
+		SetStart(1).
+		SetEnd(3).
+		SetNodeAccountIDs([]AccountID{{Account: 10}}).
+		SetQueryPayment(NewHbar(2)).
+		SetMaxQueryPayment(NewHbar(1)).
+		SetMaxRetry(3).
+		SetMinBackoff(time.Second).
+		SetMaxBackoff(time.Second * 30).
+		SetGrpcDeadline(&deadline)
+
+	require.Equal(t, TokenID{Token: 5005}, query.GetTokenID())
+	require.Equal(t, int64(1), query.GetStart())
+	require.Equal(t, int64(3), query.GetEnd())
+	require.Equal(t, []AccountID{{Account: 10}}, query.GetNodeAccountIDs())
+	require.Equal(t, NewHbar(2), query.GetQueryPayment())
+	require.Equal(t, NewHbar(1), query.GetMaxQueryPayment())
+	require.Equal(t, 3, query.GetMaxRetryCount())
+	require.Equal(t, &deadline, query.GetGrpcDeadline())
+
+	body := query.buildQuery().GetTokenGetNftInfos()
+	require.NotNil(t, body)
+	require.Equal(t, int64(5005), body.GetTokenID().GetTokenNum())
+	require.Equal(t, int64(1), body.GetStart())
+	require.Equal(t, int64(3), body.GetEnd())
+}
+
+func TestUnitTokenNftInfosQueryInvalidRange(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+
+	_, err = NewTokenNftInfosQuery().SetTokenID(TokenID{Token: 5005}).SetStart(3).SetEnd(3).Execute(client)
+	require.ErrorIs(t, err, errInvalidNftInfosRange)
+
+	_, err = NewTokenNftInfosQuery().SetTokenID(TokenID{Token: 5005}).SetStart(-1).SetEnd(3).Execute(client)
+	require.ErrorIs(t, err, errInvalidNftInfosRange)
+}
+
+func TestUnitTokenNftInfosQueryMirrorFallback(t *testing.T) {
+	t.Parallel()
+
+	mirror := _NftInfosMirror(t, "/api/v1/tokens/0.0.5005/nfts", "0.0.1800")
+	defer mirror.Close()
+
+	// the mock nodes do not serve getTokenNftInfos and answer UNIMPLEMENTED
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+	client.SetMirrorRestApiBaseUrl(mirror.URL + "/api/v1")
+
+	infos, err := NewTokenNftInfosQuery().
+		SetTokenID(TokenID{Token: 5005}).
+		SetStart(1).
+		SetEnd(3).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+
+	assert.Equal(t, NftID{TokenID: TokenID{Token: 5005}, SerialNumber: 3}, infos[0].NftID)
+	assert.Equal(t, NftID{TokenID: TokenID{Token: 5005}, SerialNumber: 4}, infos[1].NftID)
+	assert.Equal(t, AccountID{Account: 1800}, infos[0].AccountID)
+	assert.Equal(t, AccountID{}, infos[0].SpenderID)
+	assert.Equal(t, []byte("ipfs://nft"), infos[0].Metadata)
+	assert.True(t, time.Unix(1_700_000_000, 3).Equal(infos[0].CreationTime))
+	assert.Equal(t, *NewLedgerIDMainnet(), infos[0].LedgerID)
+}
+
+func TestUnitNftInfosShouldFallback(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, _NftInfosShouldFallback(status.Error(codes.Unimplemented, "unknown method getTokenNftInfos")))
+	assert.True(t, _NftInfosShouldFallback(errors.Wrapf(status.Error(codes.Unimplemented, ""), "retry %d/%d", 1, 10)))
+	assert.True(t, _NftInfosShouldFallback(ErrHederaPreCheckStatus{Status: StatusNotSupported}))
+
+	assert.False(t, _NftInfosShouldFallback(status.Error(codes.Unavailable, "")))
+	assert.False(t, _NftInfosShouldFallback(ErrHederaPreCheckStatus{Status: StatusInvalidTokenID}))
+	assert.False(t, _NftInfosShouldFallback(errNoClientProvided))
+}
+
+func TestUnitNftInfosFromProtobuf(t *testing.T) {
+	t.Parallel()
+
+	infos := _NftInfosFromProtobuf([]*services.TokenNftInfo{
+		{NftID: &services.NftID{Token_ID: &services.TokenID{TokenNum: 5005}, SerialNumber: 1}, Metadata: []byte{1}},
+		{NftID: &services.NftID{Token_ID: &services.TokenID{TokenNum: 5005}, SerialNumber: 2}, Metadata: []byte{2}},
+	})
+	require.Len(t, infos, 2)
+	assert.Equal(t, NftID{TokenID: TokenID{Token: 5005}, SerialNumber: 2}, infos[1].NftID)
+	assert.Equal(t, []byte{2}, infos[1].Metadata)
+}
@@ -2,14 +2,15 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
+import "context"
+
 type TokenRejectFlow struct {
-	ownerID           *AccountID
-	tokenIDs          []TokenID
-	nftIDs            []NftID
-	freezeWithClient  *Client
-	signPrivateKey    *PrivateKey
-	signPublicKey     *PublicKey
-	transactionSigner *TransactionSigner
+	ownerID          *AccountID
+	tokenIDs         []TokenID
+	nftIDs           []NftID
+	freezeWithClient *Client
+	signPrivateKey   *PrivateKey
+	signer           Signer
 }
 
 func NewTokenRejectFlow() *TokenRejectFlow {
@@ -83,8 +84,13 @@ func (tx *TokenRejectFlow) SignWith(
 	publicKey PublicKey,
 	signer TransactionSigner,
 ) *TokenRejectFlow {
-	tx.signPublicKey = &publicKey
-	tx.transactionSigner = &signer
+	tx.signer = NewSignerFromTransactionSigner(publicKey, signer)
+	return tx
+}
+
+// SignWithSigner adds the Signer's signature to both the dissociate and the reject transaction.
+func (tx *TokenRejectFlow) SignWithSigner(signer Signer) *TokenRejectFlow {
+	tx.signer = signer
 	return tx
 }
 
@@ -133,8 +139,8 @@ func (tx *TokenRejectFlow) _CreateTokenDissociateTransaction(client *Client) (*T
 		tokenDissociateTxn = tokenDissociateTxn.Sign(*tx.signPrivateKey)
 	}
 
-	if tx.signPublicKey != nil && tx.transactionSigner != nil {
-		tokenDissociateTxn = tokenDissociateTxn.SignWith(*tx.signPublicKey, *tx.transactionSigner)
+	if tx.signer != nil {
+		tokenDissociateTxn = tokenDissociateTxn.SignWithSigner(tx.signer)
 	}
 
 	return tokenDissociateTxn, nil
@@ -169,23 +175,30 @@ func (tx *TokenRejectFlow) _CreateTokenRejectTransaction(client *Client) (*Token
 		tokenRejectTxn = tokenRejectTxn.Sign(*tx.signPrivateKey)
 	}
 
-	if tx.signPublicKey != nil && tx.transactionSigner != nil {
-		tokenRejectTxn = tokenRejectTxn.SignWith(*tx.signPublicKey, *tx.transactionSigner)
+	if tx.signer != nil {
+		tokenRejectTxn = tokenRejectTxn.SignWithSigner(tx.signer)
 	}
 
 	return tokenRejectTxn, nil
 }
 
+// Execute executes the flow with the provided client
 func (tx *TokenRejectFlow) Execute(client *Client) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the flow with the provided client, returning ctx.Err() if ctx is cancelled before the
+// tokens have been rejected and dissociated
+func (tx *TokenRejectFlow) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	tokenRejectTxn, err := tx._CreateTokenRejectTransaction(client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	tokenRejectResponse, err := tokenRejectTxn.Execute(client)
+	tokenRejectResponse, err := tokenRejectTxn.ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	_, err = tokenRejectResponse.GetReceipt(client)
+	_, err = tokenRejectResponse.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
@@ -194,11 +207,11 @@ func (tx *TokenRejectFlow) Execute(client *Client) (TransactionResponse, error)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	tokenDissociateResponse, err := tokenDissociateTxn.Execute(client)
+	tokenDissociateResponse, err := tokenDissociateTxn.ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	_, err = tokenDissociateResponse.GetReceipt(client)
+	_, err = tokenDissociateResponse.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
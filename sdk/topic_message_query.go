@@ -4,6 +4,7 @@ package hiero
 
 import (
 	"context"
+	"io"
 	"regexp"
 	"time"
 
@@ -179,7 +180,7 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 		return handle, err
 	}
 
-	channel, err := mirrorNode._GetConsensusServiceClient()
+	channel, err := mirrorNode._GetConsensusServiceClient(client.interceptors)
 	if err != nil {
 		return handle, err
 	}
@@ -193,7 +194,24 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 	}
 	messages := make(map[string][]*mirror.ConsensusTopicResponse)
 
-	resultStream := processProtoMessageStream(ctx, stream, query.attempt, query.maxAttempts, query.retryHandler)
+	// a resumed subscription starts after the last received message and only asks for the remaining messages. A
+	// subscription which already received its limit finishes instead.
+	resubscribe := func(last *mirror.ConsensusTopicResponse, received uint64) (recvStream[*mirror.ConsensusTopicResponse], error) {
+		body := query.build()
+		if body.Limit != 0 && received >= body.Limit {
+			return nil, io.EOF
+		}
+		if last != nil {
+			body.ConsensusStartTime = _TimeToProtobuf(_TimeFromProtobuf(last.ConsensusTimestamp).Add(time.Nanosecond))
+			if body.Limit != 0 {
+				body.Limit -= received
+			}
+		}
+
+		return channel.SubscribeTopic(ctx, body)
+	}
+
+	resultStream := processProtoMessageStream(ctx, stream, resubscribe, query.attempt, query.maxAttempts, query.retryHandler)
 
 	consumeMessages := func(ctx context.Context, incomingStream <-chan streamResult[*mirror.ConsensusTopicResponse]) {
 		for {
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/pkg/errors"
@@ -148,7 +149,7 @@ func (tx *TopicMessageSubmitTransaction) FreezeWith(client *Client) (*TopicMessa
 			return tx, errNoClientOrTransactionIDOrNodeId
 		}
 
-		tx.SetNodeAccountIDs(client.network._GetNodeAccountIDsForExecute())
+		tx.SetNodeAccountIDs(client.network._GetNodeAccountIDsForRequest(RequestTypeConsensusSubmitMessage))
 	}
 
 	if tx.chunkSize == 0 {
@@ -241,6 +242,15 @@ func (tx *TopicMessageSubmitTransaction) Schedule() (*ScheduleCreateTransaction,
 // Execute executes the Query with the provided client
 func (tx *TopicMessageSubmitTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, returning ctx.Err() if ctx is cancelled
+// before every chunk has been accepted
+func (tx *TopicMessageSubmitTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -250,7 +260,7 @@ func (tx *TopicMessageSubmitTransaction) Execute(
 		return TransactionResponse{}, tx.freezeError
 	}
 
-	list, err := tx.ExecuteAll(client)
+	list, err := tx.ExecuteAllWithContext(ctx, client)
 
 	if err != nil {
 		return TransactionResponse{}, err
@@ -267,7 +277,16 @@ func (tx *TopicMessageSubmitTransaction) Execute(
 func (tx *TopicMessageSubmitTransaction) ExecuteAll(
 	client *Client,
 ) ([]TransactionResponse, error) {
-	return executeAll(tx, client)
+	return tx.ExecuteAllWithContext(context.Background(), client)
+}
+
+// ExecuteAllWithContext executes the all the Transactions with the provided client, stopping at the first chunk
+// interrupted by ctx
+func (tx *TopicMessageSubmitTransaction) ExecuteAllWithContext(
+	ctx context.Context,
+	client *Client,
+) ([]TransactionResponse, error) {
+	return executeAll(ctx, tx, client)
 }
 
 // ----------- Overridden functions ----------------
@@ -4,6 +4,7 @@ package hiero
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha512"
 	"fmt"
 	"reflect"
@@ -48,7 +49,7 @@ type BaseTransaction struct {
 	signedTransactions *_LockableSlice
 
 	publicKeys         []PublicKey
-	transactionSigners []TransactionSigner
+	transactionSigners []Signer
 	customFeeLimits    []*CustomFeeLimit
 	batchKey           Key
 }
@@ -99,7 +100,7 @@ func TransactionFromBytes(data []byte) (TransactionInterface, error) { // nolint
 	minBackoff := 250 * time.Millisecond
 	maxBackoff := 8 * time.Second
 	publicKeys := make([]PublicKey, 0)
-	transactionSigners := make([]TransactionSigner, 0)
+	transactionSigners := make([]Signer, 0)
 	err := protobuf.Unmarshal(data, &list)
 	if err != nil {
 		return nil, errors.Wrap(err, "error deserializing from bytes to transaction List")
@@ -659,7 +660,7 @@ func (tx *Transaction[T]) _RequireOneNodeAccountID() {
 
 func (tx *Transaction[T]) _SignWith(
 	publicKey PublicKey,
-	signer TransactionSigner,
+	signer Signer,
 ) {
 	tx.transactions = _NewLockableSlice()
 	tx.publicKeys = append(tx.publicKeys, publicKey)
@@ -721,46 +722,31 @@ func (tx *Transaction[T]) buildUnsignedTransaction(index int) (*services.Transac
 	return &services.Transaction{BodyBytes: bodyBytes}, nil
 }
 
-func (tx *Transaction[T]) _SignTransaction(index int) {
-	initialTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
-	bodyBytes := initialTx.GetBodyBytes()
-	if len(initialTx.SigMap.SigPair) != 0 {
-		for i, key := range tx.publicKeys {
-			if tx.transactionSigners[i] != nil {
-				if key.ed25519PublicKey != nil {
-					if bytes.Equal(initialTx.SigMap.SigPair[0].PubKeyPrefix, key.ed25519PublicKey.keyData) {
-						if !tx.regenerateTransactionID {
-							return
-						}
-						switch t := initialTx.SigMap.SigPair[0].Signature.(type) { //nolint
-						case *services.SignaturePair_Ed25519:
-							if bytes.Equal(t.Ed25519, tx.transactionSigners[0](bodyBytes)) && len(t.Ed25519) > 0 {
-								return
-							}
-						}
-					}
-				}
-				if key.ecdsaPublicKey != nil {
-					if bytes.Equal(initialTx.SigMap.SigPair[0].PubKeyPrefix, key.ecdsaPublicKey._BytesRaw()) {
-						if !tx.regenerateTransactionID {
-							return
-						}
-						switch t := initialTx.SigMap.SigPair[0].Signature.(type) { //nolint
-						case *services.SignaturePair_ECDSASecp256K1:
-							if bytes.Equal(t.ECDSASecp256K1, tx.transactionSigners[0](bodyBytes)) && len(t.ECDSASecp256K1) > 0 {
-								return
-							}
-						}
-					}
-				}
-			}
+// _SignTransactions signs the signed transactions at the given indexes with every registered Signer.
+// All body bytes are handed to a Signer at once so a BatchSigner can sign them in a single round trip.
+func (tx *Transaction[T]) _SignTransactions(ctx context.Context, indexes []int) error {
+	pending := make([]int, 0, len(indexes))
+	for _, index := range indexes {
+		if tx._IsAlreadySigned(index) {
+			continue
+		}
+
+		if tx.regenerateTransactionID && !tx.transactionIDs.locked {
+			modifiedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
+			modifiedTx.SigMap.SigPair = make([]*services.SignaturePair, 0)
+			tx.signedTransactions._Set(index, modifiedTx)
 		}
+
+		pending = append(pending, index)
+	}
+
+	if len(pending) == 0 {
+		return nil
 	}
 
-	if tx.regenerateTransactionID && !tx.transactionIDs.locked {
-		modifiedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
-		modifiedTx.SigMap.SigPair = make([]*services.SignaturePair, 0)
-		tx.signedTransactions._Set(index, modifiedTx)
+	messages := make([][]byte, len(pending))
+	for i, index := range pending {
+		messages[i] = tx.signedTransactions._Get(index).(*services.SignedTransaction).GetBodyBytes()
 	}
 
 	for i := 0; i < len(tx.publicKeys); i++ {
@@ -771,28 +757,110 @@ func (tx *Transaction[T]) _SignTransaction(index int) {
 			continue
 		}
 
-		signature := signer(bodyBytes)
-		modifiedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
-		modifiedTx.SigMap.SigPair = append(modifiedTx.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signature))
-		tx.signedTransactions._Set(index, modifiedTx)
+		signatures, err := _SignerSignAll(ctx, signer, messages)
+		if err != nil {
+			return err
+		}
+
+		for j, index := range pending {
+			modifiedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
+			modifiedTx.SigMap.SigPair = append(modifiedTx.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signatures[j]))
+			tx.signedTransactions._Set(index, modifiedTx)
+		}
 	}
+
+	return nil
 }
 
-func (tx *Transaction[T]) _BuildAllTransactions() ([]*services.Transaction, error) {
-	allTx := make([]*services.Transaction, 0)
+// _IsAlreadySigned reports whether the signed transaction at index already carries the signature of a
+// registered Signer, in which case it must not be signed again. The decision is made from the public key prefix
+// without calling the Signer. When transaction IDs are regenerated the body may have changed since it was signed, so
+// the signature must also verify against the current body.
+func (tx *Transaction[T]) _IsAlreadySigned(index int) bool {
+	initialTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
+	if len(initialTx.SigMap.SigPair) == 0 {
+		return false
+	}
+	sigPair := initialTx.SigMap.SigPair[0]
+
+	for i, key := range tx.publicKeys {
+		if tx.transactionSigners[i] == nil {
+			continue
+		}
+
+		var prefix []byte
+		var existing []byte
+		if key.ed25519PublicKey != nil {
+			prefix = key.ed25519PublicKey.keyData
+			existing = sigPair.GetEd25519()
+		} else if key.ecdsaPublicKey != nil {
+			prefix = key.ecdsaPublicKey._BytesRaw()
+			existing = sigPair.GetECDSASecp256K1()
+		}
+
+		if prefix == nil || !bytes.Equal(sigPair.PubKeyPrefix, prefix) {
+			continue
+		}
+
+		if !tx.regenerateTransactionID {
+			return true
+		}
+
+		if len(existing) > 0 && key.VerifySignedMessage(initialTx.GetBodyBytes(), existing) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (tx *Transaction[T]) _BuildAllTransactions(ctx context.Context) ([]*services.Transaction, error) {
+	toSign := make([]int, 0)
 	for i := 0; i < tx.signedTransactions._Length(); i++ {
-		curr, err := tx._BuildTransaction(i)
+		needsSigning, err := tx._UpdateSignedTransactionBody(i)
 		tx.transactionIDs._Advance()
 		if err != nil {
 			return []*services.Transaction{}, err
 		}
+		if needsSigning {
+			toSign = append(toSign, i)
+		}
+	}
+
+	if err := tx._SignTransactions(ctx, toSign); err != nil {
+		return []*services.Transaction{}, err
+	}
+
+	allTx := make([]*services.Transaction, 0)
+	for i := 0; i < tx.signedTransactions._Length(); i++ {
+		curr, err := tx._MarshalSignedTransaction(i)
+		if err != nil {
+			return []*services.Transaction{}, err
+		}
 		allTx = append(allTx, curr)
 	}
 
 	return allTx, nil
 }
 
-func (tx *Transaction[T]) _BuildTransaction(index int) (*services.Transaction, error) {
+func (tx *Transaction[T]) _BuildTransaction(ctx context.Context, index int) (*services.Transaction, error) {
+	needsSigning, err := tx._UpdateSignedTransactionBody(index)
+	if err != nil {
+		return &services.Transaction{}, err
+	}
+
+	if needsSigning {
+		if err := tx._SignTransactions(ctx, []int{index}); err != nil {
+			return &services.Transaction{}, err
+		}
+	}
+
+	return tx._MarshalSignedTransaction(index)
+}
+
+// _UpdateSignedTransactionBody applies the current transaction ID, memo and fees to the body at index and
+// reports whether the body has to be (re)signed.
+func (tx *Transaction[T]) _UpdateSignedTransactionBody(index int) (bool, error) {
 	signedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
 
 	txID := tx.transactionIDs._GetCurrent().(TransactionID)
@@ -826,7 +894,7 @@ func (tx *Transaction[T]) _BuildTransaction(index int) (*services.Transaction, e
 
 	updatedBody, err := protobuf.Marshal(&originalBody)
 	if err != nil {
-		return &services.Transaction{}, errors.Wrap(err, "failed to update tx ID")
+		return false, errors.Wrap(err, "failed to update tx ID")
 	}
 
 	// Below are checks whether we need to sign the transaction or we already have the same signed
@@ -834,22 +902,17 @@ func (tx *Transaction[T]) _BuildTransaction(index int) (*services.Transaction, e
 		sigPairLen := len(signedTx.SigMap.GetSigPair())
 		// For cases where we need more than 1 signature
 		if sigPairLen > 0 && sigPairLen == len(tx.publicKeys) {
-			data, err := protobuf.Marshal(signedTx)
-			if err != nil {
-				return &services.Transaction{}, errors.Wrap(err, "failed to serialize transactions for building")
-			}
-			transaction := &services.Transaction{
-				SignedTransactionBytes: data,
-			}
-
-			return transaction, nil
+			return false, nil
 		}
 	}
 
 	signedTx.BodyBytes = updatedBody
 	tx.signedTransactions._Set(index, signedTx)
-	tx._SignTransaction(index)
 
+	return true, nil
+}
+
+func (tx *Transaction[T]) _MarshalSignedTransaction(index int) (*services.Transaction, error) {
 	signed := tx.signedTransactions._Get(index).(*services.SignedTransaction)
 	data, err := protobuf.Marshal(signed)
 	if err != nil {
@@ -904,6 +967,8 @@ func (tx *Transaction[T]) GetSignatures() (map[AccountID]map[*PublicKey][]byte,
 				inner[&key] = sigPair.GetContract()
 			case *services.SignaturePair_Ed25519:
 				inner[&key] = sigPair.GetEd25519()
+			case *services.SignaturePair_ECDSASecp256K1:
+				inner[&key] = sigPair.GetECDSASecp256K1()
 			}
 		}
 
@@ -915,7 +980,7 @@ func (tx *Transaction[T]) GetSignatures() (map[AccountID]map[*PublicKey][]byte,
 }
 
 func (tx *Transaction[T]) GetTransactionHash() ([]byte, error) {
-	current, err := tx._BuildTransaction(0)
+	current, err := tx._BuildTransaction(context.Background(), 0)
 	if err != nil {
 		return nil, err
 	}
@@ -934,7 +999,7 @@ func (tx *Transaction[T]) GetTransactionHashPerNode() (map[AccountID][]byte, err
 		return transactionHash, errTransactionIsNotFrozen
 	}
 
-	allTx, err := tx._BuildAllTransactions()
+	allTx, err := tx._BuildAllTransactions(context.Background())
 	if err != nil {
 		return transactionHash, err
 	}
@@ -976,7 +1041,7 @@ func (tx *Transaction[T]) ToBytes() ([]byte, error) {
 	var err error
 	// If transaction is frozen, build all transactions and "signedTransactions"
 	if tx.IsFrozen() {
-		allTx, err = tx._BuildAllTransactions()
+		allTx, err = tx._BuildAllTransactions(context.Background())
 		tx.transactionIDs.locked = true
 	} else { // Build only onlt "BodyBytes" for each transaction in the list
 		allTx, err = tx.buildAllUnsignedTransactions()
@@ -1105,7 +1170,7 @@ func (tx *Transaction[T]) GetTransactionSize() (int, error) {
 		return 0, errTransactionIsNotFrozen
 	}
 
-	transaction, err := tx._BuildTransaction(0)
+	transaction, err := tx._BuildTransaction(context.Background(), 0)
 	if err != nil {
 		return 0, err
 	}
@@ -1200,7 +1265,7 @@ func (tx *Transaction[T]) Batchify(client *Client, batchKey Key) (T, error) {
 
 // ------------ Transaction methods ---------------
 func (tx *Transaction[T]) Sign(privateKey PrivateKey) T {
-	return tx.SignWith(privateKey.PublicKey(), privateKey.Sign)
+	return tx.SignWithSigner(NewPrivateKeySigner(privateKey))
 }
 func (tx *Transaction[T]) SignWithOperator(client *Client) (T, error) { // nolint
 	// If the transaction is not signed by the _Operator, we need
@@ -1217,12 +1282,19 @@ func (tx *Transaction[T]) SignWithOperator(client *Client) (T, error) { // nolin
 			return *new(T), err
 		}
 	}
-	return tx.SignWith(client.operator.publicKey, client.operator.signer), nil
+	return tx.SignWithSigner(client.operator.signer), nil
 }
 func (tx *Transaction[T]) SignWith(publicKey PublicKey, signer TransactionSigner) T {
+	return tx.SignWithSigner(NewSignerFromTransactionSigner(publicKey, signer))
+}
+
+// SignWithSigner adds the Signer to the transaction. Signing is deferred until the transaction is built,
+// so errors returned by the Signer surface from Execute, ToBytes or GetTransactionHash.
+func (tx *Transaction[T]) SignWithSigner(signer Signer) T {
 	// We need to make sure the request is frozen
 	tx._RequireFrozen()
 
+	publicKey := signer.PublicKey()
 	if !tx._KeyAlreadySigned(publicKey) {
 		tx._SignWith(publicKey, signer)
 	}
@@ -1357,11 +1429,26 @@ func (tx *Transaction[T]) shouldRetry(_ Executable, response any) _ExecutionStat
 	return executionStateError
 }
 
-func (tx *Transaction[T]) makeRequest() any {
+func (tx *Transaction[T]) makeRequest(ctx context.Context) (any, error) {
+	index := tx.nodeAccountIDs._Length()*tx.transactionIDs.index + tx.nodeAccountIDs.index
+
+	return tx._BuildTransaction(ctx, index)
+}
+
+// getRequestType returns the request type of the body at the current index, without building or signing the request
+func (tx *Transaction[T]) getRequestType() RequestType {
 	index := tx.nodeAccountIDs._Length()*tx.transactionIDs.index + tx.nodeAccountIDs.index
-	built, _ := tx._BuildTransaction(index)
+	signedTx, ok := tx.signedTransactions._Get(index).(*services.SignedTransaction)
+	if !ok {
+		return RequestTypeNone
+	}
 
-	return built
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(signedTx.GetBodyBytes(), &body); err != nil {
+		return RequestTypeNone
+	}
+
+	return _RequestTypeFromTransactionBody(&body)
 }
 
 func (tx *Transaction[T]) advanceRequest() {
@@ -1423,7 +1510,14 @@ func (tx *Transaction[T]) regenerateID(client *Client) bool {
 	return false
 }
 
+// Execute executes the Transaction with the provided client
 func (tx *Transaction[T]) Execute(client *Client) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, returning ctx.Err() if ctx is cancelled
+// before the network accepts the transaction
+func (tx *Transaction[T]) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
 	}
@@ -1446,13 +1540,10 @@ func (tx *Transaction[T]) Execute(client *Client) (TransactionResponse, error) {
 	transactionID := tx.transactionIDs._GetCurrent().(TransactionID)
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
-		tx.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		tx.SignWithSigner(client.operator.signer)
 	}
 
-	resp, err := _Execute(client, tx.childTransaction)
+	resp, err := _Execute(ctx, client, tx.childTransaction)
 
 	if err != nil {
 		return TransactionResponse{
@@ -1505,7 +1596,7 @@ func (tx *Transaction[T]) FreezeWith(client *Client) (T, error) {
 
 	if tx.nodeAccountIDs._IsEmpty() {
 		if client != nil {
-			for _, nodeAccountID := range client.network._GetNodeAccountIDsForExecute() {
+			for _, nodeAccountID := range client.network._GetNodeAccountIDsForRequest(_RequestTypeFromTransactionBody(body)) {
 				tx.nodeAccountIDs._Push(nodeAccountID)
 			}
 		} else {
@@ -1536,9 +1627,7 @@ func (tx *Transaction[T]) FreezeWith(client *Client) (T, error) {
 		bodyBytes, err := protobuf.Marshal(body)
 
 		if err != nil {
-			// This should be unreachable
-			// From the documentation this appears to only be possible if there are missing proto types
-			panic(err)
+			return tx.childTransaction, errors.Wrap(err, "failed to serialize transaction body")
 		}
 		tx.signedTransactions = tx.signedTransactions._Push(&services.SignedTransaction{
 			BodyBytes: bodyBytes,
@@ -1634,17 +1723,21 @@ func (tx *Transaction[T]) SetLogLevel(level LogLevel) T {
 // Static Utility functions //
 
 func TransactionExecute(tx TransactionInterface, client *Client) (TransactionResponse, error) {
+	return TransactionExecuteWithContext(context.Background(), tx, client)
+}
+
+func TransactionExecuteWithContext(ctx context.Context, tx TransactionInterface, client *Client) (TransactionResponse, error) {
 	fileAppendTx, ok := tx.(*FileAppendTransaction)
 	if ok {
-		return fileAppendTx.Execute(client)
+		return fileAppendTx.ExecuteWithContext(ctx, client)
 	}
 
 	messageSubmitTx, ok := tx.(*TopicMessageSubmitTransaction)
 	if ok {
-		return messageSubmitTx.Execute(client)
+		return messageSubmitTx.ExecuteWithContext(ctx, client)
 	}
 
-	return tx.getBaseTransaction().Execute(client)
+	return tx.getBaseTransaction().ExecuteWithContext(ctx, client)
 }
 
 func TransactionSign(tx TransactionInterface, key PrivateKey) (TransactionInterface, error) {
@@ -1756,6 +1849,10 @@ func TransactionGetSignatures(tx TransactionInterface) (map[AccountID]map[*Publi
 	return tx.getBaseTransaction().GetSignatures()
 }
 
+func TransactionVerifySignatures(tx TransactionInterface) (SignatureVerification, error) {
+	return tx.getBaseTransaction().VerifySignatures()
+}
+
 func TransactionFreezeWith(tx TransactionInterface, client *Client) (TransactionInterface, error) {
 	fileAppendTx, ok := tx.(*FileAppendTransaction)
 	if ok {
@@ -1793,6 +1890,13 @@ func TransactionSignWth(tx TransactionInterface, publicKKey PublicKey, signer Tr
 	return tx, nil
 }
 
+func TransactionSignWithSigner(tx TransactionInterface, signer Signer) (TransactionInterface, error) {
+	baseTx := tx.getBaseTransaction()
+	baseTx.SignWithSigner(signer)
+
+	return tx, nil
+}
+
 // Helper function to cast the concrete Transaction to the generic Transaction
 func castFromConcreteToBaseTransaction[T TransactionInterface](baseTx *Transaction[T], tx TransactionInterface) *Transaction[TransactionInterface] {
 	return &Transaction[TransactionInterface]{
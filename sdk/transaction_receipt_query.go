@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -83,7 +84,16 @@ func (q *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *TransactionReceiptQuery) Execute(client *Client) (TransactionReceipt, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, returning ctx.Err() if ctx is cancelled before it completes
+func (q *TransactionReceiptQuery) ExecuteWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
+
+	if err != nil && ctx.Err() != nil {
+		return TransactionReceipt{}, ctx.Err()
+	}
 
 	if err, ok := err.(ErrHederaPreCheckStatus); ok {
 		if resp.GetTransactionGetReceipt() != nil {
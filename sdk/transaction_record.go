@@ -497,7 +497,7 @@ func (record TransactionRecord) _ToProtobuf() (*services.TransactionGetRecordRes
 	}
 
 	var err error
-	if record.CallResultIsCreate {
+	if record.CallResult != nil && record.CallResultIsCreate {
 		var choice, err = record.GetContractCreateResult()
 
 		if err != nil {
@@ -507,7 +507,7 @@ func (record TransactionRecord) _ToProtobuf() (*services.TransactionGetRecordRes
 		tRecord.Body = &services.TransactionRecord_ContractCreateResult{
 			ContractCreateResult: choice._ToProtobuf(),
 		}
-	} else {
+	} else if record.CallResult != nil {
 		var choice, err = record.GetContractExecuteResult()
 
 		if err != nil {
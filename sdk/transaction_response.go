@@ -1,6 +1,7 @@
 package hiero
 
 import (
+	"context"
 	"encoding/hex"
 	"time"
 
@@ -36,7 +37,7 @@ func (response TransactionResponse) MarshalJSON() ([]byte, error) {
 }
 
 // retryTransaction is a helper function to retry a transaction that was throttled
-func (response *TransactionResponse) retryTransaction(client *Client) (TransactionReceipt, error) { // nolint
+func (response *TransactionResponse) retryTransaction(ctx context.Context, client *Client) (TransactionReceipt, error) { // nolint
 	maxRetries := 5
 	backoff := 250 * time.Millisecond
 
@@ -45,12 +46,21 @@ func (response *TransactionResponse) retryTransaction(client *Client) (Transacti
 
 	for i := 0; i < maxRetries; i++ {
 		if i > 0 {
-			time.Sleep(backoff)
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return receipt, ctx.Err()
+			case <-timer.C:
+			}
 			backoff *= 2 // Double the backoff for next retry
 		}
 
-		resp, err := TransactionExecute(response.Transaction, client)
+		resp, err := TransactionExecuteWithContext(ctx, response.Transaction, client)
 		if err != nil {
+			if ctx.Err() != nil {
+				return receipt, ctx.Err()
+			}
 			continue
 		}
 
@@ -58,7 +68,7 @@ func (response *TransactionResponse) retryTransaction(client *Client) (Transacti
 			SetTransactionID(resp.TransactionID).
 			SetNodeAccountIDs([]AccountID{resp.NodeID}).
 			SetIncludeChildren(response.IncludeChildReceipts).
-			Execute(client)
+			ExecuteWithContext(ctx, client)
 
 		if err == nil && receipt.Status != StatusThrottledAtConsensus {
 			// Set the transaction ID if the transaction was successful
@@ -72,33 +82,56 @@ func (response *TransactionResponse) retryTransaction(client *Client) (Transacti
 
 // GetReceipt retrieves the receipt for the transaction
 func (response *TransactionResponse) GetReceipt(client *Client) (TransactionReceipt, error) {
+	return response.GetReceiptWithContext(context.Background(), client)
+}
+
+// GetReceiptWithContext retrieves the receipt for the transaction, returning ctx.Err() if ctx is cancelled
+// while the receipt is still being polled
+func (response *TransactionResponse) GetReceiptWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
+	if client == nil {
+		return TransactionReceipt{}, errNoClientProvided
+	}
+
+	ctx, receiptSpan := client.telemetry._StartReceipt(ctx, *response)
+
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
 		SetIncludeChildren(response.IncludeChildReceipts).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if receipt.Status == StatusThrottledAtConsensus && response.ValidateStatus {
-		receipt, err = response.retryTransaction(client)
+		receipt, err = response.retryTransaction(ctx, client)
 	}
 
-	if err != nil {
-		return receipt, err
+	if err == nil {
+		err = receipt.ValidateStatus(response.ValidateStatus)
 	}
+	receiptSpan._End(receipt, err)
 
-	return receipt, receipt.ValidateStatus(response.ValidateStatus)
+	return receipt, err
 }
 
 // GetRecord retrieves the record for the transaction
 func (response *TransactionResponse) GetRecord(client *Client) (TransactionRecord, error) {
+	return response.GetRecordWithContext(context.Background(), client)
+}
+
+// GetRecordWithContext retrieves the record for the transaction, returning ctx.Err() if ctx is cancelled
+// while the receipt or record is still being polled
+func (response *TransactionResponse) GetRecordWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
+	if client == nil {
+		return TransactionRecord{}, errNoClientProvided
+	}
+
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
 		SetIncludeChildren(response.IncludeChildReceipts).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if receipt.Status == StatusThrottledAtConsensus {
-		receipt, err = response.retryTransaction(client)
+		receipt, err = response.retryTransaction(ctx, client)
 	}
 
 	if err != nil {
@@ -109,7 +142,7 @@ func (response *TransactionResponse) GetRecord(client *Client) (TransactionRecor
 	return NewTransactionRecordQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 }
 
 // GetReceiptQuery retrieves the receipt query for the transaction
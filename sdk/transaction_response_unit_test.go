@@ -0,0 +1,26 @@
+//go:build all || unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitTransactionResponseNoClient(t *testing.T) {
+	t.Parallel()
+
+	response := TransactionResponse{
+		TransactionID: TransactionIDGenerate(AccountID{Account: 1800}),
+		NodeID:        AccountID{Account: 3},
+	}
+
+	_, err := response.GetReceipt(nil)
+	require.ErrorIs(t, err, errNoClientProvided)
+
+	_, err = response.GetRecord(nil)
+	require.ErrorIs(t, err, errNoClientProvided)
+}